@@ -0,0 +1,100 @@
+package azurerm
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Child resources of the API Management service are returned from the (2016-10-10) API with
+// relative IDs such as `/apis/echo-api` - so we build the full Resource ID ourselves.
+func apiManagementResourceID(subscriptionId string, resourceGroup string, serviceName string, segments ...string) string {
+	id := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s", subscriptionId, resourceGroup, serviceName)
+	if len(segments) > 0 {
+		id = fmt.Sprintf("%s/%s", id, strings.Join(segments, "/"))
+	}
+	return id
+}
+
+func apiManagementServiceNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validateApiManagementServiceName,
+	}
+}
+
+func apiManagementChildNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validateApiManagementChildName,
+	}
+}
+
+func validateApiManagementServiceName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if matched := regexp.MustCompile(`^[a-zA-Z](?:[a-zA-Z0-9-]{0,48}[a-zA-Z0-9])?$`).MatchString(value); !matched {
+		errors = append(errors, fmt.Errorf("%q must start with a letter, may only contain alphanumeric characters and dashes, can't end with a dash and must be between 1-50 chars", k))
+	}
+
+	return
+}
+
+func validateApiManagementChildName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if matched := regexp.MustCompile(`^[^*#&+:<>?]{1,256}$`).MatchString(value); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 1-256 chars and cannot contain any of the characters `*#&+:<>?`", k))
+	}
+
+	return
+}
+
+func apiManagementPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		DiffSuppressFunc: apiManagementPolicyDiffSuppressFunc,
+	}
+}
+
+// the API reformats the Policy XML, so we compare the documents without the whitespace between elements
+func apiManagementPolicyDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeApiManagementPolicy(old) == normalizeApiManagementPolicy(new)
+}
+
+func normalizeApiManagementPolicy(input string) string {
+	return strings.TrimSpace(regexp.MustCompile(`>\s+<`).ReplaceAllString(input, "><"))
+}
+
+// withApiManagementPolicyContentType ensures Policy documents are submitted as XML rather than as a generic file upload
+func withApiManagementPolicyContentType() autorest.PrepareDecorator {
+	return autorest.WithHeader("Content-Type", "application/vnd.ms-azure-apim.policy+xml")
+}
+
+func expandApiManagementPolicy(input string) io.ReadCloser {
+	return ioutil.NopCloser(strings.NewReader(input))
+}
+
+func flattenApiManagementPolicy(input *io.ReadCloser) (string, error) {
+	if input == nil || *input == nil {
+		return "", nil
+	}
+
+	body := *input
+	defer body.Close()
+
+	contents, err := ioutil.ReadAll(body)
+	if err != nil {
+		return "", err
+	}
+
+	return string(contents), nil
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
 )
@@ -36,6 +37,8 @@ func buildConfigForSweepers() (*ArmClient, error) {
 		TenantID:                 tenantID,
 		Environment:              environment,
 		SkipProviderRegistration: false,
+		MaxRetries:               3,
+		RetryBackoff:             30 * time.Second,
 	}
 
 	return config.getArmClient()
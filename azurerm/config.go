@@ -2,31 +2,55 @@ package azurerm
 
 import (
 	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"net/http/httputil"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/arm/apimanagement"
 	"github.com/Azure/azure-sdk-for-go/arm/appinsights"
 	"github.com/Azure/azure-sdk-for-go/arm/authorization"
 	"github.com/Azure/azure-sdk-for-go/arm/automation"
+	"github.com/Azure/azure-sdk-for-go/arm/batch"
 	"github.com/Azure/azure-sdk-for-go/arm/cdn"
+	"github.com/Azure/azure-sdk-for-go/arm/cognitiveservices"
 	"github.com/Azure/azure-sdk-for-go/arm/compute"
 	"github.com/Azure/azure-sdk-for-go/arm/containerinstance"
 	"github.com/Azure/azure-sdk-for-go/arm/containerregistry"
 	"github.com/Azure/azure-sdk-for-go/arm/containerservice"
 	"github.com/Azure/azure-sdk-for-go/arm/cosmos-db"
+	analyticsAccount "github.com/Azure/azure-sdk-for-go/arm/datalake-analytics/account"
+	storeAccount "github.com/Azure/azure-sdk-for-go/arm/datalake-store/account"
+	"github.com/Azure/azure-sdk-for-go/arm/devtestlabs"
 	"github.com/Azure/azure-sdk-for-go/arm/disk"
 	"github.com/Azure/azure-sdk-for-go/arm/dns"
 	"github.com/Azure/azure-sdk-for-go/arm/eventgrid"
 	"github.com/Azure/azure-sdk-for-go/arm/eventhub"
 	"github.com/Azure/azure-sdk-for-go/arm/graphrbac"
+	"github.com/Azure/azure-sdk-for-go/arm/hdinsight"
+	"github.com/Azure/azure-sdk-for-go/arm/iothub"
 	"github.com/Azure/azure-sdk-for-go/arm/keyvault"
+	"github.com/Azure/azure-sdk-for-go/arm/logic"
+	"github.com/Azure/azure-sdk-for-go/arm/monitor"
 	"github.com/Azure/azure-sdk-for-go/arm/mysql"
 	"github.com/Azure/azure-sdk-for-go/arm/network"
 	"github.com/Azure/azure-sdk-for-go/arm/operationalinsights"
+	"github.com/Azure/azure-sdk-for-go/arm/operationsmanagement"
 	"github.com/Azure/azure-sdk-for-go/arm/postgresql"
+	"github.com/Azure/azure-sdk-for-go/arm/recoveryservices"
+	"github.com/Azure/azure-sdk-for-go/arm/recoveryservicesbackup"
 	"github.com/Azure/azure-sdk-for-go/arm/redis"
+	"github.com/Azure/azure-sdk-for-go/arm/resources/policy"
 	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
 	"github.com/Azure/azure-sdk-for-go/arm/resources/subscriptions"
 	"github.com/Azure/azure-sdk-for-go/arm/scheduler"
@@ -34,14 +58,18 @@ import (
 	"github.com/Azure/azure-sdk-for-go/arm/servicebus"
 	"github.com/Azure/azure-sdk-for-go/arm/sql"
 	"github.com/Azure/azure-sdk-for-go/arm/storage"
+	"github.com/Azure/azure-sdk-for-go/arm/streamanalytics"
 	"github.com/Azure/azure-sdk-for-go/arm/trafficmanager"
 	"github.com/Azure/azure-sdk-for-go/arm/web"
+	"github.com/Azure/azure-sdk-for-go/datalake-store/filesystem"
 	keyVault "github.com/Azure/azure-sdk-for-go/dataplane/keyvault"
 	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform/terraform"
+	uuid "github.com/satori/go.uuid"
+	"golang.org/x/crypto/pkcs12"
 )
 
 // ArmClient contains the handles to all the specific Azure Resource Manager
@@ -50,7 +78,11 @@ type ArmClient struct {
 	clientId              string
 	tenantId              string
 	subscriptionId        string
+	partnerId             string
 	usingServicePrincipal bool
+	features              Features
+	defaultTags           map[string]string
+	ignoreTags            ignoreTags
 	environment           azure.Environment
 
 	StopContext context.Context
@@ -64,13 +96,27 @@ type ArmClient struct {
 	vmClient               compute.VirtualMachinesClient
 	imageClient            compute.ImagesClient
 
-	diskClient                 disk.DisksClient
-	snapshotsClient            disk.SnapshotsClient
-	cosmosDBClient             cosmosdb.DatabaseAccountsClient
-	automationAccountClient    automation.AccountClient
-	automationRunbookClient    automation.RunbookClient
-	automationCredentialClient automation.CredentialClient
-	automationScheduleClient   automation.ScheduleClient
+	diskClient                            disk.DisksClient
+	snapshotsClient                       disk.SnapshotsClient
+	cosmosDBClient                        cosmosdb.DatabaseAccountsClient
+	automationAccountClient               automation.AccountClient
+	automationRunbookClient               automation.RunbookClient
+	automationCredentialClient            automation.CredentialClient
+	automationScheduleClient              automation.ScheduleClient
+	automationRunbookDraftClient          automation.RunbookDraftClient
+	automationJobScheduleClient           automation.JobScheduleClient
+	automationVariableClient              automation.VariableClient
+	automationAgentRegistrationInfoClient automation.AgentRegistrationInformationClient
+	automationDscConfigurationClient      automation.DscConfigurationClient
+	automationDscNodeConfigurationClient  automation.DscNodeConfigurationClient
+
+	batchAccountClient batch.AccountClient
+
+	devTestLabsClient            devtestlabs.LabsClient
+	devTestPoliciesClient        devtestlabs.PoliciesClient
+	devTestSchedulesClient       devtestlabs.SchedulesClient
+	devTestVirtualMachinesClient devtestlabs.VirtualMachinesClient
+	devTestVirtualNetworksClient devtestlabs.VirtualNetworksClient
 
 	appGatewayClient             network.ApplicationGatewaysClient
 	ifaceClient                  network.InterfacesClient
@@ -91,19 +137,53 @@ type ArmClient struct {
 	dnsClient                    dns.RecordSetsClient
 	zonesClient                  dns.ZonesClient
 
-	cdnProfilesClient  cdn.ProfilesClient
-	cdnEndpointsClient cdn.EndpointsClient
-
-	containerRegistryClient containerregistry.RegistriesClient
-	containerServicesClient containerservice.ContainerServicesClient
-	containerGroupsClient   containerinstance.ContainerGroupsClient
-
-	eventGridTopicsClient       eventgrid.TopicsClient
-	eventHubClient              eventhub.EventHubsClient
-	eventHubConsumerGroupClient eventhub.ConsumerGroupsClient
-	eventHubNamespacesClient    eventhub.NamespacesClient
-
-	workspacesClient operationalinsights.WorkspacesClient
+	apiManagementServicesClient           apimanagement.ServicesClient
+	apiManagementApisClient               apimanagement.ApisClient
+	apiManagementApiOperationsClient      apimanagement.APIOperationsClient
+	apiManagementApiPolicyClient          apimanagement.APIPolicyClient
+	apiManagementApiOperationPolicyClient apimanagement.APIOperationsPolicyClient
+	apiManagementProductsClient           apimanagement.ProductsClient
+	apiManagementProductApisClient        apimanagement.ProductApisClient
+	apiManagementProductPolicyClient      apimanagement.ProductPolicyClient
+	apiManagementSubscriptionsClient      apimanagement.SubscriptionsClient
+	apiManagementTenantPolicyClient       apimanagement.TenantPolicyClient
+	apiManagementPropertyClient           apimanagement.PropertyClient
+	apiManagementBackendsClient           apimanagement.BackendsClient
+	apiManagementLoggersClient            apimanagement.LoggersClient
+
+	cdnProfilesClient      cdn.ProfilesClient
+	cdnEndpointsClient     cdn.EndpointsClient
+	cdnCustomDomainsClient cdn.CustomDomainsClient
+
+	cognitiveAccountsClient cognitiveservices.AccountsClient
+
+	containerRegistryClient             containerregistry.RegistriesClient
+	containerRegistryReplicationsClient containerregistry.ReplicationsClient
+	containerServicesClient             containerservice.ContainerServicesClient
+	containerGroupsClient               containerinstance.ContainerGroupsClient
+
+	dataLakeStoreAccountClient       storeAccount.GroupClient
+	dataLakeStoreFirewallRulesClient storeAccount.FirewallRulesClient
+	dataLakeStoreFilesClient         filesystem.GroupClient
+	dataLakeAnalyticsAccountClient   analyticsAccount.GroupClient
+	dataLakeAnalyticsFirewallClient  analyticsAccount.FirewallRulesClient
+	dataLakeAnalyticsStoresClient    analyticsAccount.DataLakeStoreAccountsClient
+
+	eventGridEventSubscriptionsClient eventgrid.EventSubscriptionsClient
+	eventGridTopicsClient             eventgrid.TopicsClient
+	eventHubClient                    eventhub.EventHubsClient
+	eventHubConsumerGroupClient       eventhub.ConsumerGroupsClient
+	eventHubNamespacesClient          eventhub.NamespacesClient
+
+	hdinsightClustersClient       hdinsight.ClustersClient
+	hdinsightConfigurationsClient hdinsight.ConfigurationsClient
+
+	iothubResourceClient iothub.ResourceClient
+
+	linkedServicesClient operationalinsights.LinkedServicesClient
+	savedSearchesClient  operationalinsights.SavedSearchesClient
+	workspacesClient     operationalinsights.WorkspacesClient
+	solutionsClient      operationsmanagement.SolutionsClient
 
 	providers           resources.ProvidersClient
 	resourceGroupClient resources.GroupsClient
@@ -118,6 +198,12 @@ type ArmClient struct {
 	storageServiceClient storage.AccountsClient
 	storageUsageClient   storage.UsageClient
 
+	streamAnalyticsFunctionsClient       streamanalytics.FunctionsClient
+	streamAnalyticsJobsClient            streamanalytics.StreamingJobsClient
+	streamAnalyticsInputsClient          streamanalytics.InputsClient
+	streamAnalyticsOutputsClient         streamanalytics.OutputsClient
+	streamAnalyticsTransformationsClient streamanalytics.TransformationsClient
+
 	deploymentsClient resources.DeploymentsClient
 
 	redisClient redis.GroupClient
@@ -134,37 +220,65 @@ type ArmClient struct {
 	keyVaultClient           keyvault.VaultsClient
 	keyVaultManagementClient keyVault.ManagementClient
 
+	logicWorkflowsClient logic.WorkflowsClient
+
+	recoveryServicesVaultsClient             recoveryservices.VaultsClient
+	recoveryServicesStorageConfigsClient     recoveryservices.BackupStorageConfigsClient
+	recoveryServicesProtectionPoliciesClient recoveryservicesbackup.ProtectionPoliciesClient
+	recoveryServicesProtectedItemsClient     recoveryservicesbackup.ProtectedItemsClient
+
 	appServicePlansClient web.AppServicePlansClient
 	appServicesClient     web.AppsClient
 
-	appInsightsClient appinsights.ComponentsClient
+	appInsightsClient         appinsights.ComponentsClient
+	appInsightsWebTestsClient appinsights.WebTestsClient
+
+	// Monitor
+	monitorActionGroupsClient               monitor.ActionGroupsClient
+	monitorAlertRulesClient                 monitor.AlertRulesClient
+	monitorAutoscaleSettingsClient          monitor.AutoscaleSettingsClient
+	monitorDiagnosticSettingsClient         monitor.DiagnosticSettingsClient
+	monitorDiagnosticSettingsCategoryClient monitor.DiagnosticSettingsCategoryClient
+
+	// Policy
+	policyAssignmentsClient policy.AssignmentsClient
+	policyDefinitionsClient policy.DefinitionsClient
 
 	// Authentication
 	roleAssignmentsClient   authorization.RoleAssignmentsClient
 	roleDefinitionsClient   authorization.RoleDefinitionsClient
+	objectsClient           graphrbac.ObjectsClient
 	servicePrincipalsClient graphrbac.ServicePrincipalsClient
 
 	// Databases
-	mysqlConfigurationsClient      mysql.ConfigurationsClient
-	mysqlDatabasesClient           mysql.DatabasesClient
-	mysqlFirewallRulesClient       mysql.FirewallRulesClient
-	mysqlServersClient             mysql.ServersClient
-	postgresqlConfigurationsClient postgresql.ConfigurationsClient
-	postgresqlDatabasesClient      postgresql.DatabasesClient
-	postgresqlFirewallRulesClient  postgresql.FirewallRulesClient
-	postgresqlServersClient        postgresql.ServersClient
-	sqlDatabasesClient             sql.DatabasesClient
-	sqlElasticPoolsClient          sql.ElasticPoolsClient
-	sqlFirewallRulesClient         sql.FirewallRulesClient
-	sqlServersClient               sql.ServersClient
+	mysqlConfigurationsClient            mysql.ConfigurationsClient
+	mysqlDatabasesClient                 mysql.DatabasesClient
+	mysqlFirewallRulesClient             mysql.FirewallRulesClient
+	mysqlServersClient                   mysql.ServersClient
+	postgresqlConfigurationsClient       postgresql.ConfigurationsClient
+	postgresqlDatabasesClient            postgresql.DatabasesClient
+	postgresqlFirewallRulesClient        postgresql.FirewallRulesClient
+	postgresqlServersClient              postgresql.ServersClient
+	sqlDatabasesClient                   sql.DatabasesClient
+	sqlElasticPoolsClient                sql.ElasticPoolsClient
+	sqlFirewallRulesClient               sql.FirewallRulesClient
+	sqlServerAzureADAdministratorsClient sql.ServerAzureADAdministratorsClient
+	sqlServersClient                     sql.ServersClient
+	sqlTransparentDataEncryptionsClient  sql.TransparentDataEncryptionsClient
 }
 
+// logHTTPBodiesEnvVar is the environment variable which opts in to logging the (sanitized) bodies of
+// requests and responses - which are otherwise omitted since they can be large and contain sensitive values
+const logHTTPBodiesEnvVar = "ARM_LOG_HTTP_BODIES"
+
 func withRequestLogging() autorest.SendDecorator {
+	logBodies := strings.EqualFold(os.Getenv(logHTTPBodiesEnvVar), "true")
+
 	return func(s autorest.Sender) autorest.Sender {
 		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
 			// dump request to wire format
-			if dump, err := httputil.DumpRequestOut(r, true); err == nil {
-				log.Printf("[DEBUG] AzureRM Request: \n%s\n", dump)
+			if dump, err := httputil.DumpRequestOut(r, logBodies); err == nil {
+				log.Printf("[DEBUG] AzureRM Request: \n%s\n", sanitizeHTTPDump(string(dump)))
 			} else {
 				// fallback to basic message
 				log.Printf("[DEBUG] AzureRM Request: %s to %s\n", r.Method, r.URL)
@@ -173,8 +287,8 @@ func withRequestLogging() autorest.SendDecorator {
 			resp, err := s.Do(r)
 			if resp != nil {
 				// dump response to wire format
-				if dump, err := httputil.DumpResponse(resp, true); err == nil {
-					log.Printf("[DEBUG] AzureRM Response for %s: \n%s\n", r.URL, dump)
+				if dump, err := httputil.DumpResponse(resp, logBodies); err == nil {
+					log.Printf("[DEBUG] AzureRM Response for %s: \n%s\n", r.URL, sanitizeHTTPDump(string(dump)))
 				} else {
 					// fallback to basic message
 					log.Printf("[DEBUG] AzureRM Response: %s for %s\n", resp.Status, r.URL)
@@ -187,12 +301,159 @@ func withRequestLogging() autorest.SendDecorator {
 	}
 }
 
-func setUserAgent(client *autorest.Client) {
+var (
+	sensitiveHeadersRegex   = regexp.MustCompile(`(?im)^(Authorization|Set-Cookie|Cookie|Ocp-Apim-Subscription-Key):[^\r\n]*`)
+	sensitiveJSONFieldRegex = regexp.MustCompile(`(?i)("[^"]*(password|secret|key|token|connectionstring)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	sensitiveFormFieldRegex = regexp.MustCompile(`(?i)\b(client_secret|client_assertion|password|refresh_token|access_token)=[^&\s]*`)
+)
+
+// sanitizeHTTPDump redacts the credentials (such as Bearer Tokens) and sensitive fields (such as
+// passwords and access keys) from a dumped request or response, so that it can be logged.
+func sanitizeHTTPDump(dump string) string {
+	dump = sensitiveHeadersRegex.ReplaceAllString(dump, "$1: [REDACTED]")
+	dump = sensitiveJSONFieldRegex.ReplaceAllString(dump, `${1}"[REDACTED]"`)
+	dump = sensitiveFormFieldRegex.ReplaceAllString(dump, "$1=[REDACTED]")
+	return dump
+}
+
+// withCorrelationRequestID sends the same Correlation Request ID with every request made
+// by the provider, so that they can be grouped together when investigating an issue.
+func withCorrelationRequestID(correlationRequestID string) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if r.Header.Get(correlationRequestIDHeader) == "" {
+				r.Header.Set(correlationRequestIDHeader, correlationRequestID)
+			}
+			return s.Do(r)
+		})
+	}
+}
+
+// retryableStatusCodes are the status codes returned from Azure which indicate the request should be retried
+var retryableStatusCodes = []int{
+	http.StatusRequestTimeout,
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// maxRetryDelay is the longest the provider will wait between retries, regardless of the backoff
+const maxRetryDelay = 5 * time.Minute
+
+// withRetries retries requests which are throttled or fail with a transient error, waiting for the
+// duration in the `Retry-After` header if one is returned, otherwise backing off exponentially.
+func withRetries(maxRetries int, backoff time.Duration) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (resp *http.Response, err error) {
+			rr := autorest.NewRetriableRequest(r)
+			for attempt := 0; ; attempt++ {
+				if err = rr.Prepare(); err != nil {
+					return resp, err
+				}
+
+				resp, err = s.Do(rr.Request())
+				if err != nil || !autorest.ResponseHasStatusCode(resp, retryableStatusCodes...) {
+					return resp, err
+				}
+
+				if attempt >= maxRetries {
+					// the SDK waits for the `Retry-After` duration on a 429 even when it's not retrying,
+					// so this is removed once the retries have been exhausted
+					resp.Header.Del("Retry-After")
+					return resp, err
+				}
+
+				delay := retryDelay(resp, backoff, attempt)
+				log.Printf("[DEBUG] Request to %s returned %d - retrying in %s (attempt %d of %d)", r.URL, resp.StatusCode, delay, attempt+1, maxRetries)
+
+				// the response body must be drained and closed so the connection can be re-used
+				autorest.Respond(resp, autorest.ByDiscardingBody(), autorest.ByClosing())
+
+				select {
+				case <-time.After(delay):
+				case <-r.Cancel:
+					return resp, fmt.Errorf("Request to %s was cancelled whilst waiting to retry", r.URL)
+				case <-r.Context().Done():
+					return resp, fmt.Errorf("Request to %s was cancelled whilst waiting to retry: %+v", r.URL, r.Context().Err())
+				}
+			}
+		})
+	}
+}
+
+// withStopContext sends requests which don't already have a context with the one returned from `stopContext`,
+// so that in-flight requests (and any retries) are cancelled when Terraform is stopped (e.g. Ctrl-C is pressed).
+// Long-running operations are also cancelled this way, via the channel returned from `cancelAfter`.
+func withStopContext(stopContext func() context.Context) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if ctx := stopContext(); ctx != nil && r.Context() == context.Background() {
+				r = r.WithContext(ctx)
+			}
+			return s.Do(r)
+		})
+	}
+}
+
+// retryDelay returns how long to wait before retrying the request, which is the `Retry-After` header
+// if one was returned, otherwise an exponential backoff - limited to `maxRetryDelay` in either case.
+func retryDelay(resp *http.Response, backoff time.Duration, attempt int) time.Duration {
+	delay := backoff * time.Duration(1<<uint(attempt))
+	delay = autorest.GetRetryAfter(resp, delay)
+
+	if delay > maxRetryDelay || delay < 0 {
+		return maxRetryDelay
+	}
+
+	return delay
+}
+
+func (c *ArmClient) configureClient(client *autorest.Client) {
+	// retries are handled by the shared Sender (see `withRetries`), so the SDK's built-in retries are disabled
+	client.RetryAttempts = 0
+	client.RetryDuration = 0
+
 	version := terraform.VersionString()
 	client.UserAgent = fmt.Sprintf("HashiCorp-Terraform-v%s", version)
+
+	// append any custom suffix, such as that set by Cloud Shell or a CI system
+	if azureAgent := os.Getenv("AZURE_HTTP_USER_AGENT"); azureAgent != "" {
+		client.UserAgent = fmt.Sprintf("%s %s", client.UserAgent, azureAgent)
+	}
+
+	if c.partnerId != "" {
+		client.UserAgent = fmt.Sprintf("%s pid-%s", client.UserAgent, c.partnerId)
+	}
 }
 
 func (c *Config) getAuthorizationToken(oauthConfig *adal.OAuthConfig, endpoint string) (*autorest.BearerAuthorizer, error) {
+	if c.UseMsi {
+		spt, err := c.getMsiToken(endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		auth := autorest.NewBearerAuthorizer(spt)
+		return auth, nil
+	}
+
+	if c.UseOIDC {
+		secret := &servicePrincipalOIDCSecret{
+			token:        c.OIDCToken,
+			requestURL:   c.OIDCRequestURL,
+			requestToken: c.OIDCRequestToken,
+		}
+		spt, err := adal.NewServicePrincipalTokenWithSecret(*oauthConfig, c.ClientID, endpoint, secret)
+		if err != nil {
+			return nil, err
+		}
+
+		auth := autorest.NewBearerAuthorizer(spt)
+		return auth, nil
+	}
+
 	useServicePrincipal := c.ClientSecret != ""
 
 	if useServicePrincipal {
@@ -205,6 +466,21 @@ func (c *Config) getAuthorizationToken(oauthConfig *adal.OAuthConfig, endpoint s
 		return auth, nil
 	}
 
+	if c.usingClientCertificate() {
+		certificate, privateKey, err := c.loadClientCertificate()
+		if err != nil {
+			return nil, err
+		}
+
+		spt, err := adal.NewServicePrincipalTokenFromCertificate(*oauthConfig, c.ClientID, certificate, privateKey, endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		auth := autorest.NewBearerAuthorizer(spt)
+		return auth, nil
+	}
+
 	if c.IsCloudShell {
 		// load the refreshed tokens from the Azure CLI
 		err := c.LoadTokensFromAzureCLI()
@@ -222,18 +498,233 @@ func (c *Config) getAuthorizationToken(oauthConfig *adal.OAuthConfig, endpoint s
 	return auth, nil
 }
 
-// getArmClient is a helper method which returns a fully instantiated
-// *ArmClient based on the Config's current settings.
-func (c *Config) getArmClient() (*ArmClient, error) {
-	// detect cloud from environment
-	env, envErr := azure.EnvironmentFromName(c.Environment)
+func (c *Config) getMsiToken(endpoint string) (*adal.ServicePrincipalToken, error) {
+	if c.ClientID == "" {
+		// System Assigned Identity
+		return adal.NewServicePrincipalTokenFromMSI(c.MsiEndpoint, endpoint)
+	}
+
+	// a User Assigned Identity is selected by sending its Client ID to the MSI endpoint,
+	// which requires building the token with the MSI secret ourselves
+	msiConfig, err := adal.NewOAuthConfig(c.MsiEndpoint, "")
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing the MSI endpoint %q: %+v", c.MsiEndpoint, err)
+	}
+
+	return adal.NewServicePrincipalTokenWithSecret(*msiConfig, c.ClientID, endpoint, &adal.ServicePrincipalMSISecret{})
+}
+
+func (c *Config) usingClientCertificate() bool {
+	return c.ClientCertificatePath != "" || c.ClientCertificateContents != ""
+}
+
+// loadClientCertificate decodes the PKCS#12 (PFX) Client Certificate, either from the
+// file at the configured path or from the base64-encoded contents
+func (c *Config) loadClientCertificate() (*x509.Certificate, *rsa.PrivateKey, error) {
+	var pfx []byte
+	if c.ClientCertificatePath != "" {
+		contents, err := ioutil.ReadFile(c.ClientCertificatePath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error reading Client Certificate %q: %+v", c.ClientCertificatePath, err)
+		}
+		pfx = contents
+	} else {
+		contents, err := base64.StdEncoding.DecodeString(c.ClientCertificateContents)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Error base64-decoding the Client Certificate: %+v", err)
+		}
+		pfx = contents
+	}
+
+	key, certificate, err := pkcs12.Decode(pfx, c.ClientCertificatePassword)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error decoding the Client Certificate: %+v", err)
+	}
+
+	privateKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, nil, fmt.Errorf("The Client Certificate must contain an RSA Private Key")
+	}
+
+	return certificate, privateKey, nil
+}
+
+// servicePrincipalOIDCSecret implements adal.ServicePrincipalSecret by exchanging an OIDC ID Token,
+// issued by a federated identity provider (such as GitHub Actions), as a Client Assertion
+type servicePrincipalOIDCSecret struct {
+	token        string
+	requestURL   string
+	requestToken string
+}
+
+func (s *servicePrincipalOIDCSecret) SetAuthenticationValues(spt *adal.ServicePrincipalToken, v *url.Values) error {
+	token := s.token
+	if token == "" {
+		// ID Tokens are short-lived, so a new one is requested each time the Access Token is refreshed
+		requested, err := s.requestIDToken()
+		if err != nil {
+			return err
+		}
+		token = requested
+	}
+
+	v.Set("client_assertion", token)
+	v.Set("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+	return nil
+}
+
+func (s *servicePrincipalOIDCSecret) requestIDToken() (string, error) {
+	requestURL, err := url.Parse(s.requestURL)
+	if err != nil {
+		return "", fmt.Errorf("Error parsing the OIDC Request URL %q: %+v", s.requestURL, err)
+	}
+
+	query := requestURL.Query()
+	query.Set("audience", "api://AzureADTokenExchange")
+	requestURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequest(http.MethodGet, requestURL.String(), nil)
+	if err != nil {
+		return "", fmt.Errorf("Error building the OIDC Token request: %+v", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", s.requestToken))
+
+	client := &http.Client{
+		Timeout: 30 * time.Second,
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("Error requesting an OIDC Token: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Error requesting an OIDC Token: received status code %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Value string `json:"value"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("Error parsing the OIDC Token response: %+v", err)
+	}
+
+	if result.Value == "" {
+		return "", fmt.Errorf("The OIDC Token response didn't contain a Token")
+	}
+
+	return result.Value, nil
+}
+
+// azureEnvironmentFromName returns the Azure Environment (which defines the Resource Manager, Active Directory,
+// Storage and Key Vault endpoints) for either a readable name (e.g. `german`) or the full name (e.g. `AzureGermanCloud`)
+func azureEnvironmentFromName(name string) (*azure.Environment, error) {
+	env, envErr := azure.EnvironmentFromName(name)
 	if envErr != nil {
 		// try again with wrapped value to support readable values like german instead of AZUREGERMANCLOUD
-		wrapped := fmt.Sprintf("AZURE%sCLOUD", c.Environment)
+		wrapped := fmt.Sprintf("AZURE%sCLOUD", name)
 		var innerErr error
 		if env, innerErr = azure.EnvironmentFromName(wrapped); innerErr != nil {
-			return nil, envErr
+			return nil, fmt.Errorf("Unknown Environment %q - supported values are `public`, `usgovernment`, `german` and `china`", name)
+		}
+	}
+
+	return &env, nil
+}
+
+// azureEnvironmentMetadata is the response from the `/metadata/endpoints` API
+// exposed by a Resource Manager endpoint (for example on Azure Stack)
+type azureEnvironmentMetadata struct {
+	GalleryEndpoint string `json:"galleryEndpoint"`
+	GraphEndpoint   string `json:"graphEndpoint"`
+	PortalEndpoint  string `json:"portalEndpoint"`
+	Authentication  struct {
+		LoginEndpoint string   `json:"loginEndpoint"`
+		Audiences     []string `json:"audiences"`
+	} `json:"authentication"`
+}
+
+// azureEnvironmentFromMetadata builds an azure.Environment from the metadata
+// exposed by a custom Resource Manager endpoint, returning it along with the
+// audience which should be used when requesting tokens for Resource Manager.
+func azureEnvironmentFromMetadata(endpoint string) (*azure.Environment, string, error) {
+	endpointUrl, err := url.Parse(endpoint)
+	if err != nil || endpointUrl.Scheme == "" || endpointUrl.Host == "" {
+		return nil, "", fmt.Errorf("Error parsing Resource Manager Endpoint %q - expected a URL such as `https://management.local.azurestack.external`", endpoint)
+	}
+
+	endpoint = strings.TrimSuffix(endpoint, "/")
+	metadataUrl := fmt.Sprintf("%s/metadata/endpoints?api-version=1.0", endpoint)
+	log.Printf("[DEBUG] Retrieving the Environment metadata from %q", metadataUrl)
+
+	client := http.Client{
+		Timeout: 30 * time.Second,
+	}
+	resp, err := client.Get(metadataUrl)
+	if err != nil {
+		return nil, "", fmt.Errorf("Error retrieving the Environment metadata from %q: %+v", metadataUrl, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("Error retrieving the Environment metadata from %q: unexpected status code %d", metadataUrl, resp.StatusCode)
+	}
+
+	var metadata azureEnvironmentMetadata
+	if err := json.NewDecoder(resp.Body).Decode(&metadata); err != nil {
+		return nil, "", fmt.Errorf("Error parsing the Environment metadata from %q: %+v", metadataUrl, err)
+	}
+
+	if metadata.Authentication.LoginEndpoint == "" || len(metadata.Authentication.Audiences) == 0 {
+		return nil, "", fmt.Errorf("The Environment metadata from %q didn't contain a Login Endpoint and Audience", metadataUrl)
+	}
+
+	// the DNS suffixes for the other services are based on the domain of the Resource Manager endpoint
+	// e.g. `management.local.azurestack.external` -> `local.azurestack.external`
+	domain := endpointUrl.Hostname()
+	if i := strings.Index(domain, "."); i > -1 {
+		domain = domain[i+1:]
+	}
+
+	env := azure.Environment{
+		Name:                       "AzureCustomCloud",
+		ManagementPortalURL:        metadata.PortalEndpoint,
+		ResourceManagerEndpoint:    fmt.Sprintf("%s/", endpoint),
+		ActiveDirectoryEndpoint:    fmt.Sprintf("%s/", strings.TrimSuffix(metadata.Authentication.LoginEndpoint, "/")),
+		GalleryEndpoint:            metadata.GalleryEndpoint,
+		GraphEndpoint:              metadata.GraphEndpoint,
+		KeyVaultEndpoint:           fmt.Sprintf("https://vault.%s/", domain),
+		KeyVaultDNSSuffix:          fmt.Sprintf("vault.%s", domain),
+		StorageEndpointSuffix:      domain,
+		ResourceManagerVMDNSSuffix: fmt.Sprintf("cloudapp.%s", domain),
+	}
+
+	return &env, metadata.Authentication.Audiences[0], nil
+}
+
+const correlationRequestIDHeader = "x-ms-correlation-request-id"
+
+// getArmClient is a helper method which returns a fully instantiated
+// *ArmClient based on the Config's current settings.
+func (c *Config) getArmClient() (*ArmClient, error) {
+	// detect cloud from environment, or from the metadata of a custom Resource Manager endpoint (e.g. Azure Stack)
+	var env *azure.Environment
+	var tokenAudience string
+	if c.ArmEndpoint != "" {
+		customEnv, audience, err := azureEnvironmentFromMetadata(c.ArmEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		env = customEnv
+		tokenAudience = audience
+	} else {
+		namedEnv, err := azureEnvironmentFromName(c.Environment)
+		if err != nil {
+			return nil, err
 		}
+		env = namedEnv
+		tokenAudience = env.ResourceManagerEndpoint
 	}
 
 	// client declarations:
@@ -241,8 +732,12 @@ func (c *Config) getArmClient() (*ArmClient, error) {
 		clientId:              c.ClientID,
 		tenantId:              c.TenantID,
 		subscriptionId:        c.SubscriptionID,
-		environment:           env,
-		usingServicePrincipal: c.ClientSecret != "",
+		partnerId:             c.PartnerID,
+		features:              c.Features,
+		defaultTags:           c.DefaultTags,
+		ignoreTags:            c.IgnoreTags,
+		environment:           *env,
+		usingServicePrincipal: c.ClientSecret != "" || c.usingClientCertificate() || c.UseOIDC,
 	}
 
 	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, c.TenantID)
@@ -255,11 +750,24 @@ func (c *Config) getArmClient() (*ArmClient, error) {
 		return nil, fmt.Errorf("Unable to configure OAuthConfig for tenant %s", c.TenantID)
 	}
 
-	sender := autorest.CreateSender(withRequestLogging())
+	// the last decorator runs first - as such the Stop Context and Correlation Request ID are set once
+	// for all retries, and each attempt is logged
+	decorators := []autorest.SendDecorator{
+		withRequestLogging(),
+		withRetries(c.MaxRetries, c.RetryBackoff),
+	}
+	if !c.DisableCorrelationRequestID {
+		decorators = append(decorators, withCorrelationRequestID(uuid.NewV4().String()))
+	}
+	decorators = append(decorators, withStopContext(func() context.Context {
+		// this is read for each request, since the Stop Context is replaced between tests
+		return client.StopContext
+	}))
+	sender := autorest.CreateSender(decorators...)
 
 	// Resource Manager endpoints
 	endpoint := env.ResourceManagerEndpoint
-	auth, err := c.getAuthorizationToken(oauthConfig, endpoint)
+	auth, err := c.getAuthorizationToken(oauthConfig, tokenAudience)
 	if err != nil {
 		return nil, err
 	}
@@ -281,507 +789,913 @@ func (c *Config) getArmClient() (*ArmClient, error) {
 		return keyVaultSpt, nil
 	})
 
+	// Data Lake Store Filesystem Endpoints
+	// NOTE: the vendored Environment doesn't expose the Data Lake endpoints, so we use the Public Cloud values
+	dataLakeStoreAuth, err := c.getAuthorizationToken(oauthConfig, "https://datalake.azure.net/")
+	if err != nil {
+		return nil, err
+	}
+
 	// NOTE: these declarations should be left separate for clarity should the
 	// clients be wished to be configured with custom Responders/PollingModes etc...
 	asc := compute.NewAvailabilitySetsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&asc.Client)
+	client.configureClient(&asc.Client)
 	asc.Authorizer = auth
 	asc.Sender = sender
 	client.availSetClient = asc
 
 	uoc := compute.NewUsageClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&uoc.Client)
+	client.configureClient(&uoc.Client)
 	uoc.Authorizer = auth
 	uoc.Sender = sender
 	client.usageOpsClient = uoc
 
 	vmeic := compute.NewVirtualMachineExtensionImagesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&vmeic.Client)
+	client.configureClient(&vmeic.Client)
 	vmeic.Authorizer = auth
 	vmeic.Sender = sender
 	client.vmExtensionImageClient = vmeic
 
 	vmec := compute.NewVirtualMachineExtensionsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&vmec.Client)
+	client.configureClient(&vmec.Client)
 	vmec.Authorizer = auth
 	vmec.Sender = sender
 	client.vmExtensionClient = vmec
 
 	vmic := compute.NewVirtualMachineImagesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&vmic.Client)
+	client.configureClient(&vmic.Client)
 	vmic.Authorizer = auth
 	vmic.Sender = sender
 	client.vmImageClient = vmic
 
 	vmssc := compute.NewVirtualMachineScaleSetsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&vmssc.Client)
+	client.configureClient(&vmssc.Client)
 	vmssc.Authorizer = auth
 	vmssc.Sender = sender
 	client.vmScaleSetClient = vmssc
 
 	vmc := compute.NewVirtualMachinesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&vmc.Client)
+	client.configureClient(&vmc.Client)
 	vmc.Authorizer = auth
 	vmc.Sender = sender
 	client.vmClient = vmc
 
 	agc := network.NewApplicationGatewaysClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&agc.Client)
+	client.configureClient(&agc.Client)
 	agc.Authorizer = auth
 	agc.Sender = sender
 	client.appGatewayClient = agc
 
 	crc := containerregistry.NewRegistriesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&crc.Client)
+	client.configureClient(&crc.Client)
 	crc.Authorizer = auth
 	crc.Sender = sender
 	client.containerRegistryClient = crc
 
+	crrc := containerregistry.NewReplicationsClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&crrc.Client)
+	crrc.Authorizer = auth
+	crrc.Sender = sender
+	client.containerRegistryReplicationsClient = crrc
+
 	csc := containerservice.NewContainerServicesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&csc.Client)
+	client.configureClient(&csc.Client)
 	csc.Authorizer = auth
 	csc.Sender = sender
 	client.containerServicesClient = csc
 
 	cgc := containerinstance.NewContainerGroupsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&cgc.Client)
+	client.configureClient(&cgc.Client)
 	cgc.Authorizer = auth
-	cgc.Sender = autorest.CreateSender(withRequestLogging())
+	cgc.Sender = sender
 	client.containerGroupsClient = cgc
 
 	cdb := cosmosdb.NewDatabaseAccountsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&cdb.Client)
+	client.configureClient(&cdb.Client)
 	cdb.Authorizer = auth
 	cdb.Sender = sender
 	client.cosmosDBClient = cdb
 
 	img := compute.NewImagesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&img.Client)
+	client.configureClient(&img.Client)
 	img.Authorizer = auth
 	img.Sender = sender
 	client.imageClient = img
 
+	egsc := eventgrid.NewEventSubscriptionsClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&egsc.Client)
+	egsc.Authorizer = auth
+	egsc.Sender = sender
+	client.eventGridEventSubscriptionsClient = egsc
+
 	egtc := eventgrid.NewTopicsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&egtc.Client)
+	client.configureClient(&egtc.Client)
 	egtc.Authorizer = auth
 	egtc.Sender = sender
 	client.eventGridTopicsClient = egtc
 
 	ehc := eventhub.NewEventHubsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&ehc.Client)
+	client.configureClient(&ehc.Client)
 	ehc.Authorizer = auth
 	ehc.Sender = sender
 	client.eventHubClient = ehc
 
 	chcgc := eventhub.NewConsumerGroupsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&chcgc.Client)
+	client.configureClient(&chcgc.Client)
 	chcgc.Authorizer = auth
 	chcgc.Sender = sender
 	client.eventHubConsumerGroupClient = chcgc
 
 	ehnc := eventhub.NewNamespacesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&ehnc.Client)
+	client.configureClient(&ehnc.Client)
 	ehnc.Authorizer = auth
 	ehnc.Sender = sender
 	client.eventHubNamespacesClient = ehnc
 
 	ifc := network.NewInterfacesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&ifc.Client)
+	client.configureClient(&ifc.Client)
 	ifc.Authorizer = auth
 	ifc.Sender = sender
 	client.ifaceClient = ifc
 
 	erc := network.NewExpressRouteCircuitsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&erc.Client)
+	client.configureClient(&erc.Client)
 	erc.Authorizer = auth
 	erc.Sender = sender
 	client.expressRouteCircuitClient = erc
 
 	lbc := network.NewLoadBalancersClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&lbc.Client)
+	client.configureClient(&lbc.Client)
 	lbc.Authorizer = auth
 	lbc.Sender = sender
 	client.loadBalancerClient = lbc
 
 	lgc := network.NewLocalNetworkGatewaysClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&lgc.Client)
+	client.configureClient(&lgc.Client)
 	lgc.Authorizer = auth
 	lgc.Sender = sender
 	client.localNetConnClient = lgc
 
+	cac := cognitiveservices.NewAccountsClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&cac.Client)
+	cac.Authorizer = auth
+	cac.Sender = sender
+	client.cognitiveAccountsClient = cac
+
+	dlsac := storeAccount.NewGroupClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&dlsac.Client)
+	dlsac.Authorizer = auth
+	dlsac.Sender = sender
+	client.dataLakeStoreAccountClient = dlsac
+
+	dlsfc := storeAccount.NewFirewallRulesClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&dlsfc.Client)
+	dlsfc.Authorizer = auth
+	dlsfc.Sender = sender
+	client.dataLakeStoreFirewallRulesClient = dlsfc
+
+	dlsfsc := filesystem.NewGroupClient()
+	client.configureClient(&dlsfsc.Client)
+	dlsfsc.Authorizer = dataLakeStoreAuth
+	dlsfsc.Sender = sender
+	client.dataLakeStoreFilesClient = dlsfsc
+
+	dlaac := analyticsAccount.NewGroupClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&dlaac.Client)
+	dlaac.Authorizer = auth
+	dlaac.Sender = sender
+	client.dataLakeAnalyticsAccountClient = dlaac
+
+	dlafc := analyticsAccount.NewFirewallRulesClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&dlafc.Client)
+	dlafc.Authorizer = auth
+	dlafc.Sender = sender
+	client.dataLakeAnalyticsFirewallClient = dlafc
+
+	dlasc := analyticsAccount.NewDataLakeStoreAccountsClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&dlasc.Client)
+	dlasc.Authorizer = auth
+	dlasc.Sender = sender
+	client.dataLakeAnalyticsStoresClient = dlasc
+
+	hdic := hdinsight.NewClustersClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&hdic.Client)
+	hdic.Authorizer = auth
+	hdic.Sender = sender
+	client.hdinsightClustersClient = hdic
+
+	hdicc := hdinsight.NewConfigurationsClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&hdicc.Client)
+	hdicc.Authorizer = auth
+	hdicc.Sender = sender
+	client.hdinsightConfigurationsClient = hdicc
+
 	opwc := operationalinsights.NewWorkspacesClient(c.SubscriptionID)
-	setUserAgent(&opwc.Client)
+	client.configureClient(&opwc.Client)
 	opwc.Authorizer = auth
-	opwc.Sender = autorest.CreateSender(withRequestLogging())
+	opwc.Sender = sender
 	client.workspacesClient = opwc
 
+	lsc := operationalinsights.NewLinkedServicesClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&lsc.Client)
+	lsc.Authorizer = auth
+	lsc.Sender = sender
+	client.linkedServicesClient = lsc
+
+	sssc := operationalinsights.NewSavedSearchesClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&sssc.Client)
+	sssc.Authorizer = auth
+	sssc.Sender = sender
+	client.savedSearchesClient = sssc
+
+	// the Solution Name is specified on a per-request basis, so is set when using the client
+	solutionsClient := operationsmanagement.NewSolutionsClientWithBaseURI(endpoint, c.SubscriptionID, "")
+	client.configureClient(&solutionsClient.Client)
+	solutionsClient.Authorizer = auth
+	solutionsClient.Sender = sender
+	client.solutionsClient = solutionsClient
+
+	safc := streamanalytics.NewFunctionsClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&safc.Client)
+	safc.Authorizer = auth
+	safc.Sender = sender
+	client.streamAnalyticsFunctionsClient = safc
+
+	sajc := streamanalytics.NewStreamingJobsClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&sajc.Client)
+	sajc.Authorizer = auth
+	sajc.Sender = sender
+	client.streamAnalyticsJobsClient = sajc
+
+	saic := streamanalytics.NewInputsClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&saic.Client)
+	saic.Authorizer = auth
+	saic.Sender = sender
+	client.streamAnalyticsInputsClient = saic
+
+	saoc := streamanalytics.NewOutputsClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&saoc.Client)
+	saoc.Authorizer = auth
+	saoc.Sender = sender
+	client.streamAnalyticsOutputsClient = saoc
+
+	satc := streamanalytics.NewTransformationsClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&satc.Client)
+	satc.Authorizer = auth
+	satc.Sender = sender
+	client.streamAnalyticsTransformationsClient = satc
+
 	pipc := network.NewPublicIPAddressesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&pipc.Client)
+	client.configureClient(&pipc.Client)
 	pipc.Authorizer = auth
 	pipc.Sender = sender
 	client.publicIPClient = pipc
 
 	sgc := network.NewSecurityGroupsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&sgc.Client)
+	client.configureClient(&sgc.Client)
 	sgc.Authorizer = auth
 	sgc.Sender = sender
 	client.secGroupClient = sgc
 
 	src := network.NewSecurityRulesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&src.Client)
+	client.configureClient(&src.Client)
 	src.Authorizer = auth
 	src.Sender = sender
 	client.secRuleClient = src
 
 	snc := network.NewSubnetsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&snc.Client)
+	client.configureClient(&snc.Client)
 	snc.Authorizer = auth
 	snc.Sender = sender
 	client.subnetClient = snc
 
 	vgcc := network.NewVirtualNetworkGatewayConnectionsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&vgcc.Client)
+	client.configureClient(&vgcc.Client)
 	vgcc.Authorizer = auth
 	vgcc.Sender = sender
 	client.vnetGatewayConnectionsClient = vgcc
 
 	vgc := network.NewVirtualNetworkGatewaysClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&vgc.Client)
+	client.configureClient(&vgc.Client)
 	vgc.Authorizer = auth
 	vgc.Sender = sender
 	client.vnetGatewayClient = vgc
 
 	vnc := network.NewVirtualNetworksClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&vnc.Client)
+	client.configureClient(&vnc.Client)
 	vnc.Authorizer = auth
 	vnc.Sender = sender
 	client.vnetClient = vnc
 
 	vnpc := network.NewVirtualNetworkPeeringsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&vnpc.Client)
+	client.configureClient(&vnpc.Client)
 	vnpc.Authorizer = auth
 	vnpc.Sender = sender
 	client.vnetPeeringsClient = vnpc
 
 	rtc := network.NewRouteTablesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&rtc.Client)
+	client.configureClient(&rtc.Client)
 	rtc.Authorizer = auth
 	rtc.Sender = sender
 	client.routeTablesClient = rtc
 
 	rc := network.NewRoutesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&rc.Client)
+	client.configureClient(&rc.Client)
 	rc.Authorizer = auth
 	rc.Sender = sender
 	client.routesClient = rc
 
 	dn := dns.NewRecordSetsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&dn.Client)
+	client.configureClient(&dn.Client)
 	dn.Authorizer = auth
 	dn.Sender = sender
 	client.dnsClient = dn
 
 	zo := dns.NewZonesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&zo.Client)
+	client.configureClient(&zo.Client)
 	zo.Authorizer = auth
 	zo.Sender = sender
 	client.zonesClient = zo
 
 	rgc := resources.NewGroupsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&rgc.Client)
+	client.configureClient(&rgc.Client)
 	rgc.Authorizer = auth
 	rgc.Sender = sender
 	client.resourceGroupClient = rgc
 
 	pc := resources.NewProvidersClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&pc.Client)
+	client.configureClient(&pc.Client)
 	pc.Authorizer = auth
 	pc.Sender = sender
 	client.providers = pc
 
 	tc := resources.NewTagsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&tc.Client)
+	client.configureClient(&tc.Client)
 	tc.Authorizer = auth
 	tc.Sender = sender
 	client.tagsClient = tc
 
 	rf := resources.NewGroupClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&rf.Client)
+	client.configureClient(&rf.Client)
 	rf.Authorizer = auth
 	rf.Sender = sender
 	client.resourceFindClient = rf
 
 	subgc := subscriptions.NewGroupClientWithBaseURI(endpoint)
-	setUserAgent(&subgc.Client)
+	client.configureClient(&subgc.Client)
 	subgc.Authorizer = auth
 	subgc.Sender = sender
 	client.subscriptionsGroupClient = subgc
 
 	jc := scheduler.NewJobsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&jc.Client)
+	client.configureClient(&jc.Client)
 	jc.Authorizer = auth
 	jc.Sender = sender
 	client.jobsClient = jc
 
 	jcc := scheduler.NewJobCollectionsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&jcc.Client)
+	client.configureClient(&jcc.Client)
 	jcc.Authorizer = auth
 	jcc.Sender = sender
 	client.jobsCollectionsClient = jcc
 
 	ssc := storage.NewAccountsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&ssc.Client)
+	client.configureClient(&ssc.Client)
 	ssc.Authorizer = auth
 	ssc.Sender = sender
 	client.storageServiceClient = ssc
 
 	suc := storage.NewUsageClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&suc.Client)
+	client.configureClient(&suc.Client)
 	suc.Authorizer = auth
 	suc.Sender = sender
 	client.storageUsageClient = suc
 
+	amsc := apimanagement.NewServicesClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&amsc.Client)
+	amsc.Authorizer = auth
+	amsc.Sender = sender
+	client.apiManagementServicesClient = amsc
+
+	amac := apimanagement.NewApisClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&amac.Client)
+	amac.Authorizer = auth
+	amac.Sender = sender
+	client.apiManagementApisClient = amac
+
+	amaoc := apimanagement.NewAPIOperationsClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&amaoc.Client)
+	amaoc.Authorizer = auth
+	amaoc.Sender = sender
+	client.apiManagementApiOperationsClient = amaoc
+
+	// Policies are sent as raw XML documents, which the API Management API requires a specific Content-Type for
+	amapc := apimanagement.NewAPIPolicyClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&amapc.Client)
+	amapc.Authorizer = auth
+	amapc.Sender = sender
+	amapc.RequestInspector = withApiManagementPolicyContentType()
+	client.apiManagementApiPolicyClient = amapc
+
+	amaopc := apimanagement.NewAPIOperationsPolicyClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&amaopc.Client)
+	amaopc.Authorizer = auth
+	amaopc.Sender = sender
+	amaopc.RequestInspector = withApiManagementPolicyContentType()
+	client.apiManagementApiOperationPolicyClient = amaopc
+
+	ampc := apimanagement.NewProductsClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&ampc.Client)
+	ampc.Authorizer = auth
+	ampc.Sender = sender
+	client.apiManagementProductsClient = ampc
+
+	ampac := apimanagement.NewProductApisClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&ampac.Client)
+	ampac.Authorizer = auth
+	ampac.Sender = sender
+	client.apiManagementProductApisClient = ampac
+
+	amppc := apimanagement.NewProductPolicyClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&amppc.Client)
+	amppc.Authorizer = auth
+	amppc.Sender = sender
+	amppc.RequestInspector = withApiManagementPolicyContentType()
+	client.apiManagementProductPolicyClient = amppc
+
+	amsuc := apimanagement.NewSubscriptionsClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&amsuc.Client)
+	amsuc.Authorizer = auth
+	amsuc.Sender = sender
+	client.apiManagementSubscriptionsClient = amsuc
+
+	amtpc := apimanagement.NewTenantPolicyClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&amtpc.Client)
+	amtpc.Authorizer = auth
+	amtpc.Sender = sender
+	amtpc.RequestInspector = withApiManagementPolicyContentType()
+	client.apiManagementTenantPolicyClient = amtpc
+
+	amprc := apimanagement.NewPropertyClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&amprc.Client)
+	amprc.Authorizer = auth
+	amprc.Sender = sender
+	client.apiManagementPropertyClient = amprc
+
+	ambc := apimanagement.NewBackendsClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&ambc.Client)
+	ambc.Authorizer = auth
+	ambc.Sender = sender
+	client.apiManagementBackendsClient = ambc
+
+	amlc := apimanagement.NewLoggersClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&amlc.Client)
+	amlc.Authorizer = auth
+	amlc.Sender = sender
+	client.apiManagementLoggersClient = amlc
+
 	cpc := cdn.NewProfilesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&cpc.Client)
+	client.configureClient(&cpc.Client)
 	cpc.Authorizer = auth
 	cpc.Sender = sender
 	client.cdnProfilesClient = cpc
 
 	cec := cdn.NewEndpointsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&cec.Client)
+	client.configureClient(&cec.Client)
 	cec.Authorizer = auth
 	cec.Sender = sender
 	client.cdnEndpointsClient = cec
 
+	ccdc := cdn.NewCustomDomainsClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&ccdc.Client)
+	ccdc.Authorizer = auth
+	ccdc.Sender = sender
+	client.cdnCustomDomainsClient = ccdc
+
 	dc := resources.NewDeploymentsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&dc.Client)
+	client.configureClient(&dc.Client)
 	dc.Authorizer = auth
 	dc.Sender = sender
 	client.deploymentsClient = dc
 
 	tmpc := trafficmanager.NewProfilesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&tmpc.Client)
+	client.configureClient(&tmpc.Client)
 	tmpc.Authorizer = auth
 	tmpc.Sender = sender
 	client.trafficManagerProfilesClient = tmpc
 
 	tmec := trafficmanager.NewEndpointsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&tmec.Client)
+	client.configureClient(&tmec.Client)
 	tmec.Authorizer = auth
 	tmec.Sender = sender
 	client.trafficManagerEndpointsClient = tmec
 
 	rdc := redis.NewGroupClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&rdc.Client)
+	client.configureClient(&rdc.Client)
 	rdc.Authorizer = auth
 	rdc.Sender = sender
 	client.redisClient = rdc
 
 	sesc := search.NewServicesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&sesc.Client)
+	client.configureClient(&sesc.Client)
 	sesc.Authorizer = auth
 	sesc.Sender = sender
 	client.searchServicesClient = sesc
 
 	sbnc := servicebus.NewNamespacesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&sbnc.Client)
+	client.configureClient(&sbnc.Client)
 	sbnc.Authorizer = auth
 	sbnc.Sender = sender
 	client.serviceBusNamespacesClient = sbnc
 
 	sbqc := servicebus.NewQueuesClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&sbqc.Client)
+	client.configureClient(&sbqc.Client)
 	sbqc.Authorizer = auth
 	sbqc.Sender = sender
 	client.serviceBusQueuesClient = sbqc
 
 	sbtc := servicebus.NewTopicsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&sbtc.Client)
+	client.configureClient(&sbtc.Client)
 	sbtc.Authorizer = auth
 	sbtc.Sender = sender
 	client.serviceBusTopicsClient = sbtc
 
 	sbsc := servicebus.NewSubscriptionsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&sbsc.Client)
+	client.configureClient(&sbsc.Client)
 	sbsc.Authorizer = auth
 	sbsc.Sender = sender
 	client.serviceBusSubscriptionsClient = sbsc
 
 	aspc := web.NewAppServicePlansClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&aspc.Client)
+	client.configureClient(&aspc.Client)
 	aspc.Authorizer = auth
 	aspc.Sender = sender
 	client.appServicePlansClient = aspc
 
 	ac := web.NewAppsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&ac.Client)
+	client.configureClient(&ac.Client)
 	ac.Authorizer = auth
-	ac.Sender = autorest.CreateSender(withRequestLogging())
+	ac.Sender = sender
 	client.appServicesClient = ac
 
 	ai := appinsights.NewComponentsClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&ai.Client)
+	client.configureClient(&ai.Client)
 	ai.Authorizer = auth
 	ai.Sender = sender
 	client.appInsightsClient = ai
 
+	aiwt := appinsights.NewWebTestsClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&aiwt.Client)
+	aiwt.Authorizer = auth
+	aiwt.Sender = sender
+	client.appInsightsWebTestsClient = aiwt
+
 	aadb := automation.NewAccountClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&aadb.Client)
+	client.configureClient(&aadb.Client)
 	aadb.Authorizer = auth
 	aadb.Sender = sender
 	client.automationAccountClient = aadb
 
 	arc := automation.NewRunbookClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&arc.Client)
+	client.configureClient(&arc.Client)
 	arc.Authorizer = auth
 	arc.Sender = sender
 	client.automationRunbookClient = arc
 
 	acc := automation.NewCredentialClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&acc.Client)
+	client.configureClient(&acc.Client)
 	acc.Authorizer = auth
 	acc.Sender = sender
 	client.automationCredentialClient = acc
 
 	aschc := automation.NewScheduleClientWithBaseURI(endpoint, c.SubscriptionID)
-	setUserAgent(&aschc.Client)
+	client.configureClient(&aschc.Client)
 	aschc.Authorizer = auth
 	aschc.Sender = sender
 	client.automationScheduleClient = aschc
 
+	ardc := automation.NewRunbookDraftClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&ardc.Client)
+	ardc.Authorizer = auth
+	ardc.Sender = sender
+	client.automationRunbookDraftClient = ardc
+
+	ajsc := automation.NewJobScheduleClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&ajsc.Client)
+	ajsc.Authorizer = auth
+	ajsc.Sender = sender
+	client.automationJobScheduleClient = ajsc
+
+	avc := automation.NewVariableClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&avc.Client)
+	avc.Authorizer = auth
+	avc.Sender = sender
+	client.automationVariableClient = avc
+
+	aaric := automation.NewAgentRegistrationInformationClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&aaric.Client)
+	aaric.Authorizer = auth
+	aaric.Sender = sender
+	client.automationAgentRegistrationInfoClient = aaric
+
+	adscc := automation.NewDscConfigurationClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&adscc.Client)
+	adscc.Authorizer = auth
+	adscc.Sender = sender
+	client.automationDscConfigurationClient = adscc
+
+	adscnc := automation.NewDscNodeConfigurationClientWithBaseURI(endpoint, c.SubscriptionID)
+	client.configureClient(&adscnc.Client)
+	adscnc.Authorizer = auth
+	adscnc.Sender = sender
+	client.automationDscNodeConfigurationClient = adscnc
+
 	client.registerAuthentication(endpoint, graphEndpoint, c.SubscriptionID, c.TenantID, auth, graphAuth, sender)
+	client.registerBatchClients(endpoint, c.SubscriptionID, auth, sender)
 	client.registerDatabases(endpoint, c.SubscriptionID, auth, sender)
+	client.registerDevTestClients(endpoint, c.SubscriptionID, auth, sender)
 	client.registerDisks(endpoint, c.SubscriptionID, auth, sender)
+	client.registerIoTHubClients(endpoint, c.SubscriptionID, auth, sender)
 	client.registerKeyVaultClients(endpoint, c.SubscriptionID, auth, keyVaultAuth, sender)
+	client.registerLogicClients(endpoint, c.SubscriptionID, auth, sender)
+	client.registerMonitorClients(endpoint, c.SubscriptionID, auth, sender)
+	client.registerPolicyClients(endpoint, c.SubscriptionID, auth, sender)
+	client.registerRecoveryServicesClients(endpoint, c.SubscriptionID, auth, sender)
 
 	return &client, nil
 }
 
 func (c *ArmClient) registerAuthentication(endpoint, graphEndpoint, subscriptionId, tenantId string, auth, graphAuth autorest.Authorizer, sender autorest.Sender) {
 	spc := graphrbac.NewServicePrincipalsClientWithBaseURI(graphEndpoint, tenantId)
-	setUserAgent(&spc.Client)
+	c.configureClient(&spc.Client)
 	spc.Authorizer = graphAuth
 	spc.Sender = sender
 	c.servicePrincipalsClient = spc
 
+	oc := graphrbac.NewObjectsClientWithBaseURI(graphEndpoint, tenantId)
+	c.configureClient(&oc.Client)
+	oc.Authorizer = graphAuth
+	oc.Sender = sender
+	c.objectsClient = oc
+
 	rac := authorization.NewRoleAssignmentsClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&rac.Client)
+	c.configureClient(&rac.Client)
 	rac.Authorizer = auth
 	rac.Sender = sender
 	c.roleAssignmentsClient = rac
 
 	rdc := authorization.NewRoleDefinitionsClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&rdc.Client)
+	c.configureClient(&rdc.Client)
 	rdc.Authorizer = auth
 	rdc.Sender = sender
 	c.roleDefinitionsClient = rdc
 }
 
+func (c *ArmClient) registerBatchClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+	accountsClient := batch.NewAccountClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&accountsClient.Client)
+	accountsClient.Authorizer = auth
+	accountsClient.Sender = sender
+	c.batchAccountClient = accountsClient
+}
+
 func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
 	// MySQL
 	mysqlConfigClient := mysql.NewConfigurationsClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&mysqlConfigClient.Client)
+	c.configureClient(&mysqlConfigClient.Client)
 	mysqlConfigClient.Authorizer = auth
 	mysqlConfigClient.Sender = sender
 	c.mysqlConfigurationsClient = mysqlConfigClient
 
 	mysqlDBClient := mysql.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&mysqlDBClient.Client)
+	c.configureClient(&mysqlDBClient.Client)
 	mysqlDBClient.Authorizer = auth
 	mysqlDBClient.Sender = sender
 	c.mysqlDatabasesClient = mysqlDBClient
 
 	mysqlFWClient := mysql.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&mysqlFWClient.Client)
+	c.configureClient(&mysqlFWClient.Client)
 	mysqlFWClient.Authorizer = auth
 	mysqlFWClient.Sender = sender
 	c.mysqlFirewallRulesClient = mysqlFWClient
 
 	mysqlServersClient := mysql.NewServersClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&mysqlServersClient.Client)
+	c.configureClient(&mysqlServersClient.Client)
 	mysqlServersClient.Authorizer = auth
 	mysqlServersClient.Sender = sender
 	c.mysqlServersClient = mysqlServersClient
 
 	// PostgreSQL
 	postgresqlConfigClient := postgresql.NewConfigurationsClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&postgresqlConfigClient.Client)
+	c.configureClient(&postgresqlConfigClient.Client)
 	postgresqlConfigClient.Authorizer = auth
-	postgresqlConfigClient.Sender = autorest.CreateSender(withRequestLogging())
+	postgresqlConfigClient.Sender = sender
 	c.postgresqlConfigurationsClient = postgresqlConfigClient
 
 	postgresqlDBClient := postgresql.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&postgresqlDBClient.Client)
+	c.configureClient(&postgresqlDBClient.Client)
 	postgresqlDBClient.Authorizer = auth
-	postgresqlDBClient.Sender = autorest.CreateSender(withRequestLogging())
+	postgresqlDBClient.Sender = sender
 	c.postgresqlDatabasesClient = postgresqlDBClient
 
 	postgresqlFWClient := postgresql.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&postgresqlFWClient.Client)
+	c.configureClient(&postgresqlFWClient.Client)
 	postgresqlFWClient.Authorizer = auth
-	postgresqlFWClient.Sender = autorest.CreateSender(withRequestLogging())
+	postgresqlFWClient.Sender = sender
 	c.postgresqlFirewallRulesClient = postgresqlFWClient
 
 	postgresqlSrvClient := postgresql.NewServersClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&postgresqlSrvClient.Client)
+	c.configureClient(&postgresqlSrvClient.Client)
 	postgresqlSrvClient.Authorizer = auth
-	postgresqlSrvClient.Sender = autorest.CreateSender(withRequestLogging())
+	postgresqlSrvClient.Sender = sender
 	c.postgresqlServersClient = postgresqlSrvClient
 
 	// SQL Azure
 	sqlDBClient := sql.NewDatabasesClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&sqlDBClient.Client)
+	c.configureClient(&sqlDBClient.Client)
 	sqlDBClient.Authorizer = auth
 	sqlDBClient.Sender = sender
 	c.sqlDatabasesClient = sqlDBClient
 
 	sqlFWClient := sql.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&sqlFWClient.Client)
+	c.configureClient(&sqlFWClient.Client)
 	sqlFWClient.Authorizer = auth
 	sqlFWClient.Sender = sender
 	c.sqlFirewallRulesClient = sqlFWClient
 
 	sqlEPClient := sql.NewElasticPoolsClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&sqlEPClient.Client)
+	c.configureClient(&sqlEPClient.Client)
 	sqlEPClient.Authorizer = auth
 	sqlEPClient.Sender = sender
 	c.sqlElasticPoolsClient = sqlEPClient
 
+	sqlADClient := sql.NewServerAzureADAdministratorsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlADClient.Client)
+	sqlADClient.Authorizer = auth
+	sqlADClient.Sender = sender
+	c.sqlServerAzureADAdministratorsClient = sqlADClient
+
 	sqlSrvClient := sql.NewServersClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&sqlSrvClient.Client)
+	c.configureClient(&sqlSrvClient.Client)
 	sqlSrvClient.Authorizer = auth
 	sqlSrvClient.Sender = sender
 	c.sqlServersClient = sqlSrvClient
+
+	sqlTDEClient := sql.NewTransparentDataEncryptionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&sqlTDEClient.Client)
+	sqlTDEClient.Authorizer = auth
+	sqlTDEClient.Sender = sender
+	c.sqlTransparentDataEncryptionsClient = sqlTDEClient
+}
+
+func (c *ArmClient) registerDevTestClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+	labsClient := devtestlabs.NewLabsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&labsClient.Client)
+	labsClient.Authorizer = auth
+	labsClient.Sender = sender
+	c.devTestLabsClient = labsClient
+
+	policiesClient := devtestlabs.NewPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&policiesClient.Client)
+	policiesClient.Authorizer = auth
+	policiesClient.Sender = sender
+	c.devTestPoliciesClient = policiesClient
+
+	schedulesClient := devtestlabs.NewSchedulesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&schedulesClient.Client)
+	schedulesClient.Authorizer = auth
+	schedulesClient.Sender = sender
+	c.devTestSchedulesClient = schedulesClient
+
+	virtualMachinesClient := devtestlabs.NewVirtualMachinesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&virtualMachinesClient.Client)
+	virtualMachinesClient.Authorizer = auth
+	virtualMachinesClient.Sender = sender
+	c.devTestVirtualMachinesClient = virtualMachinesClient
+
+	virtualNetworksClient := devtestlabs.NewVirtualNetworksClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&virtualNetworksClient.Client)
+	virtualNetworksClient.Authorizer = auth
+	virtualNetworksClient.Sender = sender
+	c.devTestVirtualNetworksClient = virtualNetworksClient
 }
 
 func (c *ArmClient) registerDisks(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
 	diskClient := disk.NewDisksClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&diskClient.Client)
+	c.configureClient(&diskClient.Client)
 	diskClient.Authorizer = auth
 	diskClient.Sender = sender
 	c.diskClient = diskClient
 
 	snapshotsClient := disk.NewSnapshotsClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&snapshotsClient.Client)
+	c.configureClient(&snapshotsClient.Client)
 	snapshotsClient.Authorizer = auth
 	snapshotsClient.Sender = sender
 	c.snapshotsClient = snapshotsClient
 }
 
+func (c *ArmClient) registerIoTHubClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+	resourceClient := iothub.NewResourceClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&resourceClient.Client)
+	resourceClient.Authorizer = auth
+	resourceClient.Sender = sender
+	c.iothubResourceClient = resourceClient
+}
+
 func (c *ArmClient) registerKeyVaultClients(endpoint, subscriptionId string, auth autorest.Authorizer, keyVaultAuth autorest.Authorizer, sender autorest.Sender) {
 	keyVaultClient := keyvault.NewVaultsClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&keyVaultClient.Client)
+	c.configureClient(&keyVaultClient.Client)
 	keyVaultClient.Authorizer = auth
 	keyVaultClient.Sender = sender
 	c.keyVaultClient = keyVaultClient
 
 	keyVaultManagementClient := keyVault.New()
-	setUserAgent(&keyVaultManagementClient.Client)
+	c.configureClient(&keyVaultManagementClient.Client)
 	keyVaultManagementClient.Authorizer = keyVaultAuth
 	keyVaultManagementClient.Sender = sender
 	c.keyVaultManagementClient = keyVaultManagementClient
 }
 
+func (c *ArmClient) registerLogicClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+	workflowsClient := logic.NewWorkflowsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&workflowsClient.Client)
+	workflowsClient.Authorizer = auth
+	workflowsClient.Sender = sender
+	c.logicWorkflowsClient = workflowsClient
+}
+
+func (c *ArmClient) registerMonitorClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+	actionGroupsClient := monitor.NewActionGroupsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&actionGroupsClient.Client)
+	actionGroupsClient.Authorizer = auth
+	actionGroupsClient.Sender = sender
+	c.monitorActionGroupsClient = actionGroupsClient
+
+	alertRulesClient := monitor.NewAlertRulesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&alertRulesClient.Client)
+	alertRulesClient.Authorizer = auth
+	alertRulesClient.Sender = sender
+	c.monitorAlertRulesClient = alertRulesClient
+
+	autoscaleSettingsClient := monitor.NewAutoscaleSettingsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&autoscaleSettingsClient.Client)
+	autoscaleSettingsClient.Authorizer = auth
+	autoscaleSettingsClient.Sender = sender
+	c.monitorAutoscaleSettingsClient = autoscaleSettingsClient
+
+	diagnosticSettingsClient := monitor.NewDiagnosticSettingsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&diagnosticSettingsClient.Client)
+	diagnosticSettingsClient.Authorizer = auth
+	diagnosticSettingsClient.Sender = sender
+	c.monitorDiagnosticSettingsClient = diagnosticSettingsClient
+
+	diagnosticSettingsCategoryClient := monitor.NewDiagnosticSettingsCategoryClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&diagnosticSettingsCategoryClient.Client)
+	diagnosticSettingsCategoryClient.Authorizer = auth
+	diagnosticSettingsCategoryClient.Sender = sender
+	c.monitorDiagnosticSettingsCategoryClient = diagnosticSettingsCategoryClient
+}
+
+func (c *ArmClient) registerPolicyClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+	assignmentsClient := policy.NewAssignmentsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&assignmentsClient.Client)
+	assignmentsClient.Authorizer = auth
+	assignmentsClient.Sender = sender
+	c.policyAssignmentsClient = assignmentsClient
+
+	definitionsClient := policy.NewDefinitionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&definitionsClient.Client)
+	definitionsClient.Authorizer = auth
+	definitionsClient.Sender = sender
+	c.policyDefinitionsClient = definitionsClient
+}
+
+func (c *ArmClient) registerRecoveryServicesClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+	vaultsClient := recoveryservices.NewVaultsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&vaultsClient.Client)
+	vaultsClient.Authorizer = auth
+	vaultsClient.Sender = sender
+	c.recoveryServicesVaultsClient = vaultsClient
+
+	storageConfigsClient := recoveryservices.NewBackupStorageConfigsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&storageConfigsClient.Client)
+	storageConfigsClient.Authorizer = auth
+	storageConfigsClient.Sender = sender
+	c.recoveryServicesStorageConfigsClient = storageConfigsClient
+
+	protectionPoliciesClient := recoveryservicesbackup.NewProtectionPoliciesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&protectionPoliciesClient.Client)
+	protectionPoliciesClient.Authorizer = auth
+	protectionPoliciesClient.Sender = sender
+	c.recoveryServicesProtectionPoliciesClient = protectionPoliciesClient
+
+	protectedItemsClient := recoveryservicesbackup.NewProtectedItemsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&protectedItemsClient.Client)
+	protectedItemsClient.Authorizer = auth
+	protectedItemsClient.Sender = sender
+	c.recoveryServicesProtectedItemsClient = protectedItemsClient
+}
+
 func (armClient *ArmClient) getKeyForStorageAccount(resourceGroupName, storageAccountName string) (string, bool, error) {
 	accountKeys, err := armClient.storageServiceClient.ListKeys(resourceGroupName, storageAccountName)
 	if accountKeys.StatusCode == http.StatusNotFound {
@@ -0,0 +1,34 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+func validateDataLakeAccountName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if matched := regexp.MustCompile(`^[a-z0-9]{3,24}$`).MatchString(value); !matched {
+		errors = append(errors, fmt.Errorf("%q may only contain lower-case letters and numbers and must be between 3-24 chars", k))
+	}
+
+	return
+}
+
+func validateDataLakeFirewallRuleName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if matched := regexp.MustCompile(`^[a-zA-Z0-9_-]{3,50}$`).MatchString(value); !matched {
+		errors = append(errors, fmt.Errorf("%q may only contain alphanumeric characters, underscores and dashes and must be between 3-50 chars", k))
+	}
+
+	return
+}
+
+func validateDataLakeStoreRemoteFilePath(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !strings.HasPrefix(value, "/") {
+		errors = append(errors, fmt.Errorf("%q must start with `/`", k))
+	}
+
+	return
+}
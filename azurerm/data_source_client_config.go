@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/graphrbac"
@@ -25,6 +26,10 @@ func dataSourceArmClientConfig() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"object_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"service_principal_application_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -59,10 +64,27 @@ func dataSourceArmClientConfigRead(d *schema.ResourceData, meta interface{}) err
 		servicePrincipal = &(*listResult.Value)[0]
 	}
 
+	var objectId *string
+	if servicePrincipal != nil {
+		objectId = servicePrincipal.ObjectID
+	} else {
+		// when authenticating as a User (e.g. via the Azure CLI) the Object ID is that of the signed-in User
+		user, err := client.objectsClient.GetCurrentUser()
+		if err != nil {
+			// this isn't fatal since it's not possible to look up the signed-in User for all authentication methods (e.g. MSI)
+			log.Printf("[DEBUG] Unable to determine the Object ID of the signed-in User: %+v", err)
+		} else {
+			objectId = user.ObjectID
+		}
+	}
+
 	d.SetId(time.Now().UTC().String())
 	d.Set("client_id", client.clientId)
 	d.Set("tenant_id", client.tenantId)
 	d.Set("subscription_id", client.subscriptionId)
+	if objectId != nil {
+		d.Set("object_id", objectId)
+	}
 
 	if principal := servicePrincipal; principal != nil {
 		d.Set("service_principal_application_id", principal.AppID)
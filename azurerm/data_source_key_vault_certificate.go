@@ -0,0 +1,100 @@
+package azurerm
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmKeyVaultCertificate() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmKeyVaultCertificateRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateKeyVaultChildName,
+			},
+
+			"vault_uri": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"secret_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"certificate_data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+
+	name := d.Get("name").(string)
+	vaultUri := d.Get("vault_uri").(string)
+	// "" indicates the latest version
+	version := d.Get("version").(string)
+
+	cert, err := client.GetCertificate(vaultUri, name, version)
+	if err != nil {
+		if utils.ResponseWasNotFound(cert.Response) {
+			return fmt.Errorf("KeyVault Certificate %q (KeyVault URI %q / Version %q) does not exist", name, vaultUri, version)
+		}
+		return fmt.Errorf("Error making Read request on KeyVault Certificate %q (KeyVault URI %q / Version %q): %+v", name, vaultUri, version, err)
+	}
+
+	if cert.ID == nil {
+		return fmt.Errorf("Cannot read KeyVault Certificate %q (KeyVault URI %q) ID", name, vaultUri)
+	}
+
+	id, err := parseKeyVaultChildID(*cert.ID)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(*cert.ID)
+	d.Set("name", id.Name)
+	d.Set("vault_uri", id.KeyVaultBaseUrl)
+	d.Set("version", id.Version)
+	d.Set("secret_id", cert.Sid)
+
+	if contents := cert.Cer; contents != nil {
+		d.Set("certificate_data", strings.ToUpper(hex.EncodeToString(*contents)))
+	}
+
+	if v := cert.X509Thumbprint; v != nil {
+		thumbprint, err := flattenKeyVaultCertificateThumbprint(*v)
+		if err != nil {
+			return fmt.Errorf("Error decoding the Thumbprint for KeyVault Certificate %q (KeyVault URI %q): %+v", name, vaultUri, err)
+		}
+		d.Set("thumbprint", thumbprint)
+	}
+
+	flattenAndSetTags(d, cert.Tags)
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMKeyVaultCertificate_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_key_vault_certificate.test"
+	rs := acctest.RandString(6)
+	config := testAccDataSourceAzureRMKeyVaultCertificate_basic(rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "version", "azurerm_key_vault_certificate.test", "version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "secret_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "certificate_data"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "thumbprint"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMKeyVaultCertificate_basic(rString string, location string) string {
+	template := testAccAzureRMKeyVaultCertificate_basicGenerate(rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_key_vault_certificate" "test" {
+  name      = "${azurerm_key_vault_certificate.test.name}"
+  vault_uri = "${azurerm_key_vault_certificate.test.vault_uri}"
+}
+`, template)
+}
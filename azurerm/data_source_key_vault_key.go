@@ -0,0 +1,104 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmKeyVaultKey() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmKeyVaultKeyRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateKeyVaultChildName,
+			},
+
+			"vault_uri": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"key_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"key_opts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"n": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"e": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+
+	name := d.Get("name").(string)
+	vaultUri := d.Get("vault_uri").(string)
+	// "" indicates the latest version
+	version := d.Get("version").(string)
+
+	resp, err := client.GetKey(vaultUri, name, version)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("KeyVault Key %q (KeyVault URI %q / Version %q) does not exist", name, vaultUri, version)
+		}
+		return fmt.Errorf("Error making Read request on KeyVault Key %q (KeyVault URI %q / Version %q): %+v", name, vaultUri, version, err)
+	}
+
+	key := resp.Key
+	if key == nil || key.Kid == nil {
+		return fmt.Errorf("Cannot read KeyVault Key %q (KeyVault URI %q) ID", name, vaultUri)
+	}
+
+	id, err := parseKeyVaultChildID(*key.Kid)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(*key.Kid)
+	d.Set("name", id.Name)
+	d.Set("vault_uri", id.KeyVaultBaseUrl)
+	d.Set("version", id.Version)
+	d.Set("key_type", string(key.Kty))
+
+	options := make([]interface{}, 0)
+	if key.KeyOps != nil {
+		options = flattenKeyVaultKeyOptions(key.KeyOps)
+	}
+	if err := d.Set("key_opts", options); err != nil {
+		return fmt.Errorf("Error setting `key_opts`: %+v", err)
+	}
+
+	d.Set("n", key.N)
+	d.Set("e", key.E)
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
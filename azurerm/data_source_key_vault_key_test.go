@@ -0,0 +1,44 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMKeyVaultKey_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_key_vault_key.test"
+	rs := acctest.RandString(6)
+	config := testAccDataSourceAzureRMKeyVaultKey_basic(rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "key_type", "RSA"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "version", "azurerm_key_vault_key.test", "version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "n", "azurerm_key_vault_key.test", "n"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "e", "azurerm_key_vault_key.test", "e"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "key_opts.#", "azurerm_key_vault_key.test", "key_opts.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMKeyVaultKey_basic(rString string, location string) string {
+	template := testAccAzureRMKeyVaultKey_basicRSA(rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_key_vault_key" "test" {
+  name      = "${azurerm_key_vault_key.test.name}"
+  vault_uri = "${azurerm_key_vault_key.test.vault_uri}"
+}
+`, template)
+}
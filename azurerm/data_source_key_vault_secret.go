@@ -0,0 +1,83 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmKeyVaultSecret() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmKeyVaultSecretRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateKeyVaultChildName,
+			},
+
+			"vault_uri": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"value": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"content_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmKeyVaultSecretRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultManagementClient
+
+	name := d.Get("name").(string)
+	vaultUri := d.Get("vault_uri").(string)
+	// "" indicates the latest version
+	version := d.Get("version").(string)
+
+	resp, err := client.GetSecret(vaultUri, name, version)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("KeyVault Secret %q (KeyVault URI %q / Version %q) does not exist", name, vaultUri, version)
+		}
+		return fmt.Errorf("Error making Read request on KeyVault Secret %q (KeyVault URI %q / Version %q): %+v", name, vaultUri, version, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read KeyVault Secret %q (KeyVault URI %q) ID", name, vaultUri)
+	}
+
+	id, err := parseKeyVaultChildID(*resp.ID)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(*resp.ID)
+	d.Set("name", id.Name)
+	d.Set("vault_uri", id.KeyVaultBaseUrl)
+	d.Set("version", id.Version)
+	d.Set("value", resp.Value)
+	d.Set("content_type", resp.ContentType)
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
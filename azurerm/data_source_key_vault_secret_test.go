@@ -0,0 +1,75 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMKeyVaultSecret_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_key_vault_secret.test"
+	rs := acctest.RandString(6)
+	config := testAccDataSourceAzureRMKeyVaultSecret_basic(rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "value", "rick-and-morty"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "version"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMKeyVaultSecret_version(t *testing.T) {
+	dataSourceName := "data.azurerm_key_vault_secret.test"
+	rs := acctest.RandString(6)
+	config := testAccDataSourceAzureRMKeyVaultSecret_version(rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "value", "rick-and-morty"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "version", "azurerm_key_vault_secret.test", "version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMKeyVaultSecret_basic(rString string, location string) string {
+	template := testAccAzureRMKeyVaultSecret_basic(rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_key_vault_secret" "test" {
+  name      = "${azurerm_key_vault_secret.test.name}"
+  vault_uri = "${azurerm_key_vault_secret.test.vault_uri}"
+}
+`, template)
+}
+
+func testAccDataSourceAzureRMKeyVaultSecret_version(rString string, location string) string {
+	template := testAccAzureRMKeyVaultSecret_basic(rString, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_key_vault_secret" "test" {
+  name      = "${azurerm_key_vault_secret.test.name}"
+  vault_uri = "${azurerm_key_vault_secret.test.vault_uri}"
+  version   = "${azurerm_key_vault_secret.test.version}"
+}
+`, template)
+}
@@ -0,0 +1,80 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/arm/monitor"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmMonitorDiagnosticCategories() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmMonitorDiagnosticCategoriesRead,
+		Schema: map[string]*schema.Schema{
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"logs": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"metrics": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceArmMonitorDiagnosticCategoriesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorDiagnosticSettingsCategoryClient
+
+	resourceId := d.Get("resource_id").(string)
+
+	resp, err := monitorDiagnosticSettingsCategoryList(client, resourceId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Diagnostic Categories were not found for Resource %q", resourceId)
+		}
+
+		return fmt.Errorf("Error retrieving Diagnostic Categories for Resource %q: %+v", resourceId, err)
+	}
+
+	d.SetId(resourceId)
+
+	logs := make([]interface{}, 0)
+	metrics := make([]interface{}, 0)
+
+	if categories := resp.Value; categories != nil {
+		for _, category := range *categories {
+			if category.Name == nil || category.DiagnosticSettingsCategory == nil {
+				continue
+			}
+
+			switch category.CategoryType {
+			case monitor.Logs:
+				logs = append(logs, *category.Name)
+			case monitor.Metrics:
+				metrics = append(metrics, *category.Name)
+			}
+		}
+	}
+
+	if err := d.Set("logs", schema.NewSet(schema.HashString, logs)); err != nil {
+		return fmt.Errorf("Error setting `logs`: %+v", err)
+	}
+
+	if err := d.Set("metrics", schema.NewSet(schema.HashString, metrics)); err != nil {
+		return fmt.Errorf("Error setting `metrics`: %+v", err)
+	}
+
+	return nil
+}
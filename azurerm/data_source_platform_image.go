@@ -3,6 +3,8 @@ package azurerm
 import (
 	"fmt"
 
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -49,8 +51,11 @@ func dataSourceArmPlatformImageRead(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error reading Platform Images: %+v", err)
 	}
 
-	// the last value is the latest, apparently.
-	latestVersion := (*result.Value)[len(*result.Value)-1]
+	if result.Value == nil || len(*result.Value) == 0 {
+		return fmt.Errorf("No Platform Images were found for %q / %q / %q in %q", publisher, offer, sku, location)
+	}
+
+	latestVersion := latestPlatformImageVersion(*result.Value)
 
 	d.SetId(*latestVersion.ID)
 
@@ -62,3 +67,32 @@ func dataSourceArmPlatformImageRead(d *schema.ResourceData, meta interface{}) er
 
 	return nil
 }
+
+// latestPlatformImageVersion returns the Platform Image with the highest version. The API sorts the
+// versions by name - which orders `1.10.0` before `1.9.0` - as such the versions are compared here.
+func latestPlatformImageVersion(images []compute.VirtualMachineImageResource) compute.VirtualMachineImageResource {
+	latest := images[0]
+
+	for _, image := range images[1:] {
+		if image.Name == nil {
+			continue
+		}
+
+		if latest.Name == nil || platformImageVersionIsNewer(*image.Name, *latest.Name) {
+			latest = image
+		}
+	}
+
+	return latest
+}
+
+func platformImageVersionIsNewer(candidate string, current string) bool {
+	candidateVersion, candidateErr := version.NewVersion(candidate)
+	currentVersion, currentErr := version.NewVersion(current)
+	if candidateErr != nil || currentErr != nil {
+		// fall back to comparing the names when these aren't valid versions
+		return candidate > current
+	}
+
+	return candidateVersion.GreaterThan(currentVersion)
+}
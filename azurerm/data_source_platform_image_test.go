@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/arm/compute"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccDataSourceAzureRMPlatformImage_basic(t *testing.T) {
@@ -39,3 +41,45 @@ data "azurerm_platform_image" "test" {
 }
 `, location)
 }
+
+func TestLatestPlatformImageVersion(t *testing.T) {
+	cases := []struct {
+		Versions []string
+		Expected string
+	}{
+		{
+			Versions: []string{"16.04.201709190"},
+			Expected: "16.04.201709190",
+		},
+		{
+			Versions: []string{"16.04.201709190", "16.04.201711211", "16.04.201710110"},
+			Expected: "16.04.201711211",
+		},
+		{
+			Versions: []string{"1.10.0", "1.2.0", "1.9.0"},
+			Expected: "1.10.0",
+		},
+		{
+			Versions: []string{"2017.05.12", "2017.10.04"},
+			Expected: "2017.10.04",
+		},
+		{
+			Versions: []string{"latest-a", "latest-b"},
+			Expected: "latest-b",
+		},
+	}
+
+	for _, v := range cases {
+		images := make([]compute.VirtualMachineImageResource, 0)
+		for _, name := range v.Versions {
+			images = append(images, compute.VirtualMachineImageResource{
+				Name: utils.String(name),
+			})
+		}
+
+		actual := latestPlatformImageVersion(images)
+		if *actual.Name != v.Expected {
+			t.Fatalf("Expected the latest version of %+v to be %q but got %q", v.Versions, v.Expected, *actual.Name)
+		}
+	}
+}
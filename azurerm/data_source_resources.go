@@ -0,0 +1,191 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"required_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_group_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resourceFindClient
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	resourceType := d.Get("type").(string)
+	requiredTags := d.Get("required_tags").(map[string]interface{})
+
+	// the API doesn't support combining a Tag filter with other filters - so Tags are filtered below
+	filters := make([]string, 0)
+	if name != "" {
+		filters = append(filters, fmt.Sprintf("name eq '%s'", name))
+	}
+	if resourceType != "" {
+		filters = append(filters, fmt.Sprintf("resourceType eq '%s'", resourceType))
+	}
+	filter := strings.Join(filters, " and ")
+
+	var resp resources.ListResult
+	var err error
+	if resourceGroup != "" {
+		resp, err = client.ListByResourceGroup(resourceGroup, filter, "", nil)
+	} else {
+		resp, err = client.List(filter, "", nil)
+	}
+	if err != nil {
+		return fmt.Errorf("Error listing Resources (Filter %q / Resource Group %q): %+v", filter, resourceGroup, err)
+	}
+
+	results := make([]interface{}, 0)
+	for {
+		if values := resp.Value; values != nil {
+			for _, resource := range *values {
+				if !resourceHasTags(resource.Tags, requiredTags) {
+					continue
+				}
+
+				results = append(results, flattenArmResource(resource))
+			}
+		}
+
+		if resp.NextLink == nil || *resp.NextLink == "" {
+			break
+		}
+
+		if resourceGroup != "" {
+			resp, err = client.ListByResourceGroupNextResults(resp)
+		} else {
+			resp, err = client.ListNextResults(resp)
+		}
+		if err != nil {
+			return fmt.Errorf("Error listing Resources (Filter %q / Resource Group %q): %+v", filter, resourceGroup, err)
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("resources", results); err != nil {
+		return fmt.Errorf("Error setting `resources`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceHasTags(tags *map[string]*string, requiredTags map[string]interface{}) bool {
+	for key, value := range requiredTags {
+		if tags == nil {
+			return false
+		}
+
+		expected, err := tagValueToString(value)
+		if err != nil {
+			return false
+		}
+
+		v, ok := (*tags)[key]
+		if !ok || v == nil || *v != expected {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenArmResource(input resources.GenericResource) map[string]interface{} {
+	output := make(map[string]interface{}, 0)
+
+	if input.ID != nil {
+		output["id"] = *input.ID
+
+		if id, err := parseAzureResourceID(*input.ID); err == nil {
+			output["resource_group_name"] = id.ResourceGroup
+		}
+	}
+
+	if input.Name != nil {
+		output["name"] = *input.Name
+	}
+
+	if input.Type != nil {
+		output["type"] = *input.Type
+	}
+
+	if input.Location != nil {
+		output["location"] = azureRMNormalizeLocation(*input.Location)
+	}
+
+	tags := make(map[string]interface{}, 0)
+	if input.Tags != nil {
+		for k, v := range *input.Tags {
+			if v != nil {
+				tags[k] = *v
+			}
+		}
+	}
+	output["tags"] = tags
+
+	return output
+}
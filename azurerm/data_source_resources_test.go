@@ -0,0 +1,142 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMResources_byResourceGroup(t *testing.T) {
+	dataSourceName := "data.azurerm_resources.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMResources_byResourceGroup(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMResources_byType(t *testing.T) {
+	dataSourceName := "data.azurerm_resources.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMResources_byType(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.name", fmt.Sprintf("acctestvirtnet%d", ri)),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.type", "Microsoft.Network/virtualNetworks"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.location", azureRMNormalizeLocation(location)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMResources_byRequiredTags(t *testing.T) {
+	dataSourceName := "data.azurerm_resources.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMResources_byRequiredTags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.name", fmt.Sprintf("acctestnsg%d", ri)),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.tags.environment", "production"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMResources_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestnsg%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  tags {
+    environment = "production"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccDataSourceAzureRMResources_byResourceGroup(rInt int, location string) string {
+	template := testAccDataSourceAzureRMResources_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resources" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  depends_on = ["azurerm_virtual_network.test", "azurerm_network_security_group.test"]
+}
+`, template)
+}
+
+func testAccDataSourceAzureRMResources_byType(rInt int, location string) string {
+	template := testAccDataSourceAzureRMResources_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resources" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  type                = "Microsoft.Network/virtualNetworks"
+
+  depends_on = ["azurerm_virtual_network.test", "azurerm_network_security_group.test"]
+}
+`, template)
+}
+
+func testAccDataSourceAzureRMResources_byRequiredTags(rInt int, location string) string {
+	template := testAccDataSourceAzureRMResources_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resources" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  required_tags {
+    environment = "production"
+  }
+
+  depends_on = ["azurerm_virtual_network.test", "azurerm_network_security_group.test"]
+}
+`, template)
+}
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 
+	"github.com/Azure/azure-sdk-for-go/arm/authorization"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -10,9 +11,17 @@ func dataSourceArmRoleDefinition() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceArmRoleDefinitionRead,
 		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"role_definition_id"},
+			},
 			"role_definition_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
 			},
 			"scope": {
 				Type:     schema.TypeString,
@@ -20,10 +29,6 @@ func dataSourceArmRoleDefinition() *schema.Resource {
 			},
 
 			// Computed
-			"name": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
 			"description": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -68,15 +73,39 @@ func dataSourceArmRoleDefinition() *schema.Resource {
 func dataSourceArmRoleDefinitionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).roleDefinitionsClient
 
+	name := d.Get("name").(string)
 	roleDefinitionId := d.Get("role_definition_id").(string)
 	scope := d.Get("scope").(string)
 
-	role, err := client.Get(scope, roleDefinitionId)
-	if err != nil {
-		return fmt.Errorf("Error loadng Role Definition: %+v", err)
+	if name == "" && roleDefinitionId == "" {
+		return fmt.Errorf("One of `name` or `role_definition_id` must be specified")
+	}
+
+	var role authorization.RoleDefinition
+	if roleDefinitionId != "" {
+		resp, err := client.Get(scope, roleDefinitionId)
+		if err != nil {
+			return fmt.Errorf("Error loading Role Definition %q (Scope %q): %+v", roleDefinitionId, scope, err)
+		}
+		role = resp
+	} else {
+		filter := fmt.Sprintf("roleName eq '%s'", name)
+		resp, err := client.List(scope, filter)
+		if err != nil {
+			return fmt.Errorf("Error loading Role Definition List for %q (Scope %q): %+v", name, scope, err)
+		}
+		if resp.Value == nil || len(*resp.Value) != 1 {
+			return fmt.Errorf("Error loading Role Definition %q (Scope %q): expected exactly one Role Definition to be returned", name, scope)
+		}
+		role = (*resp.Value)[0]
+	}
+
+	if role.ID == nil {
+		return fmt.Errorf("Cannot read Role Definition ID (Scope %q)", scope)
 	}
 
 	d.SetId(*role.ID)
+	d.Set("role_definition_id", role.Name)
 
 	if props := role.Properties; props != nil {
 		d.Set("name", props.RoleName)
@@ -38,6 +38,49 @@ func TestAccDataSourceAzureRMRoleDefinition_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceAzureRMRoleDefinition_byName(t *testing.T) {
+	dataSourceName := "data.azurerm_role_definition.test"
+
+	id := uuid.New().String()
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceRoleDefinition_byName(id, ri),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "azurerm_role_definition.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "role_definition_id", id),
+					resource.TestCheckResourceAttr(dataSourceName, "permissions.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "permissions.0.actions.0", "*"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMRoleDefinition_builtInByName(t *testing.T) {
+	dataSourceName := "data.azurerm_role_definition.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceRoleDefinition_builtInByName("Contributor"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "Contributor"),
+					resource.TestCheckResourceAttr(dataSourceName, "role_definition_id", "b24988ac-6180-42a0-ab88-20f7382dd24c"),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "BuiltInRole"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceRoleDefinition(id string, rInt int) string {
 	return fmt.Sprintf(`
 data "azurerm_subscription" "primary" {}
@@ -68,3 +111,40 @@ data "azurerm_role_definition" "test" {
 }
 `, id, rInt)
 }
+
+func testAccDataSourceRoleDefinition_byName(id string, rInt int) string {
+	return fmt.Sprintf(`
+data "azurerm_subscription" "primary" {}
+
+resource "azurerm_role_definition" "test" {
+  role_definition_id = "%s"
+  name               = "acctestrd-%d"
+  scope              = "${data.azurerm_subscription.primary.id}"
+
+  permissions {
+    actions     = ["*"]
+    not_actions = []
+  }
+
+  assignable_scopes = [
+    "${data.azurerm_subscription.primary.id}",
+  ]
+}
+
+data "azurerm_role_definition" "test" {
+  name  = "${azurerm_role_definition.test.name}"
+  scope = "${data.azurerm_subscription.primary.id}"
+}
+`, id, rInt)
+}
+
+func testAccDataSourceRoleDefinition_builtInByName(name string) string {
+	return fmt.Sprintf(`
+data "azurerm_subscription" "primary" {}
+
+data "azurerm_role_definition" "test" {
+  name  = "%s"
+  scope = "${data.azurerm_subscription.primary.id}"
+}
+`, name)
+}
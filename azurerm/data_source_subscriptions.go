@@ -0,0 +1,145 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/resources/subscriptions"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmSubscriptions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmSubscriptionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"display_name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"display_name_contains": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"subscriptions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subscription_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"location_placement_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"quota_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"spending_limit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmSubscriptionsRead(d *schema.ResourceData, meta interface{}) error {
+	groupClient := meta.(*ArmClient).subscriptionsGroupClient
+
+	displayNamePrefix := strings.ToLower(d.Get("display_name_prefix").(string))
+	displayNameContains := strings.ToLower(d.Get("display_name_contains").(string))
+
+	resp, err := groupClient.List()
+	if err != nil {
+		return fmt.Errorf("Error listing subscriptions: %+v", err)
+	}
+
+	results := make([]interface{}, 0)
+	for {
+		if values := resp.Value; values != nil {
+			for _, subscription := range *values {
+				displayName := ""
+				if subscription.DisplayName != nil {
+					displayName = strings.ToLower(*subscription.DisplayName)
+				}
+
+				if displayNamePrefix != "" && !strings.HasPrefix(displayName, displayNamePrefix) {
+					continue
+				}
+
+				if displayNameContains != "" && !strings.Contains(displayName, displayNameContains) {
+					continue
+				}
+
+				results = append(results, flattenArmSubscription(subscription))
+			}
+		}
+
+		if resp.NextLink == nil || *resp.NextLink == "" {
+			break
+		}
+
+		resp, err = groupClient.ListNextResults(resp)
+		if err != nil {
+			return fmt.Errorf("Error listing subscriptions: %+v", err)
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("subscriptions", results); err != nil {
+		return fmt.Errorf("Error setting `subscriptions`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenArmSubscription(input subscriptions.Subscription) map[string]interface{} {
+	output := map[string]interface{}{
+		"state": string(input.State),
+	}
+
+	if input.SubscriptionID != nil {
+		output["subscription_id"] = *input.SubscriptionID
+	}
+
+	if input.DisplayName != nil {
+		output["display_name"] = *input.DisplayName
+	}
+
+	if policies := input.SubscriptionPolicies; policies != nil {
+		if policies.LocationPlacementID != nil {
+			output["location_placement_id"] = *policies.LocationPlacementID
+		}
+
+		if policies.QuotaID != nil {
+			output["quota_id"] = *policies.QuotaID
+		}
+
+		output["spending_limit"] = string(policies.SpendingLimit)
+	}
+
+	return output
+}
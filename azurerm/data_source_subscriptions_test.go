@@ -0,0 +1,75 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataSourceAzureRMSubscriptions_basic(t *testing.T) {
+	resourceName := "data.azurerm_subscriptions.current"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "azurerm_subscriptions" "current" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "subscriptions.0.subscription_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "subscriptions.0.display_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "subscriptions.0.state"),
+					testCheckAzureRMSubscriptionsContainsCurrent(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMSubscriptions_displayNamePrefix(t *testing.T) {
+	resourceName := "data.azurerm_subscriptions.filtered"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMSubscriptions_displayNamePrefix(),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubscriptionsContainsCurrent(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSubscriptionsContainsCurrent(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		subscriptionId := os.Getenv("ARM_SUBSCRIPTION_ID")
+		for _, v := range rs.Primary.Attributes {
+			if v == subscriptionId {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%s: the current Subscription ID wasn't returned", name)
+	}
+}
+
+func testAccDataSourceAzureRMSubscriptions_displayNamePrefix() string {
+	return `
+data "azurerm_subscription" "current" {}
+
+data "azurerm_subscriptions" "filtered" {
+  display_name_prefix = "${substr(data.azurerm_subscription.current.display_name, 0, 3)}"
+}
+`
+}
@@ -0,0 +1,181 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/arm/devtestlabs"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func devTestGalleryImageReferenceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"offer": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"publisher": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"sku": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"version": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func devTestVirtualMachineInboundNatRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"protocol": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(devtestlabs.TCP),
+						string(devtestlabs.UDP),
+					}, false),
+				},
+
+				"backend_port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(1, 65535),
+				},
+
+				"frontend_port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func expandDevTestGalleryImageReference(input []interface{}, osType string) *devtestlabs.GalleryImageReference {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &devtestlabs.GalleryImageReference{
+		Offer:     utils.String(v["offer"].(string)),
+		OsType:    utils.String(osType),
+		Publisher: utils.String(v["publisher"].(string)),
+		Sku:       utils.String(v["sku"].(string)),
+		Version:   utils.String(v["version"].(string)),
+	}
+}
+
+func flattenDevTestGalleryImageReference(input *devtestlabs.GalleryImageReference) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input != nil {
+		output := make(map[string]interface{}, 0)
+
+		if input.Offer != nil {
+			output["offer"] = *input.Offer
+		}
+
+		if input.Publisher != nil {
+			output["publisher"] = *input.Publisher
+		}
+
+		if input.Sku != nil {
+			output["sku"] = *input.Sku
+		}
+
+		if input.Version != nil {
+			output["version"] = *input.Version
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func expandDevTestVirtualMachineInboundNatRules(input []interface{}) []devtestlabs.InboundNatRule {
+	rules := make([]devtestlabs.InboundNatRule, 0)
+
+	for _, val := range input {
+		v := val.(map[string]interface{})
+		backendPort := v["backend_port"].(int)
+		protocol := v["protocol"].(string)
+
+		rule := devtestlabs.InboundNatRule{
+			TransportProtocol: devtestlabs.TransportProtocol(protocol),
+			BackendPort:       utils.Int32(int32(backendPort)),
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func flattenDevTestVirtualMachineInboundNatRules(input *devtestlabs.NetworkInterfaceProperties) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.SharedPublicIPAddressConfiguration == nil || input.SharedPublicIPAddressConfiguration.InboundNatRules == nil {
+		return results
+	}
+
+	for _, rule := range *input.SharedPublicIPAddressConfiguration.InboundNatRules {
+		output := map[string]interface{}{
+			"protocol": string(rule.TransportProtocol),
+		}
+
+		if rule.BackendPort != nil {
+			output["backend_port"] = int(*rule.BackendPort)
+		}
+
+		if rule.FrontendPort != nil {
+			output["frontend_port"] = int(*rule.FrontendPort)
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func validateDevTestResourceName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[A-Za-z0-9_-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q may only contain letters, numbers, underscores and hyphens: %q", k, value))
+	}
+
+	return
+}
+
+func validateDevTestUsagePermissionType() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		string(devtestlabs.Allow),
+		string(devtestlabs.Default),
+		string(devtestlabs.Deny),
+	}, false)
+}
@@ -0,0 +1,96 @@
+package azurerm
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Features contains the opt-in behaviours which can be configured in the `features` block,
+// such as what happens to dependent infrastructure when a resource is destroyed.
+type Features struct {
+	KeyVault       KeyVaultFeatures
+	VirtualMachine VirtualMachineFeatures
+}
+
+type KeyVaultFeatures struct {
+	PurgeSoftDeleteOnDestroy bool
+}
+
+type VirtualMachineFeatures struct {
+	DeleteOSDiskOnDeletion    bool
+	DeleteDataDisksOnDeletion bool
+}
+
+func schemaFeatures() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key_vault": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"purge_soft_delete_on_destroy": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+
+				"virtual_machine": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"delete_os_disk_on_deletion": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+
+							"delete_data_disks_on_deletion": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandFeatures(input []interface{}) Features {
+	features := Features{}
+	if len(input) == 0 || input[0] == nil {
+		return features
+	}
+
+	val := input[0].(map[string]interface{})
+
+	if raw, ok := val["key_vault"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			v := items[0].(map[string]interface{})
+			features.KeyVault.PurgeSoftDeleteOnDestroy = v["purge_soft_delete_on_destroy"].(bool)
+		}
+	}
+
+	if raw, ok := val["virtual_machine"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			v := items[0].(map[string]interface{})
+			features.VirtualMachine.DeleteOSDiskOnDeletion = v["delete_os_disk_on_deletion"].(bool)
+			features.VirtualMachine.DeleteDataDisksOnDeletion = v["delete_data_disks_on_deletion"].(bool)
+		}
+	}
+
+	return features
+}
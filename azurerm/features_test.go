@@ -0,0 +1,63 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandFeatures(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    []interface{}
+		Expected Features
+	}{
+		{
+			Name:     "Empty Block",
+			Input:    []interface{}{},
+			Expected: Features{},
+		},
+		{
+			Name: "Key Vault Purge Soft Delete",
+			Input: []interface{}{
+				map[string]interface{}{
+					"key_vault": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": true,
+						},
+					},
+				},
+			},
+			Expected: Features{
+				KeyVault: KeyVaultFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+				},
+			},
+		},
+		{
+			Name: "Virtual Machine Disks",
+			Input: []interface{}{
+				map[string]interface{}{
+					"key_vault": []interface{}{},
+					"virtual_machine": []interface{}{
+						map[string]interface{}{
+							"delete_os_disk_on_deletion":    true,
+							"delete_data_disks_on_deletion": false,
+						},
+					},
+				},
+			},
+			Expected: Features{
+				VirtualMachine: VirtualMachineFeatures{
+					DeleteOSDiskOnDeletion: true,
+				},
+			},
+		},
+	}
+
+	for _, v := range cases {
+		actual := expandFeatures(v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,653 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/hdinsight"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// hdInsightNodeDefinition describes the constraints which apply to a given Role (e.g. the Head Nodes) within
+// a specific kind of HDInsight Cluster
+type hdInsightNodeDefinition struct {
+	CanSpecifyInstanceCount  bool
+	MinInstanceCount         int
+	MaxInstanceCount         int
+	FixedMinInstanceCount    *int32
+	FixedTargetInstanceCount *int32
+}
+
+var hdInsightHeadNodeDefinition = hdInsightNodeDefinition{
+	CanSpecifyInstanceCount:  false,
+	MinInstanceCount:         2,
+	MaxInstanceCount:         2,
+	FixedTargetInstanceCount: utils.Int32(int32(2)),
+}
+
+var hdInsightWorkerNodeDefinition = hdInsightNodeDefinition{
+	CanSpecifyInstanceCount: true,
+	MinInstanceCount:        1,
+	MaxInstanceCount:        200,
+}
+
+var hdInsightZookeeperNodeDefinition = hdInsightNodeDefinition{
+	CanSpecifyInstanceCount:  false,
+	MinInstanceCount:         3,
+	MaxInstanceCount:         3,
+	FixedTargetInstanceCount: utils.Int32(int32(3)),
+}
+
+func hdInsightNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+}
+
+func hdInsightClusterVersionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+}
+
+func hdInsightTierSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(hdinsight.Standard),
+			string(hdinsight.Premium),
+		}, false),
+	}
+}
+
+func hdInsightComponentVersionSchema(schemaKey string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				schemaKey: {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func hdInsightGatewaySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				// NOTE: this is currently hard-coded since the API doesn't support disabling the Gateway
+				"enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+				"username": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"password": {
+					Type:      schema.TypeString,
+					Required:  true,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+}
+
+func hdInsightStorageAccountsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"storage_account_name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"storage_account_key": {
+					Type:      schema.TypeString,
+					Required:  true,
+					ForceNew:  true,
+					Sensitive: true,
+				},
+				"storage_container_name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"is_default": {
+					Type:     schema.TypeBool,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func hdInsightMetastoresSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"hive": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"server": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"database_name": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"username": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"password": {
+								Type:      schema.TypeString,
+								Required:  true,
+								ForceNew:  true,
+								Sensitive: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func hdInsightRolesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"head_node":      hdInsightNodeSchema("roles.0.head_node", hdInsightHeadNodeDefinition),
+				"worker_node":    hdInsightNodeSchema("roles.0.worker_node", hdInsightWorkerNodeDefinition),
+				"zookeeper_node": hdInsightNodeSchema("roles.0.zookeeper_node", hdInsightZookeeperNodeDefinition),
+			},
+		},
+	}
+}
+
+func hdInsightNodeSchema(schemaLocation string, definition hdInsightNodeDefinition) *schema.Schema {
+	result := map[string]*schema.Schema{
+		"vm_size": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+		},
+		"username": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"password": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			ForceNew:  true,
+			Sensitive: true,
+		},
+		"ssh_keys": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			ForceNew: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Set:           schema.HashString,
+			ConflictsWith: []string{fmt.Sprintf("%s.0.password", schemaLocation)},
+		},
+		"subnet_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+		"virtual_network_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+	}
+
+	if definition.CanSpecifyInstanceCount {
+		result["min_instance_count"] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(definition.MinInstanceCount, definition.MaxInstanceCount),
+		}
+		result["target_instance_count"] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(definition.MinInstanceCount, definition.MaxInstanceCount),
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: result,
+		},
+	}
+}
+
+func expandHDInsightComponentVersion(schemaKey string, componentName string, input []interface{}) *map[string]*string {
+	vs := input[0].(map[string]interface{})
+	return &map[string]*string{
+		componentName: utils.String(vs[schemaKey].(string)),
+	}
+}
+
+func flattenHDInsightComponentVersion(schemaKey string, componentName string, input *map[string]*string) []interface{} {
+	version := ""
+	if input != nil {
+		for k, v := range *input {
+			if strings.EqualFold(k, componentName) && v != nil {
+				version = *v
+			}
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			schemaKey: version,
+		},
+	}
+}
+
+func expandHDInsightConfigurations(gateway []interface{}, metastores []interface{}) *map[string]interface{} {
+	vs := gateway[0].(map[string]interface{})
+
+	// NOTE: this is hard-coded to `true` since the API appears to ignore this value
+	configurations := map[string]interface{}{
+		string(hdinsight.Gateway): map[string]interface{}{
+			"restAuthCredential.isEnabled": true,
+			"restAuthCredential.username":  vs["username"].(string),
+			"restAuthCredential.password":  vs["password"].(string),
+		},
+	}
+
+	if len(metastores) > 0 {
+		metastore := metastores[0].(map[string]interface{})
+		if hives := metastore["hive"].([]interface{}); len(hives) > 0 {
+			hive := hives[0].(map[string]interface{})
+			server := hive["server"].(string)
+			database := hive["database_name"].(string)
+
+			configurations["hive-site"] = map[string]interface{}{
+				"javax.jdo.option.ConnectionDriverName": "com.microsoft.sqlserver.jdbc.SQLServerDriver",
+				"javax.jdo.option.ConnectionURL":        fmt.Sprintf("jdbc:sqlserver://%s;database=%s;encrypt=true;trustServerCertificate=true;create=false;loginTimeout=300", server, database),
+				"javax.jdo.option.ConnectionUserName":   hive["username"].(string),
+				"javax.jdo.option.ConnectionPassword":   hive["password"].(string),
+			}
+			configurations["hive-env"] = map[string]interface{}{
+				"hive_database":                       "Existing MSSQL Server database with SQL authentication",
+				"hive_database_name":                  database,
+				"hive_database_type":                  "mssql",
+				"hive_existing_mssql_server_database": database,
+				"hive_existing_mssql_server_host":     server,
+				"hive_hostname":                       server,
+			}
+		}
+	}
+
+	return &configurations
+}
+
+func flattenHDInsightGateway(input hdinsight.HTTPConnectivitySettings, d *schema.ResourceData) []interface{} {
+	enabled := true
+	if input.EnabledCredential != nil {
+		enabled = strings.EqualFold(*input.EnabledCredential, "true")
+	}
+
+	username := ""
+	if input.Username != nil {
+		username = *input.Username
+	}
+
+	// the password isn't returned from the API, so we pull it from the config
+	password := ""
+	if v, ok := d.GetOk("gateway.0.password"); ok {
+		password = v.(string)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":  enabled,
+			"username": username,
+			"password": password,
+		},
+	}
+}
+
+func expandHDInsightStorageAccounts(input []interface{}, storageEndpointSuffix string) *[]hdinsight.StorageAccount {
+	results := make([]hdinsight.StorageAccount, 0)
+
+	for _, raw := range input {
+		vs := raw.(map[string]interface{})
+
+		name := fmt.Sprintf("%s.blob.%s", vs["storage_account_name"].(string), storageEndpointSuffix)
+		results = append(results, hdinsight.StorageAccount{
+			Name:      utils.String(name),
+			Key:       utils.String(vs["storage_account_key"].(string)),
+			Container: utils.String(vs["storage_container_name"].(string)),
+			IsDefault: utils.Bool(vs["is_default"].(bool)),
+		})
+	}
+
+	return &results
+}
+
+func expandHDInsightRoles(input []interface{}) *[]hdinsight.Role {
+	v := input[0].(map[string]interface{})
+
+	headNode := expandHDInsightNodeDefinition("headnode", v["head_node"].([]interface{}), hdInsightHeadNodeDefinition)
+	workerNode := expandHDInsightNodeDefinition("workernode", v["worker_node"].([]interface{}), hdInsightWorkerNodeDefinition)
+	zookeeperNode := expandHDInsightNodeDefinition("zookeepernode", v["zookeeper_node"].([]interface{}), hdInsightZookeeperNodeDefinition)
+
+	return &[]hdinsight.Role{
+		headNode,
+		workerNode,
+		zookeeperNode,
+	}
+}
+
+func expandHDInsightNodeDefinition(name string, input []interface{}, definition hdInsightNodeDefinition) hdinsight.Role {
+	v := input[0].(map[string]interface{})
+
+	linuxProfile := hdinsight.LinuxOperatingSystemProfile{
+		Username: utils.String(v["username"].(string)),
+	}
+
+	if password := v["password"].(string); password != "" {
+		linuxProfile.Password = utils.String(password)
+	}
+
+	sshKeys := make([]hdinsight.SSHPublicKey, 0)
+	for _, key := range v["ssh_keys"].(*schema.Set).List() {
+		sshKeys = append(sshKeys, hdinsight.SSHPublicKey{
+			CertificateData: utils.String(key.(string)),
+		})
+	}
+	if len(sshKeys) > 0 {
+		linuxProfile.SSHProfile = &hdinsight.SSHProfile{
+			PublicKeys: &sshKeys,
+		}
+	}
+
+	role := hdinsight.Role{
+		Name: utils.String(name),
+		HardwareProfile: &hdinsight.HardwareProfile{
+			VMSize: utils.String(v["vm_size"].(string)),
+		},
+		OsProfile: &hdinsight.OsProfile{
+			LinuxOperatingSystemProfile: &linuxProfile,
+		},
+	}
+
+	virtualNetworkId := v["virtual_network_id"].(string)
+	subnetId := v["subnet_id"].(string)
+	if virtualNetworkId != "" && subnetId != "" {
+		role.VirtualNetworkProfile = &hdinsight.VirtualNetworkProfile{
+			ID:     utils.String(virtualNetworkId),
+			Subnet: utils.String(subnetId),
+		}
+	}
+
+	if definition.CanSpecifyInstanceCount {
+		minInstanceCount := v["min_instance_count"].(int)
+		if minInstanceCount > 0 {
+			role.MinInstanceCount = utils.Int32(int32(minInstanceCount))
+		}
+
+		targetInstanceCount := v["target_instance_count"].(int)
+		role.TargetInstanceCount = utils.Int32(int32(targetInstanceCount))
+	} else {
+		role.MinInstanceCount = definition.FixedMinInstanceCount
+		role.TargetInstanceCount = definition.FixedTargetInstanceCount
+	}
+
+	return role
+}
+
+func flattenHDInsightRoles(d *schema.ResourceData, input *hdinsight.ComputeProfile) []interface{} {
+	if input == nil || input.Roles == nil {
+		return []interface{}{}
+	}
+
+	var headNode, workerNode, zookeeperNode *hdinsight.Role
+	for _, role := range *input.Roles {
+		if role.Name == nil {
+			continue
+		}
+
+		// we need a local copy of the role since we're taking the address
+		r := role
+		switch strings.ToLower(*role.Name) {
+		case "headnode":
+			headNode = &r
+		case "workernode":
+			workerNode = &r
+		case "zookeepernode":
+			zookeeperNode = &r
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"head_node":      flattenHDInsightRoleDefinition(d, "roles.0.head_node", headNode, hdInsightHeadNodeDefinition),
+			"worker_node":    flattenHDInsightRoleDefinition(d, "roles.0.worker_node", workerNode, hdInsightWorkerNodeDefinition),
+			"zookeeper_node": flattenHDInsightRoleDefinition(d, "roles.0.zookeeper_node", zookeeperNode, hdInsightZookeeperNodeDefinition),
+		},
+	}
+}
+
+func flattenHDInsightRoleDefinition(d *schema.ResourceData, schemaLocation string, input *hdinsight.Role, definition hdInsightNodeDefinition) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := map[string]interface{}{
+		"username":           "",
+		"password":           "",
+		"ssh_keys":           schema.NewSet(schema.HashString, []interface{}{}),
+		"subnet_id":          "",
+		"virtual_network_id": "",
+		"vm_size":            "",
+	}
+
+	if profile := input.OsProfile; profile != nil {
+		if osProfile := profile.LinuxOperatingSystemProfile; osProfile != nil {
+			if osProfile.Username != nil {
+				output["username"] = *osProfile.Username
+			}
+		}
+	}
+
+	// neither Passwords or SSH Keys are returned from the API, so we pull them from the config
+	if v, ok := d.GetOk(fmt.Sprintf("%s.0.password", schemaLocation)); ok {
+		output["password"] = v.(string)
+	}
+	if v, ok := d.GetOk(fmt.Sprintf("%s.0.ssh_keys", schemaLocation)); ok {
+		output["ssh_keys"] = v.(*schema.Set)
+	}
+
+	if profile := input.VirtualNetworkProfile; profile != nil {
+		if profile.ID != nil {
+			output["virtual_network_id"] = *profile.ID
+		}
+		if profile.Subnet != nil {
+			output["subnet_id"] = *profile.Subnet
+		}
+	}
+
+	if profile := input.HardwareProfile; profile != nil {
+		if profile.VMSize != nil {
+			output["vm_size"] = *profile.VMSize
+		}
+	}
+
+	if definition.CanSpecifyInstanceCount {
+		output["min_instance_count"] = 0
+		if input.MinInstanceCount != nil {
+			output["min_instance_count"] = int(*input.MinInstanceCount)
+		}
+
+		output["target_instance_count"] = 0
+		if input.TargetInstanceCount != nil {
+			output["target_instance_count"] = int(*input.TargetInstanceCount)
+		}
+	}
+
+	return []interface{}{output}
+}
+
+func flattenHDInsightConnectivityEndpoints(input *[]hdinsight.ConnectivityEndpoint) (string, string) {
+	httpsEndpoint := ""
+	sshEndpoint := ""
+
+	if input != nil {
+		for _, v := range *input {
+			if v.Location == nil || v.Name == nil {
+				continue
+			}
+
+			switch strings.ToLower(*v.Name) {
+			case "https":
+				httpsEndpoint = *v.Location
+			case "ssh":
+				sshEndpoint = *v.Location
+			}
+		}
+	}
+
+	return httpsEndpoint, sshEndpoint
+}
+
+func hdInsightClusterUpdate(clusterKind string, readFunc schema.ReadFunc) schema.UpdateFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client := meta.(*ArmClient).hdinsightClustersClient
+
+		id, err := parseAzureResourceID(d.Id())
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		name := id.Path["clusters"]
+
+		if d.HasChange("tags") || d.HasChange("default_tags_applied") {
+			tags := d.Get("tags").(map[string]interface{})
+			params := hdinsight.ClusterPatchParameters{
+				Tags: expandResourceTags(d, tags, meta),
+			}
+			if _, err := client.Update(resourceGroup, name, params); err != nil {
+				return fmt.Errorf("Error updating Tags for HDInsight %q Cluster %q (Resource Group %q): %+v", clusterKind, name, resourceGroup, err)
+			}
+		}
+
+		if d.HasChange("roles") {
+			log.Printf("[DEBUG] Resizing the HDInsight %q Cluster", clusterKind)
+			rolesRaw := d.Get("roles").([]interface{})
+			roles := rolesRaw[0].(map[string]interface{})
+			workerNodes := roles["worker_node"].([]interface{})
+			workerNode := workerNodes[0].(map[string]interface{})
+			targetInstanceCount := workerNode["target_instance_count"].(int)
+			params := hdinsight.ClusterResizeParameters{
+				TargetInstanceCount: utils.Int32(int32(targetInstanceCount)),
+			}
+
+			cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+			defer release()
+			_, errChan := client.Resize(resourceGroup, name, "workernode", params, cancel)
+			if err := <-errChan; err != nil {
+				return fmt.Errorf("Error resizing the HDInsight %q Cluster %q (Resource Group %q): %+v", clusterKind, name, resourceGroup, err)
+			}
+		}
+
+		if d.HasChange("gateway") {
+			configurationsClient := meta.(*ArmClient).hdinsightConfigurationsClient
+			gateways := d.Get("gateway").([]interface{})
+			gateway := gateways[0].(map[string]interface{})
+			params := hdinsight.HTTPConnectivitySettings{
+				EnabledCredential: utils.String("true"),
+				Username:          utils.String(gateway["username"].(string)),
+				Password:          utils.String(gateway["password"].(string)),
+			}
+
+			cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+			defer release()
+			_, errChan := configurationsClient.UpdateHTTPSettings(resourceGroup, name, string(hdinsight.Gateway), params, cancel)
+			if err := <-errChan; err != nil {
+				return fmt.Errorf("Error updating the Gateway for HDInsight %q Cluster %q (Resource Group %q): %+v", clusterKind, name, resourceGroup, err)
+			}
+		}
+
+		return readFunc(d, meta)
+	}
+}
+
+func hdInsightClusterDelete(clusterKind string) schema.DeleteFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client := meta.(*ArmClient).hdinsightClustersClient
+
+		id, err := parseAzureResourceID(d.Id())
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		name := id.Path["clusters"]
+
+		cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+		defer release()
+		_, errChan := client.Delete(resourceGroup, name, cancel)
+		if err := <-errChan; err != nil {
+			return fmt.Errorf("Error deleting HDInsight %q Cluster %q (Resource Group %q): %+v", clusterKind, name, resourceGroup, err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,88 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testCheckAzureRMHDInsightClusterDestroy(terraformResourceName string) func(s *terraform.State) error {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != terraformResourceName {
+				continue
+			}
+
+			client := testAccProvider.Meta().(*ArmClient).hdinsightClustersClient
+			name := rs.Primary.Attributes["name"]
+			resourceGroup := rs.Primary.Attributes["resource_group_name"]
+			resp, err := client.Get(resourceGroup, name)
+
+			if err != nil {
+				if resp.StatusCode == http.StatusNotFound {
+					return nil
+				}
+
+				return err
+			}
+
+			return fmt.Errorf("HDInsight Cluster still exists:\n%#v", resp)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMHDInsightClusterExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		clusterName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for HDInsight Cluster: %s", clusterName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).hdinsightClustersClient
+		resp, err := client.Get(resourceGroup, clusterName)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: HDInsight Cluster %q (Resource Group: %q) does not exist", clusterName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on hdinsightClustersClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMHDInsightCluster_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "acctest"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+`, rInt, location, rString)
+}
@@ -0,0 +1,34 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMAutomationDscNodeConfiguration_importBasic(t *testing.T) {
+	resourceName := "azurerm_automation_dsc_nodeconfiguration.test"
+
+	ri := acctest.RandInt()
+	config := testAccAzureRMAutomationDscNodeConfiguration_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationDscNodeConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the content of a Node Configuration isn't returned from the API
+				ImportStateVerifyIgnore: []string{"content_embedded"},
+			},
+		},
+	})
+}
@@ -0,0 +1,33 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMCdnEndpointCustomDomain_importBasic(t *testing.T) {
+	zoneName, zoneResourceGroup := testAccAzureRMCdnEndpointCustomDomainPreCheck(t)
+	resourceName := "azurerm_cdn_endpoint_custom_domain.test"
+
+	ri := acctest.RandInt()
+	config := testAccAzureRMCdnEndpointCustomDomain_basic(ri, testLocation(), zoneName, zoneResourceGroup, false)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCdnEndpointCustomDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
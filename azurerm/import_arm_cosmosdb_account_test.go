@@ -174,3 +174,27 @@ func TestAccAzureRMCosmosDBAccount_importGeoReplicated(t *testing.T) {
 		},
 	})
 }
+
+func TestAccAzureRMCosmosDBAccount_importAutomaticFailover(t *testing.T) {
+	resourceName := "azurerm_cosmosdb_account.test"
+
+	ri := acctest.RandInt()
+	config := testAccAzureRMCosmosDBAccount_automaticFailover(ri, testLocation(), testAltLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,31 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMSqlActiveDirectoryAdministrator_importBasic(t *testing.T) {
+	resourceName := "azurerm_sql_active_directory_administrator.test"
+
+	ri := acctest.RandInt()
+	config := testAccAzureRMSqlActiveDirectoryAdministrator_basic(ri, testLocation(), "sqladmin")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlActiveDirectoryAdministratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
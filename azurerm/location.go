@@ -3,6 +3,7 @@ package azurerm
 import (
 	"strings"
 
+	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -46,3 +47,8 @@ func azureRMNormalizeLocation(location interface{}) string {
 func azureRMSuppressLocationDiff(k, old, new string, d *schema.ResourceData) bool {
 	return azureRMNormalizeLocation(old) == azureRMNormalizeLocation(new)
 }
+
+// azureRMHashLocation hashes a location after normalising it, for use within a TypeSet of locations
+func azureRMHashLocation(location interface{}) int {
+	return hashcode.String(azureRMNormalizeLocation(location))
+}
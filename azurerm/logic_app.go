@@ -0,0 +1,163 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/logic"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Triggers and Actions are stored within the Definition of the Logic App Workflow, rather than as separate
+// resources in the API - so we lock on the Logic App Workflow whilst updating the Definition
+const logicAppResourceName = "azurerm_logic_app"
+
+func resourceLogicAppComponentUpdate(d *schema.ResourceData, meta interface{}, kind string, propertyName string, logicAppId string, name string, vals map[string]interface{}) error {
+	client := meta.(*ArmClient).logicWorkflowsClient
+
+	id, err := parseAzureResourceID(logicAppId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	logicAppName := id.Path["workflows"]
+
+	azureRMLockByName(logicAppName, logicAppResourceName)
+	defer azureRMUnlockByName(logicAppName, logicAppResourceName)
+
+	read, err := client.Get(resourceGroup, logicAppName)
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			return fmt.Errorf("Logic App Workflow %q (Resource Group %q) was not found", logicAppName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Logic App Workflow %q (Resource Group %q): %+v", logicAppName, resourceGroup, err)
+	}
+
+	if read.WorkflowProperties == nil || read.WorkflowProperties.Definition == nil {
+		return fmt.Errorf("Error parsing Logic App Workflow %q (Resource Group %q) - `properties.definition` was nil", logicAppName, resourceGroup)
+	}
+
+	definition := *read.WorkflowProperties.Definition
+
+	components := make(map[string]interface{}, 0)
+	if v, ok := definition[propertyName].(map[string]interface{}); ok {
+		components = v
+	}
+
+	if d.IsNewResource() {
+		if _, exists := components[name]; exists {
+			return fmt.Errorf("A %s named %q already exists in Logic App Workflow %q (Resource Group %q) - these must be unique", kind, name, logicAppName, resourceGroup)
+		}
+	}
+
+	components[name] = vals
+	definition[propertyName] = components
+
+	read.WorkflowProperties.Definition = &definition
+	if _, err := client.CreateOrUpdate(resourceGroup, logicAppName, expandLogicAppWorkflowForUpdate(read)); err != nil {
+		return fmt.Errorf("Error adding %s %q to Logic App Workflow %q (Resource Group %q): %+v", kind, name, logicAppName, resourceGroup, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", logicAppId, propertyName, name))
+
+	return nil
+}
+
+func resourceLogicAppComponentRemove(d *schema.ResourceData, meta interface{}, kind string, propertyName string, name string) error {
+	client := meta.(*ArmClient).logicWorkflowsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	logicAppName := id.Path["workflows"]
+
+	azureRMLockByName(logicAppName, logicAppResourceName)
+	defer azureRMUnlockByName(logicAppName, logicAppResourceName)
+
+	read, err := client.Get(resourceGroup, logicAppName)
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			// the Logic App Workflow (and therefore the component) has already been removed
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Logic App Workflow %q (Resource Group %q): %+v", logicAppName, resourceGroup, err)
+	}
+
+	if read.WorkflowProperties == nil || read.WorkflowProperties.Definition == nil {
+		return nil
+	}
+
+	definition := *read.WorkflowProperties.Definition
+	components, ok := definition[propertyName].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if _, exists := components[name]; !exists {
+		return nil
+	}
+
+	delete(components, name)
+	definition[propertyName] = components
+
+	read.WorkflowProperties.Definition = &definition
+	if _, err := client.CreateOrUpdate(resourceGroup, logicAppName, expandLogicAppWorkflowForUpdate(read)); err != nil {
+		return fmt.Errorf("Error removing %s %q from Logic App Workflow %q (Resource Group %q): %+v", kind, name, logicAppName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// retrieveLogicAppComponent returns the Trigger or Action from the Definition of the Logic App Workflow, which is nil
+// when either the Logic App Workflow or the component doesn't exist
+func retrieveLogicAppComponent(meta interface{}, resourceGroup string, logicAppName string, propertyName string, name string) (map[string]interface{}, *logic.Workflow, error) {
+	client := meta.(*ArmClient).logicWorkflowsClient
+
+	read, err := client.Get(resourceGroup, logicAppName)
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			return nil, nil, nil
+		}
+
+		return nil, nil, fmt.Errorf("Error retrieving Logic App Workflow %q (Resource Group %q): %+v", logicAppName, resourceGroup, err)
+	}
+
+	if read.WorkflowProperties == nil || read.WorkflowProperties.Definition == nil {
+		return nil, &read, nil
+	}
+
+	definition := *read.WorkflowProperties.Definition
+	components, ok := definition[propertyName].(map[string]interface{})
+	if !ok {
+		return nil, &read, nil
+	}
+
+	component, ok := components[name].(map[string]interface{})
+	if !ok {
+		log.Printf("[DEBUG] %q %q was not found in Logic App Workflow %q (Resource Group %q)", propertyName, name, logicAppName, resourceGroup)
+		return nil, &read, nil
+	}
+
+	return component, &read, nil
+}
+
+// the API returns a number of read-only fields, so we only submit those which can be set
+func expandLogicAppWorkflowForUpdate(read logic.Workflow) logic.Workflow {
+	props := read.WorkflowProperties
+
+	return logic.Workflow{
+		Location: read.Location,
+		Tags:     read.Tags,
+		WorkflowProperties: &logic.WorkflowProperties{
+			Definition:         props.Definition,
+			Parameters:         props.Parameters,
+			IntegrationAccount: props.IntegrationAccount,
+			State:              props.State,
+		},
+	}
+}
@@ -0,0 +1,107 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/monitor"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// The Diagnostic Settings API's are scoped to an arbitrary Resource ID, which the generated SDK path-escapes
+// (e.g. `/` becomes `%2F`) - which the API doesn't support. As such these helpers build the request using
+// the SDK and then ensure the Resource ID is sent un-escaped.
+
+func monitorDiagnosticSettingsResourceURI(req *http.Request) *http.Request {
+	if req != nil && req.URL != nil {
+		req.URL.RawPath = ""
+	}
+	return req
+}
+
+func monitorDiagnosticSettingsCreateOrUpdate(client monitor.DiagnosticSettingsClient, resourceId string, parameters monitor.DiagnosticSettingsResource, name string) (result monitor.DiagnosticSettingsResource, err error) {
+	req, err := client.CreateOrUpdatePreparer(strings.TrimPrefix(resourceId, "/"), parameters, name)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "monitor.DiagnosticSettingsClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := client.CreateOrUpdateSender(monitorDiagnosticSettingsResourceURI(req))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "monitor.DiagnosticSettingsClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	return client.CreateOrUpdateResponder(resp)
+}
+
+func monitorDiagnosticSettingsGet(client monitor.DiagnosticSettingsClient, resourceId string, name string) (result monitor.DiagnosticSettingsResource, err error) {
+	req, err := client.GetPreparer(strings.TrimPrefix(resourceId, "/"), name)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "monitor.DiagnosticSettingsClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := client.GetSender(monitorDiagnosticSettingsResourceURI(req))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "monitor.DiagnosticSettingsClient", "Get", resp, "Failure sending request")
+	}
+
+	return client.GetResponder(resp)
+}
+
+func monitorDiagnosticSettingsDelete(client monitor.DiagnosticSettingsClient, resourceId string, name string) (result autorest.Response, err error) {
+	req, err := client.DeletePreparer(strings.TrimPrefix(resourceId, "/"), name)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "monitor.DiagnosticSettingsClient", "Delete", nil, "Failure preparing request")
+	}
+
+	resp, err := client.DeleteSender(monitorDiagnosticSettingsResourceURI(req))
+	if err != nil {
+		result.Response = resp
+		return result, autorest.NewErrorWithError(err, "monitor.DiagnosticSettingsClient", "Delete", resp, "Failure sending request")
+	}
+
+	return client.DeleteResponder(resp)
+}
+
+func monitorDiagnosticSettingsCategoryList(client monitor.DiagnosticSettingsCategoryClient, resourceId string) (result monitor.DiagnosticSettingsCategoryResourceCollection, err error) {
+	req, err := client.ListPreparer(strings.TrimPrefix(resourceId, "/"))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "monitor.DiagnosticSettingsCategoryClient", "List", nil, "Failure preparing request")
+	}
+
+	resp, err := client.ListSender(monitorDiagnosticSettingsResourceURI(req))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "monitor.DiagnosticSettingsCategoryClient", "List", resp, "Failure sending request")
+	}
+
+	return client.ListResponder(resp)
+}
+
+type monitorDiagnosticSettingId struct {
+	ResourceID string
+	Name       string
+}
+
+// parseMonitorDiagnosticSettingId splits the ID of a Diagnostic Setting into the ID of the Resource
+// it's applied to and the name of the Diagnostic Setting.
+func parseMonitorDiagnosticSettingId(id string) (*monitorDiagnosticSettingId, error) {
+	separator := "/providers/microsoft.insights/diagnosticsettings/"
+	index := strings.LastIndex(strings.ToLower(id), separator)
+	if index == -1 {
+		return nil, fmt.Errorf("Expected the Diagnostic Setting ID %q to be in the format `{resourceId}/providers/microsoft.insights/diagnosticSettings/{name}`", id)
+	}
+
+	resourceId := id[0:index]
+	name := id[index+len(separator):]
+	if resourceId == "" || name == "" || strings.Contains(name, "/") {
+		return nil, fmt.Errorf("Expected the Diagnostic Setting ID %q to be in the format `{resourceId}/providers/microsoft.insights/diagnosticSettings/{name}`", id)
+	}
+
+	return &monitorDiagnosticSettingId{
+		ResourceID: resourceId,
+		Name:       name,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package azurerm
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestParseMonitorDiagnosticSettingId(t *testing.T) {
+	testCases := []struct {
+		id                 string
+		expectedResourceId string
+		expectedName       string
+		expectError        bool
+	}{
+		{
+			id:          "",
+			expectError: true,
+		},
+		{
+			id:          "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1",
+			expectError: true,
+		},
+		{
+			id:          "/providers/microsoft.insights/diagnosticSettings/setting1",
+			expectError: true,
+		},
+		{
+			id:          "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1/providers/microsoft.insights/diagnosticSettings/",
+			expectError: true,
+		},
+		{
+			id:                 "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1/providers/microsoft.insights/diagnosticSettings/setting1",
+			expectedResourceId: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1",
+			expectedName:       "setting1",
+		},
+		{
+			id:                 "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1/providers/Microsoft.Insights/DiagnosticSettings/setting1",
+			expectedResourceId: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.KeyVault/vaults/vault1",
+			expectedName:       "setting1",
+		},
+	}
+
+	for _, test := range testCases {
+		parsed, err := parseMonitorDiagnosticSettingId(test.id)
+		if test.expectError {
+			if err == nil {
+				t.Fatalf("Expected an error parsing %q but didn't get one", test.id)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error parsing %q but got: %+v", test.id, err)
+		}
+
+		if parsed.ResourceID != test.expectedResourceId {
+			t.Fatalf("Expected Resource ID to be %q but got %q", test.expectedResourceId, parsed.ResourceID)
+		}
+
+		if parsed.Name != test.expectedName {
+			t.Fatalf("Expected Name to be %q but got %q", test.expectedName, parsed.Name)
+		}
+	}
+}
+
+func TestMonitorDiagnosticSettingsResourceURI(t *testing.T) {
+	u, err := url.Parse("https://management.azure.com/subscriptions%2F00000000-0000-0000-0000-000000000000%2FresourceGroups%2Fgroup1/providers/microsoft.insights/diagnosticSettings/setting1")
+	if err != nil {
+		t.Fatalf("Error parsing URL: %+v", err)
+	}
+
+	req := monitorDiagnosticSettingsResourceURI(&http.Request{URL: u})
+
+	expected := "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.insights/diagnosticSettings/setting1"
+	if actual := req.URL.String(); actual != expected {
+		t.Fatalf("Expected the URL to be %q but got %q", expected, actual)
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -42,6 +43,26 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_SECRET", ""),
 			},
 
+			"client_certificate_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE_PATH", ""),
+			},
+
+			"client_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE", ""),
+				Sensitive:   true,
+			},
+
+			"client_certificate_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE_PASSWORD", ""),
+				Sensitive:   true,
+			},
+
 			"tenant_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -54,6 +75,50 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_ENVIRONMENT", "public"),
 			},
 
+			"arm_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_ENDPOINT", ""),
+			},
+
+			"use_msi": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_MSI", false),
+			},
+
+			"msi_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_MSI_ENDPOINT", ""),
+			},
+
+			"use_oidc": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_OIDC", false),
+			},
+
+			"oidc_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_OIDC_TOKEN", ""),
+				Sensitive:   true,
+			},
+
+			"oidc_request_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ARM_OIDC_REQUEST_URL", "ACTIONS_ID_TOKEN_REQUEST_URL"}, ""),
+			},
+
+			"oidc_request_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ARM_OIDC_REQUEST_TOKEN", "ACTIONS_ID_TOKEN_REQUEST_TOKEN"}, ""),
+				Sensitive:   true,
+			},
+
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -65,113 +130,266 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_PROVIDER_REGISTRATION", false),
 			},
+
+			"features": schemaFeatures(),
+
+			"default_tags": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateAzureRMTags,
+			},
+
+			"ignore_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+
+						"key_prefixes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+			},
+
+			"partner_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_PARTNER_ID", ""),
+				ValidateFunc: validateUUIDOrEmpty,
+			},
+
+			"disable_correlation_request_id": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_DISABLE_CORRELATION_REQUEST_ID", false),
+			},
+
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_RETRIES", 3),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"retry_backoff_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RETRY_BACKOFF_SECONDS", 30),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"resource_providers_to_register": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"additional_resource_providers_to_register": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"azurerm_builtin_role_definition": dataSourceArmBuiltInRoleDefinition(),
-			"azurerm_client_config":           dataSourceArmClientConfig(),
-			"azurerm_image":                   dataSourceArmImage(),
-			"azurerm_key_vault_access_policy": dataSourceArmKeyVaultAccessPolicy(),
-			"azurerm_managed_disk":            dataSourceArmManagedDisk(),
-			"azurerm_platform_image":          dataSourceArmPlatformImage(),
-			"azurerm_public_ip":               dataSourceArmPublicIP(),
-			"azurerm_resource_group":          dataSourceArmResourceGroup(),
-			"azurerm_role_definition":         dataSourceArmRoleDefinition(),
-			"azurerm_snapshot":                dataSourceArmSnapshot(),
-			"azurerm_subnet":                  dataSourceArmSubnet(),
-			"azurerm_subscription":            dataSourceArmSubscription(),
+			"azurerm_builtin_role_definition":       dataSourceArmBuiltInRoleDefinition(),
+			"azurerm_client_config":                 dataSourceArmClientConfig(),
+			"azurerm_image":                         dataSourceArmImage(),
+			"azurerm_key_vault_access_policy":       dataSourceArmKeyVaultAccessPolicy(),
+			"azurerm_key_vault_certificate":         dataSourceArmKeyVaultCertificate(),
+			"azurerm_key_vault_key":                 dataSourceArmKeyVaultKey(),
+			"azurerm_key_vault_secret":              dataSourceArmKeyVaultSecret(),
+			"azurerm_managed_disk":                  dataSourceArmManagedDisk(),
+			"azurerm_monitor_diagnostic_categories": dataSourceArmMonitorDiagnosticCategories(),
+			"azurerm_platform_image":                dataSourceArmPlatformImage(),
+			"azurerm_public_ip":                     dataSourceArmPublicIP(),
+			"azurerm_recovery_services_vault":       dataSourceArmRecoveryServicesVault(),
+			"azurerm_resource_group":                dataSourceArmResourceGroup(),
+			"azurerm_resources":                     dataSourceArmResources(),
+			"azurerm_role_definition":               dataSourceArmRoleDefinition(),
+			"azurerm_snapshot":                      dataSourceArmSnapshot(),
+			"azurerm_sql_server":                    dataSourceArmSqlServer(),
+			"azurerm_subnet":                        dataSourceArmSubnet(),
+			"azurerm_subscription":                  dataSourceArmSubscription(),
+			"azurerm_subscriptions":                 dataSourceArmSubscriptions(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"azurerm_application_insights":        resourceArmApplicationInsights(),
-			"azurerm_app_service":                 resourceArmAppService(),
-			"azurerm_app_service_plan":            resourceArmAppServicePlan(),
-			"azurerm_automation_account":          resourceArmAutomationAccount(),
-			"azurerm_automation_credential":       resourceArmAutomationCredential(),
-			"azurerm_automation_runbook":          resourceArmAutomationRunbook(),
-			"azurerm_automation_schedule":         resourceArmAutomationSchedule(),
-			"azurerm_availability_set":            resourceArmAvailabilitySet(),
-			"azurerm_cdn_endpoint":                resourceArmCdnEndpoint(),
-			"azurerm_cdn_profile":                 resourceArmCdnProfile(),
-			"azurerm_container_registry":          resourceArmContainerRegistry(),
-			"azurerm_container_service":           resourceArmContainerService(),
-			"azurerm_container_group":             resourceArmContainerGroup(),
-			"azurerm_cosmosdb_account":            resourceArmCosmosDBAccount(),
-			"azurerm_dns_a_record":                resourceArmDnsARecord(),
-			"azurerm_dns_aaaa_record":             resourceArmDnsAAAARecord(),
-			"azurerm_dns_cname_record":            resourceArmDnsCNameRecord(),
-			"azurerm_dns_mx_record":               resourceArmDnsMxRecord(),
-			"azurerm_dns_ns_record":               resourceArmDnsNsRecord(),
-			"azurerm_dns_ptr_record":              resourceArmDnsPtrRecord(),
-			"azurerm_dns_srv_record":              resourceArmDnsSrvRecord(),
-			"azurerm_dns_txt_record":              resourceArmDnsTxtRecord(),
-			"azurerm_dns_zone":                    resourceArmDnsZone(),
-			"azurerm_eventgrid_topic":             resourceArmEventGridTopic(),
-			"azurerm_eventhub":                    resourceArmEventHub(),
-			"azurerm_eventhub_authorization_rule": resourceArmEventHubAuthorizationRule(),
-			"azurerm_eventhub_consumer_group":     resourceArmEventHubConsumerGroup(),
-			"azurerm_eventhub_namespace":          resourceArmEventHubNamespace(),
-			"azurerm_express_route_circuit":       resourceArmExpressRouteCircuit(),
-			"azurerm_image":                       resourceArmImage(),
-			"azurerm_key_vault":                   resourceArmKeyVault(),
-			"azurerm_key_vault_certificate":       resourceArmKeyVaultCertificate(),
-			"azurerm_key_vault_key":               resourceArmKeyVaultKey(),
-			"azurerm_key_vault_secret":            resourceArmKeyVaultSecret(),
-			"azurerm_lb":                          resourceArmLoadBalancer(),
-			"azurerm_lb_backend_address_pool":     resourceArmLoadBalancerBackendAddressPool(),
-			"azurerm_lb_nat_rule":                 resourceArmLoadBalancerNatRule(),
-			"azurerm_lb_nat_pool":                 resourceArmLoadBalancerNatPool(),
-			"azurerm_lb_probe":                    resourceArmLoadBalancerProbe(),
-			"azurerm_lb_rule":                     resourceArmLoadBalancerRule(),
-			"azurerm_local_network_gateway":       resourceArmLocalNetworkGateway(),
-			"azurerm_log_analytics_workspace":     resourceArmLogAnalyticsWorkspace(),
-			"azurerm_managed_disk":                resourceArmManagedDisk(),
-			"azurerm_mysql_configuration":         resourceArmMySQLConfiguration(),
-			"azurerm_mysql_database":              resourceArmMySqlDatabase(),
-			"azurerm_mysql_firewall_rule":         resourceArmMySqlFirewallRule(),
-			"azurerm_mysql_server":                resourceArmMySqlServer(),
-			"azurerm_network_interface":           resourceArmNetworkInterface(),
-			"azurerm_network_security_group":      resourceArmNetworkSecurityGroup(),
-			"azurerm_network_security_rule":       resourceArmNetworkSecurityRule(),
-			"azurerm_postgresql_configuration":    resourceArmPostgreSQLConfiguration(),
-			"azurerm_postgresql_database":         resourceArmPostgreSQLDatabase(),
-			"azurerm_postgresql_firewall_rule":    resourceArmPostgreSQLFirewallRule(),
-			"azurerm_postgresql_server":           resourceArmPostgreSQLServer(),
-			"azurerm_public_ip":                   resourceArmPublicIp(),
-			"azurerm_redis_cache":                 resourceArmRedisCache(),
-			"azurerm_resource_group":              resourceArmResourceGroup(),
-			"azurerm_role_assignment":             resourceArmRoleAssignment(),
-			"azurerm_role_definition":             resourceArmRoleDefinition(),
-			"azurerm_route":                       resourceArmRoute(),
-			"azurerm_route_table":                 resourceArmRouteTable(),
-			"azurerm_search_service":              resourceArmSearchService(),
-			"azurerm_servicebus_namespace":        resourceArmServiceBusNamespace(),
-			"azurerm_servicebus_queue":            resourceArmServiceBusQueue(),
-			"azurerm_servicebus_subscription":     resourceArmServiceBusSubscription(),
-			"azurerm_servicebus_topic":            resourceArmServiceBusTopic(),
-			"azurerm_snapshot":                    resourceArmSnapshot(),
-			"azurerm_sql_database":                resourceArmSqlDatabase(),
-			"azurerm_sql_elasticpool":             resourceArmSqlElasticPool(),
-			"azurerm_sql_firewall_rule":           resourceArmSqlFirewallRule(),
-			"azurerm_sql_server":                  resourceArmSqlServer(),
-			"azurerm_storage_account":             resourceArmStorageAccount(),
-			"azurerm_storage_blob":                resourceArmStorageBlob(),
-			"azurerm_storage_container":           resourceArmStorageContainer(),
-			"azurerm_storage_share":               resourceArmStorageShare(),
-			"azurerm_storage_queue":               resourceArmStorageQueue(),
-			"azurerm_storage_table":               resourceArmStorageTable(),
-			"azurerm_subnet":                      resourceArmSubnet(),
-			"azurerm_template_deployment":         resourceArmTemplateDeployment(),
-			"azurerm_traffic_manager_endpoint":    resourceArmTrafficManagerEndpoint(),
-			"azurerm_traffic_manager_profile":     resourceArmTrafficManagerProfile(),
-			"azurerm_virtual_machine_extension":   resourceArmVirtualMachineExtensions(),
-			"azurerm_virtual_machine":             resourceArmVirtualMachine(),
-			"azurerm_virtual_machine_scale_set":   resourceArmVirtualMachineScaleSet(),
-			"azurerm_virtual_network":             resourceArmVirtualNetwork(),
-			"azurerm_virtual_network_peering":     resourceArmVirtualNetworkPeering(),
+			"azurerm_api_management":                           resourceArmApiManagementService(),
+			"azurerm_api_management_api":                       resourceArmApiManagementApi(),
+			"azurerm_api_management_api_operation":             resourceArmApiManagementApiOperation(),
+			"azurerm_api_management_api_operation_policy":      resourceArmApiManagementApiOperationPolicy(),
+			"azurerm_api_management_api_policy":                resourceArmApiManagementApiPolicy(),
+			"azurerm_api_management_backend":                   resourceArmApiManagementBackend(),
+			"azurerm_api_management_logger":                    resourceArmApiManagementLogger(),
+			"azurerm_api_management_policy":                    resourceArmApiManagementPolicy(),
+			"azurerm_api_management_product":                   resourceArmApiManagementProduct(),
+			"azurerm_api_management_product_api":               resourceArmApiManagementProductApi(),
+			"azurerm_api_management_product_policy":            resourceArmApiManagementProductPolicy(),
+			"azurerm_api_management_property":                  resourceArmApiManagementProperty(),
+			"azurerm_api_management_subscription":              resourceArmApiManagementSubscription(),
+			"azurerm_application_gateway":                      resourceArmApplicationGateway(),
+			"azurerm_application_insights":                     resourceArmApplicationInsights(),
+			"azurerm_application_insights_web_test":            resourceArmApplicationInsightsWebTest(),
+			"azurerm_app_service":                              resourceArmAppService(),
+			"azurerm_app_service_custom_hostname_binding":      resourceArmAppServiceCustomHostnameBinding(),
+			"azurerm_app_service_plan":                         resourceArmAppServicePlan(),
+			"azurerm_app_service_slot":                         resourceArmAppServiceSlot(),
+			"azurerm_automation_account":                       resourceArmAutomationAccount(),
+			"azurerm_automation_credential":                    resourceArmAutomationCredential(),
+			"azurerm_automation_dsc_configuration":             resourceArmAutomationDscConfiguration(),
+			"azurerm_automation_dsc_nodeconfiguration":         resourceArmAutomationDscNodeConfiguration(),
+			"azurerm_automation_job_schedule":                  resourceArmAutomationJobSchedule(),
+			"azurerm_automation_runbook":                       resourceArmAutomationRunbook(),
+			"azurerm_automation_schedule":                      resourceArmAutomationSchedule(),
+			"azurerm_automation_variable":                      resourceArmAutomationVariable(),
+			"azurerm_availability_set":                         resourceArmAvailabilitySet(),
+			"azurerm_backup_policy_vm":                         resourceArmBackupProtectionPolicyVM(),
+			"azurerm_backup_protected_vm":                      resourceArmBackupProtectedVM(),
+			"azurerm_batch_account":                            resourceArmBatchAccount(),
+			"azurerm_cdn_endpoint":                             resourceArmCdnEndpoint(),
+			"azurerm_cdn_endpoint_custom_domain":               resourceArmCdnEndpointCustomDomain(),
+			"azurerm_cdn_profile":                              resourceArmCdnProfile(),
+			"azurerm_cognitive_account":                        resourceArmCognitiveAccount(),
+			"azurerm_container_registry":                       resourceArmContainerRegistry(),
+			"azurerm_container_service":                        resourceArmContainerService(),
+			"azurerm_container_group":                          resourceArmContainerGroup(),
+			"azurerm_cosmosdb_account":                         resourceArmCosmosDBAccount(),
+			"azurerm_data_lake_analytics_account":              resourceArmDataLakeAnalyticsAccount(),
+			"azurerm_data_lake_analytics_firewall_rule":        resourceArmDataLakeAnalyticsFirewallRule(),
+			"azurerm_data_lake_store":                          resourceArmDataLakeStore(),
+			"azurerm_data_lake_store_file":                     resourceArmDataLakeStoreFile(),
+			"azurerm_data_lake_store_firewall_rule":            resourceArmDataLakeStoreFirewallRule(),
+			"azurerm_dev_test_lab":                             resourceArmDevTestLab(),
+			"azurerm_dev_test_linux_virtual_machine":           resourceArmDevTestLinuxVirtualMachine(),
+			"azurerm_dev_test_policy":                          resourceArmDevTestPolicy(),
+			"azurerm_dev_test_schedule":                        resourceArmDevTestSchedule(),
+			"azurerm_dev_test_virtual_network":                 resourceArmDevTestVirtualNetwork(),
+			"azurerm_dev_test_windows_virtual_machine":         resourceArmDevTestWindowsVirtualMachine(),
+			"azurerm_dns_a_record":                             resourceArmDnsARecord(),
+			"azurerm_dns_aaaa_record":                          resourceArmDnsAAAARecord(),
+			"azurerm_dns_cname_record":                         resourceArmDnsCNameRecord(),
+			"azurerm_dns_mx_record":                            resourceArmDnsMxRecord(),
+			"azurerm_dns_ns_record":                            resourceArmDnsNsRecord(),
+			"azurerm_dns_ptr_record":                           resourceArmDnsPtrRecord(),
+			"azurerm_dns_srv_record":                           resourceArmDnsSrvRecord(),
+			"azurerm_dns_txt_record":                           resourceArmDnsTxtRecord(),
+			"azurerm_dns_zone":                                 resourceArmDnsZone(),
+			"azurerm_eventgrid_event_subscription":             resourceArmEventGridEventSubscription(),
+			"azurerm_eventgrid_topic":                          resourceArmEventGridTopic(),
+			"azurerm_eventhub":                                 resourceArmEventHub(),
+			"azurerm_eventhub_authorization_rule":              resourceArmEventHubAuthorizationRule(),
+			"azurerm_eventhub_consumer_group":                  resourceArmEventHubConsumerGroup(),
+			"azurerm_eventhub_namespace":                       resourceArmEventHubNamespace(),
+			"azurerm_express_route_circuit":                    resourceArmExpressRouteCircuit(),
+			"azurerm_hdinsight_hadoop_cluster":                 resourceArmHDInsightHadoopCluster(),
+			"azurerm_hdinsight_hbase_cluster":                  resourceArmHDInsightHBaseCluster(),
+			"azurerm_hdinsight_interactive_query_cluster":      resourceArmHDInsightInteractiveQueryCluster(),
+			"azurerm_hdinsight_kafka_cluster":                  resourceArmHDInsightKafkaCluster(),
+			"azurerm_hdinsight_spark_cluster":                  resourceArmHDInsightSparkCluster(),
+			"azurerm_image":                                    resourceArmImage(),
+			"azurerm_iothub":                                   resourceArmIotHub(),
+			"azurerm_key_vault":                                resourceArmKeyVault(),
+			"azurerm_key_vault_access_policy":                  resourceArmKeyVaultAccessPolicy(),
+			"azurerm_key_vault_certificate":                    resourceArmKeyVaultCertificate(),
+			"azurerm_key_vault_key":                            resourceArmKeyVaultKey(),
+			"azurerm_key_vault_secret":                         resourceArmKeyVaultSecret(),
+			"azurerm_lb":                                       resourceArmLoadBalancer(),
+			"azurerm_lb_backend_address_pool":                  resourceArmLoadBalancerBackendAddressPool(),
+			"azurerm_lb_nat_rule":                              resourceArmLoadBalancerNatRule(),
+			"azurerm_lb_nat_pool":                              resourceArmLoadBalancerNatPool(),
+			"azurerm_lb_probe":                                 resourceArmLoadBalancerProbe(),
+			"azurerm_lb_rule":                                  resourceArmLoadBalancerRule(),
+			"azurerm_local_network_gateway":                    resourceArmLocalNetworkGateway(),
+			"azurerm_logic_app_action_custom":                  resourceArmLogicAppActionCustom(),
+			"azurerm_logic_app_action_http":                    resourceArmLogicAppActionHttp(),
+			"azurerm_logic_app_trigger_custom":                 resourceArmLogicAppTriggerCustom(),
+			"azurerm_logic_app_trigger_http_request":           resourceArmLogicAppTriggerHttpRequest(),
+			"azurerm_logic_app_trigger_recurrence":             resourceArmLogicAppTriggerRecurrence(),
+			"azurerm_logic_app_workflow":                       resourceArmLogicAppWorkflow(),
+			"azurerm_log_analytics_linked_service":             resourceArmLogAnalyticsLinkedService(),
+			"azurerm_log_analytics_saved_search":               resourceArmLogAnalyticsSavedSearch(),
+			"azurerm_log_analytics_solution":                   resourceArmLogAnalyticsSolution(),
+			"azurerm_log_analytics_workspace":                  resourceArmLogAnalyticsWorkspace(),
+			"azurerm_managed_disk":                             resourceArmManagedDisk(),
+			"azurerm_monitor_action_group":                     resourceArmMonitorActionGroup(),
+			"azurerm_monitor_autoscale_setting":                resourceArmMonitorAutoscaleSetting(),
+			"azurerm_monitor_diagnostic_setting":               resourceArmMonitorDiagnosticSetting(),
+			"azurerm_monitor_metric_alertrule":                 resourceArmMonitorMetricAlertRule(),
+			"azurerm_mysql_configuration":                      resourceArmMySQLConfiguration(),
+			"azurerm_mysql_database":                           resourceArmMySqlDatabase(),
+			"azurerm_mysql_firewall_rule":                      resourceArmMySqlFirewallRule(),
+			"azurerm_mysql_server":                             resourceArmMySqlServer(),
+			"azurerm_network_interface":                        resourceArmNetworkInterface(),
+			"azurerm_network_security_group":                   resourceArmNetworkSecurityGroup(),
+			"azurerm_network_security_rule":                    resourceArmNetworkSecurityRule(),
+			"azurerm_policy_assignment":                        resourceArmPolicyAssignment(),
+			"azurerm_policy_definition":                        resourceArmPolicyDefinition(),
+			"azurerm_postgresql_configuration":                 resourceArmPostgreSQLConfiguration(),
+			"azurerm_postgresql_database":                      resourceArmPostgreSQLDatabase(),
+			"azurerm_postgresql_firewall_rule":                 resourceArmPostgreSQLFirewallRule(),
+			"azurerm_postgresql_server":                        resourceArmPostgreSQLServer(),
+			"azurerm_public_ip":                                resourceArmPublicIp(),
+			"azurerm_recovery_services_vault":                  resourceArmRecoveryServicesVault(),
+			"azurerm_redis_cache":                              resourceArmRedisCache(),
+			"azurerm_resource_group":                           resourceArmResourceGroup(),
+			"azurerm_role_assignment":                          resourceArmRoleAssignment(),
+			"azurerm_role_definition":                          resourceArmRoleDefinition(),
+			"azurerm_route":                                    resourceArmRoute(),
+			"azurerm_route_table":                              resourceArmRouteTable(),
+			"azurerm_search_service":                           resourceArmSearchService(),
+			"azurerm_servicebus_namespace":                     resourceArmServiceBusNamespace(),
+			"azurerm_servicebus_queue":                         resourceArmServiceBusQueue(),
+			"azurerm_servicebus_subscription":                  resourceArmServiceBusSubscription(),
+			"azurerm_servicebus_topic":                         resourceArmServiceBusTopic(),
+			"azurerm_snapshot":                                 resourceArmSnapshot(),
+			"azurerm_sql_active_directory_administrator":       resourceArmSqlActiveDirectoryAdministrator(),
+			"azurerm_sql_database":                             resourceArmSqlDatabase(),
+			"azurerm_sql_elasticpool":                          resourceArmSqlElasticPool(),
+			"azurerm_sql_firewall_rule":                        resourceArmSqlFirewallRule(),
+			"azurerm_sql_server":                               resourceArmSqlServer(),
+			"azurerm_storage_account":                          resourceArmStorageAccount(),
+			"azurerm_storage_blob":                             resourceArmStorageBlob(),
+			"azurerm_storage_container":                        resourceArmStorageContainer(),
+			"azurerm_storage_share":                            resourceArmStorageShare(),
+			"azurerm_storage_queue":                            resourceArmStorageQueue(),
+			"azurerm_storage_table":                            resourceArmStorageTable(),
+			"azurerm_stream_analytics_function_javascript_udf": resourceArmStreamAnalyticsFunctionJavaScriptUDF(),
+			"azurerm_stream_analytics_job":                     resourceArmStreamAnalyticsJob(),
+			"azurerm_stream_analytics_output_blob":             resourceArmStreamAnalyticsOutputBlob(),
+			"azurerm_stream_analytics_output_eventhub":         resourceArmStreamAnalyticsOutputEventHub(),
+			"azurerm_stream_analytics_output_mssql":            resourceArmStreamAnalyticsOutputSql(),
+			"azurerm_stream_analytics_stream_input_blob":       resourceArmStreamAnalyticsStreamInputBlob(),
+			"azurerm_stream_analytics_stream_input_eventhub":   resourceArmStreamAnalyticsStreamInputEventHub(),
+			"azurerm_stream_analytics_stream_input_iothub":     resourceArmStreamAnalyticsStreamInputIoTHub(),
+			"azurerm_subnet":                                   resourceArmSubnet(),
+			"azurerm_template_deployment":                      resourceArmTemplateDeployment(),
+			"azurerm_traffic_manager_endpoint":                 resourceArmTrafficManagerEndpoint(),
+			"azurerm_traffic_manager_profile":                  resourceArmTrafficManagerProfile(),
+			"azurerm_virtual_machine_extension":                resourceArmVirtualMachineExtensions(),
+			"azurerm_virtual_machine":                          resourceArmVirtualMachine(),
+			"azurerm_virtual_machine_scale_set":                resourceArmVirtualMachineScaleSet(),
+			"azurerm_virtual_network":                          resourceArmVirtualNetwork(),
+			"azurerm_virtual_network_peering":                  resourceArmVirtualNetworkPeering(),
 		},
 	}
 
+	setDefaultTimeouts(p.ResourcesMap)
+	setResourceTagsSchema(p)
+
 	p.ConfigureFunc = providerConfigure(p)
 
 	return p
@@ -187,15 +405,50 @@ type Config struct {
 	SubscriptionID            string
 	TenantID                  string
 	Environment               string
+	ArmEndpoint               string
 	SkipCredentialsValidation bool
 	SkipProviderRegistration  bool
 
+	// Opt-in behaviours, such as what happens when a resource is destroyed
+	Features Features
+
+	// Tags applied to, or ignored on, every resource
+	DefaultTags map[string]string
+	IgnoreTags  ignoreTags
+
+	// Request Attribution
+	PartnerID                   string
+	DisableCorrelationRequestID bool
+
+	// Retries for throttled and failed requests
+	MaxRetries   int
+	RetryBackoff time.Duration
+
+	// Resource Provider Registration
+	ResourceProvidersToRegister           []string
+	AdditionalResourceProvidersToRegister []string
+
 	// Service Principal Auth
 	ClientSecret string
 
+	// Service Principal (Client Certificate) Auth
+	ClientCertificatePath     string
+	ClientCertificateContents string
+	ClientCertificatePassword string
+
 	// Bearer Auth
 	AccessToken  *adal.Token
 	IsCloudShell bool
+
+	// Managed Service Identity Auth
+	UseMsi      bool
+	MsiEndpoint string
+
+	// OIDC (Workload Identity Federation) Auth
+	UseOIDC          bool
+	OIDCToken        string
+	OIDCRequestURL   string
+	OIDCRequestToken string
 }
 
 func (c *Config) validateServicePrincipal() error {
@@ -220,6 +473,69 @@ func (c *Config) validateServicePrincipal() error {
 	return err.ErrorOrNil()
 }
 
+func (c *Config) validateClientCertificate() error {
+	var err *multierror.Error
+
+	if c.SubscriptionID == "" {
+		err = multierror.Append(err, fmt.Errorf("Subscription ID must be configured for the AzureRM provider"))
+	}
+	if c.ClientID == "" {
+		err = multierror.Append(err, fmt.Errorf("Client ID must be configured for the AzureRM provider"))
+	}
+	if c.ClientCertificatePath != "" && c.ClientCertificateContents != "" {
+		err = multierror.Append(err, fmt.Errorf("Only one of Client Certificate Path or Client Certificate can be configured for the AzureRM provider"))
+	}
+	if c.TenantID == "" {
+		err = multierror.Append(err, fmt.Errorf("Tenant ID must be configured for the AzureRM provider"))
+	}
+	if c.Environment == "" {
+		err = multierror.Append(err, fmt.Errorf("Environment must be configured for the AzureRM provider"))
+	}
+
+	return err.ErrorOrNil()
+}
+
+func (c *Config) validateOIDC() error {
+	var err *multierror.Error
+
+	if c.SubscriptionID == "" {
+		err = multierror.Append(err, fmt.Errorf("Subscription ID must be configured for the AzureRM provider"))
+	}
+	if c.ClientID == "" {
+		err = multierror.Append(err, fmt.Errorf("Client ID must be configured for the AzureRM provider"))
+	}
+	if c.OIDCToken == "" && (c.OIDCRequestURL == "" || c.OIDCRequestToken == "") {
+		err = multierror.Append(err, fmt.Errorf("Either an OIDC Token, or an OIDC Request URL and Request Token must be configured for the AzureRM provider"))
+	}
+	if c.TenantID == "" {
+		err = multierror.Append(err, fmt.Errorf("Tenant ID must be configured for the AzureRM provider"))
+	}
+	if c.Environment == "" {
+		err = multierror.Append(err, fmt.Errorf("Environment must be configured for the AzureRM provider"))
+	}
+
+	return err.ErrorOrNil()
+}
+
+func (c *Config) validateMsi() error {
+	var err *multierror.Error
+
+	if c.SubscriptionID == "" {
+		err = multierror.Append(err, fmt.Errorf("Subscription ID must be configured for the AzureRM provider"))
+	}
+	if c.TenantID == "" {
+		err = multierror.Append(err, fmt.Errorf("Tenant ID must be configured for the AzureRM provider"))
+	}
+	if c.Environment == "" {
+		err = multierror.Append(err, fmt.Errorf("Environment must be configured for the AzureRM provider"))
+	}
+	if c.MsiEndpoint == "" {
+		err = multierror.Append(err, fmt.Errorf("MSI endpoint must be configured for the AzureRM provider"))
+	}
+
+	return err.ErrorOrNil()
+}
+
 func (c *Config) validateBearerAuth() error {
 	var err *multierror.Error
 
@@ -254,13 +570,15 @@ func (c *Config) LoadTokensFromAzureCLI() error {
 	}
 
 	// pull out the TenantID and Subscription ID from the Azure Profile
-	for _, subscription := range profile.Subscriptions {
-		if subscription.IsDefault {
-			c.SubscriptionID = subscription.ID
-			c.TenantID = subscription.TenantID
-			c.Environment = normalizeEnvironmentName(subscription.EnvironmentName)
-			break
-		}
+	subscription, err := findAzureCLISubscription(profile.Subscriptions, c.SubscriptionID)
+	if err != nil {
+		return err
+	}
+
+	if subscription != nil {
+		c.SubscriptionID = subscription.ID
+		c.TenantID = subscription.TenantID
+		c.Environment = normalizeEnvironmentName(subscription.EnvironmentName)
 	}
 
 	foundToken := false
@@ -317,6 +635,26 @@ func (c *Config) LoadTokensFromAzureCLI() error {
 	return nil
 }
 
+// findAzureCLISubscription returns the Subscription from the Azure CLI Profile which matches
+// the configured Subscription ID - or the Default Subscription when one isn't configured.
+func findAzureCLISubscription(subscriptions []cli.Subscription, subscriptionId string) (*cli.Subscription, error) {
+	for _, subscription := range subscriptions {
+		if subscriptionId == "" && subscription.IsDefault {
+			return &subscription, nil
+		}
+
+		if subscriptionId != "" && strings.EqualFold(subscription.ID, subscriptionId) {
+			return &subscription, nil
+		}
+	}
+
+	if subscriptionId != "" {
+		return nil, fmt.Errorf("Subscription %q was not found in your Azure CLI Credentials.\n\nPlease check the Subscription ID, or log in to the Azure CLI again via `az login`", subscriptionId)
+	}
+
+	return nil, nil
+}
+
 func normalizeEnvironmentName(input string) string {
 	// Environment is stored as `Azure{Environment}Cloud`
 	output := strings.ToLower(input)
@@ -333,22 +671,76 @@ func normalizeEnvironmentName(input string) string {
 func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 	return func(d *schema.ResourceData) (interface{}, error) {
 		config := &Config{
-			SubscriptionID:            d.Get("subscription_id").(string),
-			ClientID:                  d.Get("client_id").(string),
-			ClientSecret:              d.Get("client_secret").(string),
-			TenantID:                  d.Get("tenant_id").(string),
-			Environment:               d.Get("environment").(string),
-			SkipCredentialsValidation: d.Get("skip_credentials_validation").(bool),
-			SkipProviderRegistration:  d.Get("skip_provider_registration").(bool),
+			SubscriptionID:              d.Get("subscription_id").(string),
+			ClientID:                    d.Get("client_id").(string),
+			ClientSecret:                d.Get("client_secret").(string),
+			ClientCertificatePath:       d.Get("client_certificate_path").(string),
+			ClientCertificateContents:   d.Get("client_certificate").(string),
+			ClientCertificatePassword:   d.Get("client_certificate_password").(string),
+			TenantID:                    d.Get("tenant_id").(string),
+			Environment:                 d.Get("environment").(string),
+			ArmEndpoint:                 d.Get("arm_endpoint").(string),
+			SkipCredentialsValidation:   d.Get("skip_credentials_validation").(bool),
+			SkipProviderRegistration:    d.Get("skip_provider_registration").(bool),
+			UseMsi:                      d.Get("use_msi").(bool),
+			MsiEndpoint:                 d.Get("msi_endpoint").(string),
+			UseOIDC:                     d.Get("use_oidc").(bool),
+			OIDCToken:                   d.Get("oidc_token").(string),
+			OIDCRequestURL:              d.Get("oidc_request_url").(string),
+			OIDCRequestToken:            d.Get("oidc_request_token").(string),
+			PartnerID:                   d.Get("partner_id").(string),
+			DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
+			Features:                    expandFeatures(d.Get("features").([]interface{})),
+			IgnoreTags:                  expandIgnoreTags(d.Get("ignore_tags").([]interface{})),
+			MaxRetries:                  d.Get("max_retries").(int),
+			RetryBackoff:                time.Duration(d.Get("retry_backoff_seconds").(int)) * time.Second,
 		}
 
-		if config.ClientSecret != "" {
+		config.DefaultTags = make(map[string]string)
+		for k, v := range d.Get("default_tags").(map[string]interface{}) {
+			value, _ := tagValueToString(v)
+			config.DefaultTags[k] = value
+		}
+
+		for _, v := range d.Get("resource_providers_to_register").(*schema.Set).List() {
+			config.ResourceProvidersToRegister = append(config.ResourceProvidersToRegister, v.(string))
+		}
+
+		for _, v := range d.Get("additional_resource_providers_to_register").(*schema.Set).List() {
+			config.AdditionalResourceProvidersToRegister = append(config.AdditionalResourceProvidersToRegister, v.(string))
+		}
+
+		if config.UseMsi {
+			log.Printf("[DEBUG] use_msi specified - using Managed Service Identity for Authentication")
+			if config.MsiEndpoint == "" {
+				msiEndpoint, err := adal.GetMSIVMEndpoint()
+				if err != nil {
+					return nil, fmt.Errorf("Could not retrieve the MSI endpoint from the VM settings. Ensure the VM has MSI enabled, or specify the `msi_endpoint`: %+v", err)
+				}
+				config.MsiEndpoint = msiEndpoint
+			}
+			log.Printf("[DEBUG] Using MSI endpoint %q", config.MsiEndpoint)
+
+			if err := config.validateMsi(); err != nil {
+				return nil, err
+			}
+		} else if config.UseOIDC {
+			log.Printf("[DEBUG] use_oidc specified - using OIDC (Workload Identity Federation) for Authentication")
+			if err := config.validateOIDC(); err != nil {
+				return nil, err
+			}
+		} else if config.ClientSecret != "" {
 			log.Printf("[DEBUG] Client Secret specified - using Service Principal for Authentication")
 			if err := config.validateServicePrincipal(); err != nil {
 				return nil, err
 			}
+		} else if config.usingClientCertificate() {
+			log.Printf("[DEBUG] Client Certificate specified - using Service Principal for Authentication")
+			if err := config.validateClientCertificate(); err != nil {
+				return nil, err
+			}
 		} else {
-			log.Printf("[DEBUG] No Client Secret specified - loading credentials from Azure CLI")
+			log.Printf("[DEBUG] No Client Secret or Client Certificate specified - loading credentials from Azure CLI")
 			if err := config.LoadTokensFromAzureCLI(); err != nil {
 				return nil, err
 			}
@@ -382,7 +774,8 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			}
 
 			if !config.SkipProviderRegistration {
-				err = registerAzureResourceProvidersWithSubscription(*providerList.Value, client.providers)
+				providers := resourceProvidersToRegister(config.ResourceProvidersToRegister, config.AdditionalResourceProvidersToRegister)
+				err = registerAzureResourceProvidersWithSubscription(*providerList.Value, providers, client.providers)
 				if err != nil {
 					return nil, err
 				}
@@ -402,53 +795,96 @@ func registerProviderWithSubscription(providerName string, client resources.Prov
 	return nil
 }
 
-func determineAzureResourceProvidersToRegister(providerList []resources.Provider) map[string]struct{} {
-	providers := map[string]struct{}{
+// requiredResourceProviders returns all of the Resource Providers used by this provider
+func requiredResourceProviders() map[string]struct{} {
+	return map[string]struct{}{
+		"Microsoft.ApiManagement":       {},
 		"Microsoft.Authorization":       {},
 		"Microsoft.Automation":          {},
+		"Microsoft.Batch":               {},
 		"Microsoft.Cache":               {},
 		"Microsoft.Cdn":                 {},
+		"Microsoft.CognitiveServices":   {},
 		"Microsoft.Compute":             {},
 		"Microsoft.ContainerInstance":   {},
 		"Microsoft.ContainerRegistry":   {},
 		"Microsoft.ContainerService":    {},
 		"Microsoft.DBforMySQL":          {},
 		"Microsoft.DBforPostgreSQL":     {},
+		"Microsoft.DevTestLab":          {},
+		"Microsoft.Devices":             {},
+		"Microsoft.DataLakeAnalytics":   {},
+		"Microsoft.DataLakeStore":       {},
 		"Microsoft.DocumentDB":          {},
 		"Microsoft.EventGrid":           {},
 		"Microsoft.EventHub":            {},
 		"Microsoft.KeyVault":            {},
+		"Microsoft.Logic":               {},
 		"microsoft.insights":            {},
 		"Microsoft.Network":             {},
 		"Microsoft.OperationalInsights": {},
+		"Microsoft.RecoveryServices":    {},
 		"Microsoft.Resources":           {},
 		"Microsoft.Search":              {},
 		"Microsoft.ServiceBus":          {},
 		"Microsoft.Sql":                 {},
 		"Microsoft.Storage":             {},
 	}
+}
+
+// resourceProvidersToRegister returns the Resource Providers which should be registered - which
+// is either the restricted set specified by the user, or all of the required Resource Providers,
+// along with any additional Resource Providers which have been specified.
+func resourceProvidersToRegister(restrictTo []string, additional []string) map[string]struct{} {
+	providers := requiredResourceProviders()
+	if len(restrictTo) > 0 {
+		providers = make(map[string]struct{}, len(restrictTo))
+		for _, v := range restrictTo {
+			providers[v] = struct{}{}
+		}
+	}
+
+	for _, v := range additional {
+		providers[v] = struct{}{}
+	}
+
+	return providers
+}
+
+func determineAzureResourceProvidersToRegister(providerList []resources.Provider, providers map[string]struct{}) map[string]struct{} {
+	toRegister := make(map[string]struct{}, len(providers))
+	for k := range providers {
+		toRegister[k] = struct{}{}
+	}
 
 	// filter out any providers already registered
 	for _, p := range providerList {
-		if _, ok := providers[*p.Namespace]; !ok {
+		if p.Namespace == nil || p.RegistrationState == nil {
 			continue
 		}
 
-		if strings.ToLower(*p.RegistrationState) == "registered" {
-			log.Printf("[DEBUG] Skipping provider registration for namespace %s\n", *p.Namespace)
-			delete(providers, *p.Namespace)
+		if strings.ToLower(*p.RegistrationState) != "registered" {
+			continue
+		}
+
+		// Resource Provider namespaces are case-insensitive
+		for k := range toRegister {
+			if strings.EqualFold(k, *p.Namespace) {
+				log.Printf("[DEBUG] Skipping provider registration for namespace %s\n", *p.Namespace)
+				delete(toRegister, k)
+			}
 		}
 	}
 
-	return providers
+	return toRegister
 }
 
 // registerAzureResourceProvidersWithSubscription uses the providers client to register
 // all Azure resource providers which the Terraform provider may require (regardless of
 // whether they are actually used by the configuration or not). It was confirmed by Microsoft
 // that this is the approach their own internal tools also take.
-func registerAzureResourceProvidersWithSubscription(providerList []resources.Provider, client resources.ProvidersClient) error {
-	providers := determineAzureResourceProvidersToRegister(providerList)
+func registerAzureResourceProvidersWithSubscription(providerList []resources.Provider, requested map[string]struct{}, client resources.ProvidersClient) error {
+	providers := determineAzureResourceProvidersToRegister(providerList, requested)
 
 	var err error
 	var wg sync.WaitGroup
@@ -1,15 +1,26 @@
 package azurerm
 
 import (
+	"context"
+	"encoding/base64"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/arm/resources/resources"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/azure/cli"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
@@ -50,6 +61,459 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+func TestFindAzureCLISubscription(t *testing.T) {
+	subscriptions := []cli.Subscription{
+		{
+			ID:       "00000000-0000-0000-0000-000000000000",
+			TenantID: "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			ID:        "22222222-2222-2222-2222-222222222222",
+			TenantID:  "33333333-3333-3333-3333-333333333333",
+			IsDefault: true,
+		},
+	}
+
+	cases := []struct {
+		SubscriptionID string
+		ExpectedID     string
+		ExpectError    bool
+	}{
+		{
+			SubscriptionID: "",
+			ExpectedID:     "22222222-2222-2222-2222-222222222222",
+		},
+		{
+			SubscriptionID: "00000000-0000-0000-0000-000000000000",
+			ExpectedID:     "00000000-0000-0000-0000-000000000000",
+		},
+		{
+			SubscriptionID: "22222222-2222-2222-2222-222222222222",
+			ExpectedID:     "22222222-2222-2222-2222-222222222222",
+		},
+		{
+			SubscriptionID: "44444444-4444-4444-4444-444444444444",
+			ExpectError:    true,
+		},
+	}
+
+	for _, v := range cases {
+		subscription, err := findAzureCLISubscription(subscriptions, v.SubscriptionID)
+		if v.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected an error for Subscription %q but didn't get one", v.SubscriptionID)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error for Subscription %q but got: %+v", v.SubscriptionID, err)
+		}
+
+		if subscription == nil || subscription.ID != v.ExpectedID {
+			t.Fatalf("Expected Subscription %q for %q but got %+v", v.ExpectedID, v.SubscriptionID, subscription)
+		}
+	}
+}
+
+func TestConfigLoadClientCertificate(t *testing.T) {
+	path := "testdata/keyvaultcert.pfx"
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Error reading %q: %+v", path, err)
+	}
+
+	cases := []struct {
+		Config      Config
+		ExpectError bool
+	}{
+		{
+			Config: Config{
+				ClientCertificatePath: path,
+			},
+		},
+		{
+			Config: Config{
+				ClientCertificateContents: base64.StdEncoding.EncodeToString(contents),
+			},
+		},
+		{
+			Config: Config{
+				ClientCertificatePath: "testdata/does-not-exist.pfx",
+			},
+			ExpectError: true,
+		},
+		{
+			Config: Config{
+				ClientCertificateContents: "not-base64!",
+			},
+			ExpectError: true,
+		},
+		{
+			Config: Config{
+				ClientCertificatePath:     path,
+				ClientCertificatePassword: "incorrect",
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range cases {
+		certificate, privateKey, err := v.Config.loadClientCertificate()
+		if v.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected an error loading the Client Certificate for %+v but didn't get one", v.Config)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error loading the Client Certificate for %+v but got: %+v", v.Config, err)
+		}
+
+		if certificate == nil || privateKey == nil {
+			t.Fatalf("Expected a Certificate and Private Key for %+v", v.Config)
+		}
+	}
+}
+
+func TestServicePrincipalOIDCSecret(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer request-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if r.URL.Query().Get("audience") != "api://AzureADTokenExchange" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"count": 1, "value": "requested-id-token"}`))
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		Secret        servicePrincipalOIDCSecret
+		ExpectedToken string
+		ExpectError   bool
+	}{
+		{
+			Secret: servicePrincipalOIDCSecret{
+				token: "static-id-token",
+			},
+			ExpectedToken: "static-id-token",
+		},
+		{
+			Secret: servicePrincipalOIDCSecret{
+				requestURL:   fmt.Sprintf("%s/?api-version=2.0", server.URL),
+				requestToken: "request-token",
+			},
+			ExpectedToken: "requested-id-token",
+		},
+		{
+			Secret: servicePrincipalOIDCSecret{
+				requestURL:   server.URL,
+				requestToken: "incorrect-token",
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range cases {
+		values := url.Values{}
+		err := v.Secret.SetAuthenticationValues(nil, &values)
+		if v.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected an error for %+v but didn't get one", v.Secret)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error for %+v but got: %+v", v.Secret, err)
+		}
+
+		if actual := values.Get("client_assertion"); actual != v.ExpectedToken {
+			t.Fatalf("Expected the Client Assertion to be %q but got %q", v.ExpectedToken, actual)
+		}
+
+		if actual := values.Get("client_assertion_type"); actual != "urn:ietf:params:oauth:client-assertion-type:jwt-bearer" {
+			t.Fatalf("Expected the Client Assertion Type to be a JWT Bearer but got %q", actual)
+		}
+	}
+}
+
+func TestArmClientConfigureClient(t *testing.T) {
+	originalUserAgent := os.Getenv("AZURE_HTTP_USER_AGENT")
+	defer os.Setenv("AZURE_HTTP_USER_AGENT", originalUserAgent)
+
+	baseUserAgent := fmt.Sprintf("HashiCorp-Terraform-v%s", terraform.VersionString())
+	cases := []struct {
+		PartnerID         string
+		UserAgentSuffix   string
+		ExpectedUserAgent string
+	}{
+		{
+			ExpectedUserAgent: baseUserAgent,
+		},
+		{
+			UserAgentSuffix:   "cloud-shell/1.0",
+			ExpectedUserAgent: fmt.Sprintf("%s cloud-shell/1.0", baseUserAgent),
+		},
+		{
+			PartnerID:         "00000000-0000-0000-0000-000000000000",
+			ExpectedUserAgent: fmt.Sprintf("%s pid-00000000-0000-0000-0000-000000000000", baseUserAgent),
+		},
+		{
+			PartnerID:         "00000000-0000-0000-0000-000000000000",
+			UserAgentSuffix:   "cloud-shell/1.0",
+			ExpectedUserAgent: fmt.Sprintf("%s cloud-shell/1.0 pid-00000000-0000-0000-0000-000000000000", baseUserAgent),
+		},
+	}
+
+	for _, v := range cases {
+		os.Setenv("AZURE_HTTP_USER_AGENT", v.UserAgentSuffix)
+		armClient := ArmClient{
+			partnerId: v.PartnerID,
+		}
+
+		client := autorest.Client{}
+		armClient.configureClient(&client)
+		if client.UserAgent != v.ExpectedUserAgent {
+			t.Fatalf("Expected the User Agent to be %q but got %q", v.ExpectedUserAgent, client.UserAgent)
+		}
+	}
+}
+
+func TestWithCorrelationRequestID(t *testing.T) {
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Get("x-ms-correlation-request-id")
+	}))
+	defer server.Close()
+
+	sender := autorest.CreateSender(withRequestLogging(), withCorrelationRequestID("correlation-id"))
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("Error building request: %+v", err)
+	}
+
+	if _, err := sender.Do(req); err != nil {
+		t.Fatalf("Error sending request: %+v", err)
+	}
+
+	if received != "correlation-id" {
+		t.Fatalf("Expected the Correlation Request ID to be %q but got %q", "correlation-id", received)
+	}
+}
+
+func TestSanitizeHTTPDump(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "GET /subscriptions HTTP/1.1\r\nHost: management.azure.com\r\nAuthorization: Bearer abc.def.ghi\r\n\r\n",
+			Expected: "GET /subscriptions HTTP/1.1\r\nHost: management.azure.com\r\nAuthorization: [REDACTED]\r\n\r\n",
+		},
+		{
+			Input:    `{"properties":{"adminUsername":"admin","adminPassword":"P@ssw0rd!"}}`,
+			Expected: `{"properties":{"adminUsername":"admin","adminPassword":"[REDACTED]"}}`,
+		},
+		{
+			Input:    `{"primaryKey": "abc\"123", "keyType": "RSA", "clientSecret": "shh"}`,
+			Expected: `{"primaryKey": "[REDACTED]", "keyType": "RSA", "clientSecret": "[REDACTED]"}`,
+		},
+		{
+			Input:    `{"connectionString":"Endpoint=sb://example/;SharedAccessKey=abc"}`,
+			Expected: `{"connectionString":"[REDACTED]"}`,
+		},
+		{
+			Input:    "grant_type=client_credentials&client_id=123&client_secret=shh&resource=https://management.azure.com/",
+			Expected: "grant_type=client_credentials&client_id=123&client_secret=[REDACTED]&resource=https://management.azure.com/",
+		},
+		{
+			Input:    `{"name":"example","location":"westeurope"}`,
+			Expected: `{"name":"example","location":"westeurope"}`,
+		},
+	}
+
+	for _, v := range cases {
+		actual := sanitizeHTTPDump(v.Input)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q to be sanitized to %q but got %q", v.Input, v.Expected, actual)
+		}
+	}
+}
+
+func TestWithRetries(t *testing.T) {
+	cases := []struct {
+		Name             string
+		StatusCodes      []int
+		MaxRetries       int
+		ExpectedStatus   int
+		ExpectedAttempts int
+	}{
+		{
+			Name:             "Success",
+			StatusCodes:      []int{http.StatusOK},
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusOK,
+			ExpectedAttempts: 1,
+		},
+		{
+			Name:             "Throttled then Success",
+			StatusCodes:      []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusOK},
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusOK,
+			ExpectedAttempts: 3,
+		},
+		{
+			Name:             "Retries Exhausted",
+			StatusCodes:      []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests},
+			MaxRetries:       2,
+			ExpectedStatus:   http.StatusTooManyRequests,
+			ExpectedAttempts: 3,
+		},
+		{
+			Name:             "Retries Disabled",
+			StatusCodes:      []int{http.StatusInternalServerError, http.StatusOK},
+			MaxRetries:       0,
+			ExpectedStatus:   http.StatusInternalServerError,
+			ExpectedAttempts: 1,
+		},
+		{
+			Name:             "Not Retryable",
+			StatusCodes:      []int{http.StatusConflict, http.StatusOK},
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusConflict,
+			ExpectedAttempts: 1,
+		},
+	}
+
+	for _, v := range cases {
+		attempts := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(v.StatusCodes[attempts])
+			attempts++
+		}))
+
+		sender := autorest.CreateSender(withRetries(v.MaxRetries, time.Millisecond))
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatalf("Error building request: %+v", err)
+		}
+
+		resp, err := sender.Do(req)
+		server.Close()
+		if err != nil {
+			t.Fatalf("[%s] Error sending request: %+v", v.Name, err)
+		}
+
+		if resp.StatusCode != v.ExpectedStatus {
+			t.Fatalf("[%s] Expected the Status Code to be %d but got %d", v.Name, v.ExpectedStatus, resp.StatusCode)
+		}
+
+		if attempts != v.ExpectedAttempts {
+			t.Fatalf("[%s] Expected %d attempts but got %d", v.Name, v.ExpectedAttempts, attempts)
+		}
+
+		if v.ExpectedStatus == http.StatusTooManyRequests && resp.Header.Get("Retry-After") != "" {
+			t.Fatalf("[%s] Expected the `Retry-After` header to be removed once retries were exhausted", v.Name)
+		}
+	}
+}
+
+func TestWithRetriesStopped(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+		attempts++
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopContext := func() context.Context {
+		return ctx
+	}
+	sender := autorest.CreateSender(withRetries(3, time.Millisecond), withStopContext(stopContext))
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("Error building request: %+v", err)
+	}
+
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	start := time.Now()
+	if _, err := sender.Do(req); err == nil {
+		t.Fatalf("Expected an error once the Stop Context was cancelled but didn't get one")
+	}
+
+	if elapsed := time.Since(start); elapsed > 30*time.Second {
+		t.Fatalf("Expected the request to stop retrying once the Stop Context was cancelled, but it took %s", elapsed)
+	}
+
+	if attempts != 1 {
+		t.Fatalf("Expected 1 attempt but got %d", attempts)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	cases := []struct {
+		Name       string
+		RetryAfter string
+		Attempt    int
+		Expected   time.Duration
+	}{
+		{
+			Name:     "First Attempt",
+			Attempt:  0,
+			Expected: 10 * time.Second,
+		},
+		{
+			Name:     "Exponential Backoff",
+			Attempt:  2,
+			Expected: 40 * time.Second,
+		},
+		{
+			Name:     "Limited to the Maximum",
+			Attempt:  10,
+			Expected: maxRetryDelay,
+		},
+		{
+			Name:       "Retry After",
+			RetryAfter: "3",
+			Attempt:    2,
+			Expected:   3 * time.Second,
+		},
+		{
+			Name:       "Retry After Limited to the Maximum",
+			RetryAfter: "3600",
+			Attempt:    0,
+			Expected:   maxRetryDelay,
+		},
+	}
+
+	for _, v := range cases {
+		resp := &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			Header:     http.Header{},
+		}
+		if v.RetryAfter != "" {
+			resp.Header.Set("Retry-After", v.RetryAfter)
+		}
+
+		actual := retryDelay(resp, 10*time.Second, v.Attempt)
+		if actual != v.Expected {
+			t.Fatalf("[%s] Expected a delay of %s but got %s", v.Name, v.Expected, actual)
+		}
+	}
+}
+
 func testLocation() string {
 	return os.Getenv("ARM_TEST_LOCATION")
 }
@@ -68,20 +532,110 @@ func testArmEnvironmentName() string {
 }
 
 func testArmEnvironment() (*azure.Environment, error) {
-	envName := testArmEnvironmentName()
+	return azureEnvironmentFromName(testArmEnvironmentName())
+}
+
+func TestAzureEnvironmentFromName(t *testing.T) {
+	cases := []struct {
+		Name                    string
+		ExpectedEnvironmentName string
+		ExpectError             bool
+	}{
+		{
+			Name:                    "public",
+			ExpectedEnvironmentName: azure.PublicCloud.Name,
+		},
+		{
+			Name:                    "usgovernment",
+			ExpectedEnvironmentName: azure.USGovernmentCloud.Name,
+		},
+		{
+			Name:                    "german",
+			ExpectedEnvironmentName: azure.GermanCloud.Name,
+		},
+		{
+			Name:                    "china",
+			ExpectedEnvironmentName: azure.ChinaCloud.Name,
+		},
+		{
+			Name:                    "AzureChinaCloud",
+			ExpectedEnvironmentName: azure.ChinaCloud.Name,
+		},
+		{
+			Name:        "mars",
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range cases {
+		env, err := azureEnvironmentFromName(v.Name)
+		if v.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected an error for Environment %q but didn't get one", v.Name)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error for Environment %q but got: %+v", v.Name, err)
+		}
+
+		if env.Name != v.ExpectedEnvironmentName {
+			t.Fatalf("Expected Environment %q for %q but got %q", v.ExpectedEnvironmentName, v.Name, env.Name)
+		}
+	}
+}
 
-	// detect cloud from environment
-	env, envErr := azure.EnvironmentFromName(envName)
-	if envErr != nil {
-		// try again with wrapped value to support readable values like german instead of AZUREGERMANCLOUD
-		wrapped := fmt.Sprintf("AZURE%sCLOUD", envName)
-		var innerErr error
-		if env, innerErr = azure.EnvironmentFromName(wrapped); innerErr != nil {
-			return nil, envErr
+func TestAzureEnvironmentFromMetadata(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/metadata/endpoints" || r.URL.Query().Get("api-version") != "1.0" {
+			w.WriteHeader(http.StatusNotFound)
+			return
 		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{
+  "galleryEndpoint": "https://portal.local.azurestack.external:30015/",
+  "graphEndpoint": "https://graph.windows.net/",
+  "portalEndpoint": "https://portal.local.azurestack.external/",
+  "authentication": {
+    "loginEndpoint": "https://login.windows.net",
+    "audiences": [
+      "https://management.example.onmicrosoft.com/abc123"
+    ]
+  }
+}`))
+	}))
+	defer server.Close()
+
+	env, audience, err := azureEnvironmentFromMetadata(server.URL)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if audience != "https://management.example.onmicrosoft.com/abc123" {
+		t.Fatalf("Expected the Token Audience to be from the metadata but got %q", audience)
+	}
+
+	if expected := fmt.Sprintf("%s/", server.URL); env.ResourceManagerEndpoint != expected {
+		t.Fatalf("Expected the Resource Manager Endpoint to be %q but got %q", expected, env.ResourceManagerEndpoint)
 	}
 
-	return &env, nil
+	if env.ActiveDirectoryEndpoint != "https://login.windows.net/" {
+		t.Fatalf("Expected the Active Directory Endpoint to be %q but got %q", "https://login.windows.net/", env.ActiveDirectoryEndpoint)
+	}
+
+	if env.GraphEndpoint != "https://graph.windows.net/" {
+		t.Fatalf("Expected the Graph Endpoint to be %q but got %q", "https://graph.windows.net/", env.GraphEndpoint)
+	}
+
+	if _, _, err := azureEnvironmentFromMetadata(fmt.Sprintf("%s/missing", server.URL)); err == nil {
+		t.Fatalf("Expected an error when the metadata isn't available but didn't get one")
+	}
+
+	if _, _, err := azureEnvironmentFromMetadata("management.local.azurestack.external"); err == nil {
+		t.Fatalf("Expected an error for an endpoint without a scheme but didn't get one")
+	}
 }
 
 func testGetAzureConfig(t *testing.T) *Config {
@@ -100,6 +654,8 @@ func testGetAzureConfig(t *testing.T) *Config {
 		ClientSecret:             os.Getenv("ARM_CLIENT_SECRET"),
 		Environment:              environment,
 		SkipProviderRegistration: false,
+		MaxRetries:               3,
+		RetryBackoff:             30 * time.Second,
 	}
 	return &config
 }
@@ -123,13 +679,95 @@ func TestAccAzureRMResourceProviderRegistration(t *testing.T) {
 			"error: %s", err)
 	}
 
-	err = registerAzureResourceProvidersWithSubscription(*providerList.Value, client)
+	providers := requiredResourceProviders()
+	err = registerAzureResourceProvidersWithSubscription(*providerList.Value, providers, client)
 	if err != nil {
 		t.Fatalf("Error registering Resource Providers: %+v", err)
 	}
 
-	needingRegistration := determineAzureResourceProvidersToRegister(*providerList.Value)
+	needingRegistration := determineAzureResourceProvidersToRegister(*providerList.Value, providers)
 	if len(needingRegistration) > 0 {
 		t.Fatalf("'%d' Resource Providers are still Pending Registration: %s", len(needingRegistration), spew.Sprint(needingRegistration))
 	}
 }
+
+func TestResourceProvidersToRegister(t *testing.T) {
+	required := requiredResourceProviders()
+
+	cases := []struct {
+		RestrictTo []string
+		Additional []string
+		Expected   []string
+		Count      int
+	}{
+		{
+			Expected: []string{"Microsoft.Compute", "Microsoft.Storage"},
+			Count:    len(required),
+		},
+		{
+			RestrictTo: []string{"Microsoft.Network"},
+			Expected:   []string{"Microsoft.Network"},
+			Count:      1,
+		},
+		{
+			Additional: []string{"Microsoft.Web"},
+			Expected:   []string{"Microsoft.Compute", "Microsoft.Web"},
+			Count:      len(required) + 1,
+		},
+		{
+			RestrictTo: []string{"Microsoft.Network"},
+			Additional: []string{"Microsoft.Web"},
+			Expected:   []string{"Microsoft.Network", "Microsoft.Web"},
+			Count:      2,
+		},
+	}
+
+	for _, v := range cases {
+		actual := resourceProvidersToRegister(v.RestrictTo, v.Additional)
+		if len(actual) != v.Count {
+			t.Fatalf("Expected %d Resource Providers but got %d: %s", v.Count, len(actual), spew.Sprint(actual))
+		}
+
+		for _, p := range v.Expected {
+			if _, ok := actual[p]; !ok {
+				t.Fatalf("Expected %q to be registered but it wasn't: %s", p, spew.Sprint(actual))
+			}
+		}
+	}
+}
+
+func TestDetermineAzureResourceProvidersToRegister(t *testing.T) {
+	providerList := []resources.Provider{
+		{
+			Namespace:         utils.String("Microsoft.Compute"),
+			RegistrationState: utils.String("Registered"),
+		},
+		{
+			Namespace:         utils.String("Microsoft.Insights"),
+			RegistrationState: utils.String("Registered"),
+		},
+		{
+			Namespace:         utils.String("Microsoft.Network"),
+			RegistrationState: utils.String("NotRegistered"),
+		},
+	}
+
+	requested := map[string]struct{}{
+		"Microsoft.Compute":  {},
+		"microsoft.insights": {},
+		"Microsoft.Network":  {},
+	}
+
+	actual := determineAzureResourceProvidersToRegister(providerList, requested)
+	if len(actual) != 1 {
+		t.Fatalf("Expected 1 Resource Provider to need registration but got %d: %s", len(actual), spew.Sprint(actual))
+	}
+
+	if _, ok := actual["Microsoft.Network"]; !ok {
+		t.Fatalf("Expected `Microsoft.Network` to need registration but got: %s", spew.Sprint(actual))
+	}
+
+	if len(requested) != 3 {
+		t.Fatalf("Expected the requested Resource Providers not to be modified")
+	}
+}
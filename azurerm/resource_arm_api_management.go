@@ -0,0 +1,488 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/apimanagement"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementServiceCreateUpdate,
+		Read:   resourceArmApiManagementServiceRead,
+		Update: resourceArmApiManagementServiceCreateUpdate,
+		Delete: resourceArmApiManagementServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("service"),
+		},
+
+		// provisioning an API Management Service can take upwards of 30 minutes
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": apiManagementServiceNameSchema(),
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"publisher_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"publisher_email": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+
+			"sku": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(apimanagement.Developer),
+								string(apimanagement.Standard),
+								string(apimanagement.Premium),
+							}, true),
+						},
+
+						"capacity": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"hostname_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"management": apiManagementHostnameSchema(),
+						"portal":     apiManagementHostnameSchema(),
+						"proxy":      apiManagementHostnameSchema(),
+						"scm":        apiManagementHostnameSchema(),
+					},
+				},
+			},
+
+			"notification_sender_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+
+			"gateway_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"portal_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"management_api_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"scm_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"public_ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceArmApiManagementServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementServicesClient
+
+	name := d.Get("name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	resourceGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	log.Printf("[INFO] preparing arguments for API Management Service %q (Resource Group %q)", name, resourceGroup)
+
+	properties := apimanagement.ServiceResource{
+		Location: utils.String(location),
+		Sku:      expandApiManagementServiceSku(d),
+		Tags:     expandResourceTags(d, tags, meta),
+		ServiceProperties: &apimanagement.ServiceProperties{
+			PublisherName:  utils.String(d.Get("publisher_name").(string)),
+			PublisherEmail: utils.String(d.Get("publisher_email").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("notification_sender_email"); ok {
+		properties.ServiceProperties.AddresserEmail = utils.String(v.(string))
+	}
+
+	// Custom Hostnames are managed separately below - so we need to retain any which are already assigned
+	if !d.IsNewResource() {
+		existing, err := client.Get(resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if props := existing.ServiceProperties; props != nil {
+			properties.ServiceProperties.HostnameConfigurations = props.HostnameConfigurations
+		}
+	}
+
+	if _, err := client.CreateOrUpdate(resourceGroup, name, properties); err != nil {
+		return fmt.Errorf("Error creating/updating API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	// provisioning an API Management Service can take upwards of 30 minutes
+	log.Printf("[DEBUG] Waiting for API Management Service %q (Resource Group %q) to finish provisioning", name, resourceGroup)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Created", "Activating", "Updating"},
+		Target:     []string{"Succeeded"},
+		Refresh:    apiManagementServiceStateRefreshFunc(client, resourceGroup, name),
+		Timeout:    createUpdateTimeout(d),
+		MinTimeout: 30 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for API Management Service %q (Resource Group %q) to finish provisioning: %+v", name, resourceGroup, err)
+	}
+
+	if d.HasChange("hostname_configuration") {
+		if err := updateApiManagementServiceHostnames(d, meta, resourceGroup, name); err != nil {
+			return err
+		}
+	}
+
+	read, err := client.Get(resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read API Management Service %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmApiManagementServiceRead(d, meta)
+}
+
+func resourceArmApiManagementServiceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementServicesClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["service"]
+
+	resp, err := client.Get(resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] API Management Service %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if err := d.Set("sku", flattenApiManagementServiceSku(resp.Sku)); err != nil {
+		return fmt.Errorf("Error setting `sku`: %+v", err)
+	}
+
+	if props := resp.ServiceProperties; props != nil {
+		d.Set("publisher_name", props.PublisherName)
+		d.Set("publisher_email", props.PublisherEmail)
+		d.Set("notification_sender_email", props.AddresserEmail)
+		d.Set("gateway_url", props.RuntimeURL)
+		d.Set("portal_url", props.PortalURL)
+		d.Set("management_api_url", props.ManagementAPIURL)
+		d.Set("scm_url", props.ScmURL)
+
+		if err := d.Set("hostname_configuration", flattenApiManagementServiceHostnames(d, props.HostnameConfigurations)); err != nil {
+			return fmt.Errorf("Error setting `hostname_configuration`: %+v", err)
+		}
+
+		ipAddresses := make([]interface{}, 0)
+		if ips := props.StaticIPs; ips != nil {
+			for _, ip := range *ips {
+				ipAddresses = append(ipAddresses, ip)
+			}
+		}
+		if err := d.Set("public_ip_addresses", ipAddresses); err != nil {
+			return fmt.Errorf("Error setting `public_ip_addresses`: %+v", err)
+		}
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmApiManagementServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementServicesClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["service"]
+
+	resp, err := client.Delete(resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func apiManagementServiceStateRefreshFunc(client apimanagement.ServicesClient, resourceGroup string, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(resourceGroup, name)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error polling for the status of API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if props := resp.ServiceProperties; props != nil && props.ProvisioningState != nil {
+			return resp, *props.ProvisioningState, nil
+		}
+
+		return resp, "", nil
+	}
+}
+
+func apiManagementHostnameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"host_name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+
+				"certificate": {
+					Type:      schema.TypeString,
+					Required:  true,
+					Sensitive: true,
+				},
+
+				"certificate_password": {
+					Type:      schema.TypeString,
+					Required:  true,
+					Sensitive: true,
+				},
+
+				"thumbprint": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"subject": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"expiry": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+var apiManagementHostnameTypes = map[string]apimanagement.HostnameType{
+	"management": apimanagement.Management,
+	"portal":     apimanagement.Portal,
+	"proxy":      apimanagement.Proxy,
+	"scm":        apimanagement.Scm,
+}
+
+func updateApiManagementServiceHostnames(d *schema.ResourceData, meta interface{}, resourceGroup string, name string) error {
+	client := meta.(*ArmClient).apiManagementServicesClient
+
+	o, n := d.GetChange("hostname_configuration")
+	oldHostnames := expandApiManagementServiceHostnames(o.([]interface{}))
+	newHostnames := expandApiManagementServiceHostnames(n.([]interface{}))
+
+	updates := make([]apimanagement.HostnameConfiguration, 0)
+	for key, v := range newHostnames {
+		hostnameType := apiManagementHostnameTypes[key]
+		hostname := v["host_name"].(string)
+
+		// the SSL Certificate has to be uploaded before it can be assigned to the Hostname
+		log.Printf("[DEBUG] Uploading the %s Certificate for API Management Service %q (Resource Group %q)", hostnameType, name, resourceGroup)
+		certificate := apimanagement.ServiceUploadCertificateParameters{
+			Type:                hostnameType,
+			Certificate:         utils.String(v["certificate"].(string)),
+			CertificatePassword: utils.String(v["certificate_password"].(string)),
+		}
+		info, err := client.UploadCertificate(resourceGroup, name, certificate)
+		if err != nil {
+			return fmt.Errorf("Error uploading the %s Certificate for API Management Service %q (Resource Group %q): %+v", hostnameType, name, resourceGroup, err)
+		}
+
+		updates = append(updates, apimanagement.HostnameConfiguration{
+			Type:     hostnameType,
+			Hostname: utils.String(hostname),
+			Certificate: &apimanagement.CertificateInformation{
+				Thumbprint: info.Thumbprint,
+				Subject:    info.Subject,
+				Expiry:     info.Expiry,
+			},
+		})
+	}
+
+	deletes := make([]apimanagement.HostnameType, 0)
+	for key := range oldHostnames {
+		if _, ok := newHostnames[key]; !ok {
+			deletes = append(deletes, apiManagementHostnameTypes[key])
+		}
+	}
+
+	if len(updates) == 0 && len(deletes) == 0 {
+		return nil
+	}
+
+	parameters := apimanagement.ServiceUpdateHostnameParameters{
+		Update: &updates,
+		Delete: &deletes,
+	}
+
+	log.Printf("[DEBUG] Updating the Hostnames for API Management Service %q (Resource Group %q)", name, resourceGroup)
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, errChan := client.UpdateHostname(resourceGroup, name, parameters, cancel)
+	if err := <-errChan; err != nil {
+		return fmt.Errorf("Error updating the Hostnames for API Management Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandApiManagementServiceHostnames(input []interface{}) map[string]map[string]interface{} {
+	hostnames := make(map[string]map[string]interface{}, 0)
+	if len(input) == 0 || input[0] == nil {
+		return hostnames
+	}
+
+	config := input[0].(map[string]interface{})
+	for key := range apiManagementHostnameTypes {
+		values := config[key].([]interface{})
+		if len(values) == 0 || values[0] == nil {
+			continue
+		}
+
+		hostnames[key] = values[0].(map[string]interface{})
+	}
+
+	return hostnames
+}
+
+func flattenApiManagementServiceHostnames(d *schema.ResourceData, input *[]apimanagement.HostnameConfiguration) []interface{} {
+	if input == nil || len(*input) == 0 {
+		return []interface{}{}
+	}
+
+	config := make(map[string]interface{}, 0)
+	for _, v := range *input {
+		key := strings.ToLower(string(v.Type))
+
+		hostname := make(map[string]interface{}, 0)
+		if v.Hostname != nil {
+			hostname["host_name"] = *v.Hostname
+		}
+
+		// the Certificate itself isn't returned from the API - so we pull it from the existing state
+		hostname["certificate"] = d.Get(fmt.Sprintf("hostname_configuration.0.%s.0.certificate", key)).(string)
+		hostname["certificate_password"] = d.Get(fmt.Sprintf("hostname_configuration.0.%s.0.certificate_password", key)).(string)
+
+		if certificate := v.Certificate; certificate != nil {
+			if certificate.Thumbprint != nil {
+				hostname["thumbprint"] = *certificate.Thumbprint
+			}
+			if certificate.Subject != nil {
+				hostname["subject"] = *certificate.Subject
+			}
+			if certificate.Expiry != nil {
+				hostname["expiry"] = certificate.Expiry.Format(time.RFC3339)
+			}
+		}
+
+		config[key] = []interface{}{hostname}
+	}
+
+	return []interface{}{config}
+}
+
+func expandApiManagementServiceSku(d *schema.ResourceData) *apimanagement.ServiceSkuProperties {
+	skus := d.Get("sku").([]interface{})
+	sku := skus[0].(map[string]interface{})
+
+	return &apimanagement.ServiceSkuProperties{
+		Name:     apimanagement.SkuType(sku["name"].(string)),
+		Capacity: utils.Int32(int32(sku["capacity"].(int))),
+	}
+}
+
+func flattenApiManagementServiceSku(input *apimanagement.ServiceSkuProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	sku := map[string]interface{}{
+		"name": string(input.Name),
+	}
+	if input.Capacity != nil {
+		sku["capacity"] = int(*input.Capacity)
+	}
+
+	return []interface{}{sku}
+}
@@ -0,0 +1,259 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/apimanagement"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementApi() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementApiCreateUpdate,
+		Read:   resourceArmApiManagementApiRead,
+		Update: resourceArmApiManagementApiCreateUpdate,
+		Delete: resourceArmApiManagementApiDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("service", "apis"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": apiManagementChildNameSchema(),
+
+			"api_management_name": apiManagementServiceNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 300),
+			},
+
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(0, 400),
+			},
+
+			"service_url": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"protocols": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"http",
+						"https",
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+
+			"soap_pass_through": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"subscription_key_parameter_names": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"header": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"query": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmApiManagementApiCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	client := armClient.apiManagementApisClient
+
+	name := d.Get("name").(string)
+	serviceName := d.Get("api_management_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	log.Printf("[INFO] preparing arguments for API Management API %q (API Management Service %q / Resource Group %q)", name, serviceName, resourceGroup)
+
+	apiType := apimanagement.APITypeContractHTTP
+	if d.Get("soap_pass_through").(bool) {
+		apiType = apimanagement.APITypeContractSoap
+	}
+
+	parameters := apimanagement.APIContract{
+		Name:                          utils.String(d.Get("display_name").(string)),
+		Path:                          utils.String(d.Get("path").(string)),
+		ServiceURL:                    utils.String(d.Get("service_url").(string)),
+		Description:                   utils.String(d.Get("description").(string)),
+		Protocols:                     expandApiManagementApiProtocols(d),
+		SubscriptionKeyParameterNames: expandApiManagementApiSubscriptionKeyParameterNames(d),
+		Type:                          apiType,
+	}
+
+	// an ETag mustn't be specified when creating an API - but is required when updating one
+	ifMatch := ""
+	if !d.IsNewResource() {
+		ifMatch = "*"
+	}
+
+	if _, err := client.CreateOrUpdate(resourceGroup, serviceName, name, parameters, ifMatch); err != nil {
+		return fmt.Errorf("Error creating/updating API Management API %q (API Management Service %q / Resource Group %q): %+v", name, serviceName, resourceGroup, err)
+	}
+
+	if _, err := client.Get(resourceGroup, serviceName, name); err != nil {
+		return fmt.Errorf("Error retrieving API Management API %q (API Management Service %q / Resource Group %q): %+v", name, serviceName, resourceGroup, err)
+	}
+
+	d.SetId(apiManagementResourceID(armClient.subscriptionId, resourceGroup, serviceName, "apis", name))
+
+	return resourceArmApiManagementApiRead(d, meta)
+}
+
+func resourceArmApiManagementApiRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementApisClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	name := id.Path["apis"]
+
+	resp, err := client.Get(resourceGroup, serviceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] API Management API %q was not found in API Management Service %q / Resource Group %q - removing from state!", name, serviceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving API Management API %q (API Management Service %q / Resource Group %q): %+v", name, serviceName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("api_management_name", serviceName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("display_name", resp.Name)
+	d.Set("path", resp.Path)
+	d.Set("service_url", resp.ServiceURL)
+	d.Set("description", resp.Description)
+	d.Set("soap_pass_through", resp.Type == apimanagement.APITypeContractSoap)
+
+	if err := d.Set("protocols", flattenApiManagementApiProtocols(resp.Protocols)); err != nil {
+		return fmt.Errorf("Error setting `protocols`: %+v", err)
+	}
+
+	if err := d.Set("subscription_key_parameter_names", flattenApiManagementApiSubscriptionKeyParameterNames(resp.SubscriptionKeyParameterNames)); err != nil {
+		return fmt.Errorf("Error setting `subscription_key_parameter_names`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmApiManagementApiDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementApisClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	name := id.Path["apis"]
+
+	resp, err := client.Delete(resourceGroup, serviceName, name, "*")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting API Management API %q (API Management Service %q / Resource Group %q): %+v", name, serviceName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandApiManagementApiProtocols(d *schema.ResourceData) *[]apimanagement.APIProtocolContract {
+	protocols := make([]apimanagement.APIProtocolContract, 0)
+
+	for _, v := range d.Get("protocols").(*schema.Set).List() {
+		switch strings.ToLower(v.(string)) {
+		case "http":
+			protocols = append(protocols, apimanagement.HTTP)
+		case "https":
+			protocols = append(protocols, apimanagement.HTTPS)
+		}
+	}
+
+	return &protocols
+}
+
+func flattenApiManagementApiProtocols(input *[]apimanagement.APIProtocolContract) *schema.Set {
+	protocols := make([]interface{}, 0)
+
+	if input != nil {
+		for _, v := range *input {
+			protocols = append(protocols, strings.ToLower(string(v)))
+		}
+	}
+
+	return schema.NewSet(schema.HashString, protocols)
+}
+
+func expandApiManagementApiSubscriptionKeyParameterNames(d *schema.ResourceData) *apimanagement.SubscriptionKeyParameterNamesContract {
+	names := d.Get("subscription_key_parameter_names").([]interface{})
+	if len(names) == 0 {
+		return nil
+	}
+
+	v := names[0].(map[string]interface{})
+	return &apimanagement.SubscriptionKeyParameterNamesContract{
+		Header: utils.String(v["header"].(string)),
+		Query:  utils.String(v["query"].(string)),
+	}
+}
+
+func flattenApiManagementApiSubscriptionKeyParameterNames(input *apimanagement.SubscriptionKeyParameterNamesContract) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	names := make(map[string]interface{}, 0)
+	if v := input.Header; v != nil {
+		names["header"] = *v
+	}
+	if v := input.Query; v != nil {
+		names["query"] = *v
+	}
+
+	return []interface{}{names}
+}
@@ -0,0 +1,456 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/apimanagement"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementApiOperation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementApiOperationCreateUpdate,
+		Read:   resourceArmApiManagementApiOperationRead,
+		Update: resourceArmApiManagementApiOperationCreateUpdate,
+		Delete: resourceArmApiManagementApiOperationDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("service", "apis", "operations"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"operation_id": apiManagementChildNameSchema(),
+
+			"api_name": apiManagementChildNameSchema(),
+
+			"api_management_name": apiManagementServiceNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 300),
+			},
+
+			"method": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"url_template": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"template_parameter": apiManagementOperationParameterSchema(),
+
+			"request": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"header": apiManagementOperationParameterSchema(),
+
+						"query_parameter": apiManagementOperationParameterSchema(),
+
+						"representation": apiManagementOperationRepresentationSchema(),
+					},
+				},
+			},
+
+			"response": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status_code": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"representation": apiManagementOperationRepresentationSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func apiManagementOperationParameterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+
+				"required": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+
+				"description": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+
+				"default_value": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+
+				"values": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Set:      schema.HashString,
+				},
+			},
+		},
+	}
+}
+
+func apiManagementOperationRepresentationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"content_type": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+
+				"sample": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmApiManagementApiOperationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	client := armClient.apiManagementApiOperationsClient
+
+	operationId := d.Get("operation_id").(string)
+	apiName := d.Get("api_name").(string)
+	serviceName := d.Get("api_management_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	log.Printf("[INFO] preparing arguments for API Management API Operation %q (API %q / API Management Service %q / Resource Group %q)", operationId, apiName, serviceName, resourceGroup)
+
+	templateParameters := expandApiManagementOperationParameters(d.Get("template_parameter").([]interface{}))
+	responses := expandApiManagementOperationResponses(d.Get("response").([]interface{}))
+
+	parameters := apimanagement.OperationContract{
+		Name:               utils.String(d.Get("display_name").(string)),
+		Method:             utils.String(d.Get("method").(string)),
+		URLTemplate:        utils.String(d.Get("url_template").(string)),
+		Description:        utils.String(d.Get("description").(string)),
+		TemplateParameters: &templateParameters,
+		Request:            expandApiManagementOperationRequest(d.Get("request").([]interface{})),
+		Responses:          &responses,
+	}
+
+	if _, err := client.CreateOrUpdate(resourceGroup, serviceName, apiName, operationId, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating API Management API Operation %q (API %q / API Management Service %q / Resource Group %q): %+v", operationId, apiName, serviceName, resourceGroup, err)
+	}
+
+	if _, err := client.Get(resourceGroup, serviceName, apiName, operationId); err != nil {
+		return fmt.Errorf("Error retrieving API Management API Operation %q (API %q / API Management Service %q / Resource Group %q): %+v", operationId, apiName, serviceName, resourceGroup, err)
+	}
+
+	d.SetId(apiManagementResourceID(armClient.subscriptionId, resourceGroup, serviceName, "apis", apiName, "operations", operationId))
+
+	return resourceArmApiManagementApiOperationRead(d, meta)
+}
+
+func resourceArmApiManagementApiOperationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementApiOperationsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	apiName := id.Path["apis"]
+	operationId := id.Path["operations"]
+
+	resp, err := client.Get(resourceGroup, serviceName, apiName, operationId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] API Management API Operation %q was not found in API %q / API Management Service %q / Resource Group %q - removing from state!", operationId, apiName, serviceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving API Management API Operation %q (API %q / API Management Service %q / Resource Group %q): %+v", operationId, apiName, serviceName, resourceGroup, err)
+	}
+
+	d.Set("operation_id", operationId)
+	d.Set("api_name", apiName)
+	d.Set("api_management_name", serviceName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("display_name", resp.Name)
+	d.Set("method", resp.Method)
+	d.Set("url_template", resp.URLTemplate)
+	d.Set("description", resp.Description)
+
+	if err := d.Set("template_parameter", flattenApiManagementOperationParameters(resp.TemplateParameters)); err != nil {
+		return fmt.Errorf("Error setting `template_parameter`: %+v", err)
+	}
+
+	if err := d.Set("request", flattenApiManagementOperationRequest(resp.Request)); err != nil {
+		return fmt.Errorf("Error setting `request`: %+v", err)
+	}
+
+	if err := d.Set("response", flattenApiManagementOperationResponses(resp.Responses)); err != nil {
+		return fmt.Errorf("Error setting `response`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmApiManagementApiOperationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementApiOperationsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	apiName := id.Path["apis"]
+	operationId := id.Path["operations"]
+
+	resp, err := client.Delete(resourceGroup, serviceName, apiName, operationId, "*")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting API Management API Operation %q (API %q / API Management Service %q / Resource Group %q): %+v", operationId, apiName, serviceName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandApiManagementOperationParameters(input []interface{}) []apimanagement.ParameterContract {
+	parameters := make([]apimanagement.ParameterContract, 0)
+
+	for _, v := range input {
+		vals := v.(map[string]interface{})
+
+		values := make([]string, 0)
+		for _, value := range vals["values"].(*schema.Set).List() {
+			values = append(values, value.(string))
+		}
+
+		parameter := apimanagement.ParameterContract{
+			Name:     utils.String(vals["name"].(string)),
+			Type:     utils.String(vals["type"].(string)),
+			Required: utils.Bool(vals["required"].(bool)),
+			Values:   &values,
+		}
+
+		if description := vals["description"].(string); description != "" {
+			parameter.Description = utils.String(description)
+		}
+		if defaultValue := vals["default_value"].(string); defaultValue != "" {
+			parameter.DefaultValue = utils.String(defaultValue)
+		}
+
+		parameters = append(parameters, parameter)
+	}
+
+	return parameters
+}
+
+func flattenApiManagementOperationParameters(input *[]apimanagement.ParameterContract) []interface{} {
+	parameters := make([]interface{}, 0)
+	if input == nil {
+		return parameters
+	}
+
+	for _, v := range *input {
+		parameter := make(map[string]interface{}, 0)
+
+		if v.Name != nil {
+			parameter["name"] = *v.Name
+		}
+		if v.Type != nil {
+			parameter["type"] = *v.Type
+		}
+		if v.Required != nil {
+			parameter["required"] = *v.Required
+		}
+		if v.Description != nil {
+			parameter["description"] = *v.Description
+		}
+		if v.DefaultValue != nil {
+			parameter["default_value"] = *v.DefaultValue
+		}
+
+		values := make([]interface{}, 0)
+		if v.Values != nil {
+			for _, value := range *v.Values {
+				values = append(values, value)
+			}
+		}
+		parameter["values"] = schema.NewSet(schema.HashString, values)
+
+		parameters = append(parameters, parameter)
+	}
+
+	return parameters
+}
+
+func expandApiManagementOperationRepresentations(input []interface{}) *[]apimanagement.RepresentationContract {
+	representations := make([]apimanagement.RepresentationContract, 0)
+
+	for _, v := range input {
+		vals := v.(map[string]interface{})
+
+		representation := apimanagement.RepresentationContract{
+			ContentType: utils.String(vals["content_type"].(string)),
+		}
+		if sample := vals["sample"].(string); sample != "" {
+			representation.Sample = utils.String(sample)
+		}
+
+		representations = append(representations, representation)
+	}
+
+	return &representations
+}
+
+func flattenApiManagementOperationRepresentations(input *[]apimanagement.RepresentationContract) []interface{} {
+	representations := make([]interface{}, 0)
+	if input == nil {
+		return representations
+	}
+
+	for _, v := range *input {
+		representation := make(map[string]interface{}, 0)
+
+		if v.ContentType != nil {
+			representation["content_type"] = *v.ContentType
+		}
+		if v.Sample != nil {
+			representation["sample"] = *v.Sample
+		}
+
+		representations = append(representations, representation)
+	}
+
+	return representations
+}
+
+func expandApiManagementOperationRequest(input []interface{}) *apimanagement.RequestContract {
+	if len(input) == 0 {
+		return nil
+	}
+
+	vals := input[0].(map[string]interface{})
+	headers := expandApiManagementOperationParameters(vals["header"].([]interface{}))
+	queryParameters := expandApiManagementOperationParameters(vals["query_parameter"].([]interface{}))
+
+	return &apimanagement.RequestContract{
+		Description:     utils.String(vals["description"].(string)),
+		Headers:         &headers,
+		QueryParameters: &queryParameters,
+		Representations: expandApiManagementOperationRepresentations(vals["representation"].([]interface{})),
+	}
+}
+
+func flattenApiManagementOperationRequest(input *apimanagement.RequestContract) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	request := map[string]interface{}{
+		"header":          flattenApiManagementOperationParameters(input.Headers),
+		"query_parameter": flattenApiManagementOperationParameters(input.QueryParameters),
+		"representation":  flattenApiManagementOperationRepresentations(input.Representations),
+	}
+	if input.Description != nil {
+		request["description"] = *input.Description
+	}
+
+	return []interface{}{request}
+}
+
+func expandApiManagementOperationResponses(input []interface{}) []apimanagement.ResultContract {
+	responses := make([]apimanagement.ResultContract, 0)
+
+	for _, v := range input {
+		vals := v.(map[string]interface{})
+
+		response := apimanagement.ResultContract{
+			StatusCode:      utils.Int32(int32(vals["status_code"].(int))),
+			Representations: expandApiManagementOperationRepresentations(vals["representation"].([]interface{})),
+		}
+		if description := vals["description"].(string); description != "" {
+			response.Description = utils.String(description)
+		}
+
+		responses = append(responses, response)
+	}
+
+	return responses
+}
+
+func flattenApiManagementOperationResponses(input *[]apimanagement.ResultContract) []interface{} {
+	responses := make([]interface{}, 0)
+	if input == nil {
+		return responses
+	}
+
+	for _, v := range *input {
+		response := map[string]interface{}{
+			"representation": flattenApiManagementOperationRepresentations(v.Representations),
+		}
+
+		if v.StatusCode != nil {
+			response["status_code"] = int(*v.StatusCode)
+		}
+		if v.Description != nil {
+			response["description"] = *v.Description
+		}
+
+		responses = append(responses, response)
+	}
+
+	return responses
+}
@@ -0,0 +1,115 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementApiOperationPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementApiOperationPolicyCreateUpdate,
+		Read:   resourceArmApiManagementApiOperationPolicyRead,
+		Update: resourceArmApiManagementApiOperationPolicyCreateUpdate,
+		Delete: resourceArmApiManagementApiOperationPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("service", "apis", "operations"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"operation_id": apiManagementChildNameSchema(),
+
+			"api_name": apiManagementChildNameSchema(),
+
+			"api_management_name": apiManagementServiceNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"xml_content": apiManagementPolicySchema(),
+		},
+	}
+}
+
+func resourceArmApiManagementApiOperationPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	client := armClient.apiManagementApiOperationPolicyClient
+
+	operationId := d.Get("operation_id").(string)
+	apiName := d.Get("api_name").(string)
+	serviceName := d.Get("api_management_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	xmlContent := d.Get("xml_content").(string)
+
+	log.Printf("[INFO] preparing arguments for API Management API Operation Policy (Operation %q / API %q / API Management Service %q / Resource Group %q)", operationId, apiName, serviceName, resourceGroup)
+
+	if _, err := client.CreateOrUpdate(resourceGroup, serviceName, apiName, operationId, expandApiManagementPolicy(xmlContent), "*"); err != nil {
+		return fmt.Errorf("Error creating/updating API Management API Operation Policy (Operation %q / API %q / API Management Service %q / Resource Group %q): %+v", operationId, apiName, serviceName, resourceGroup, err)
+	}
+
+	d.SetId(apiManagementResourceID(armClient.subscriptionId, resourceGroup, serviceName, "apis", apiName, "operations", operationId, "policies", "policy"))
+
+	return resourceArmApiManagementApiOperationPolicyRead(d, meta)
+}
+
+func resourceArmApiManagementApiOperationPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementApiOperationPolicyClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	apiName := id.Path["apis"]
+	operationId := id.Path["operations"]
+
+	resp, err := client.Get(resourceGroup, serviceName, apiName, operationId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] API Management API Operation Policy was not found in Operation %q / API %q / API Management Service %q / Resource Group %q - removing from state!", operationId, apiName, serviceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving API Management API Operation Policy (Operation %q / API %q / API Management Service %q / Resource Group %q): %+v", operationId, apiName, serviceName, resourceGroup, err)
+	}
+
+	xmlContent, err := flattenApiManagementPolicy(resp.Value)
+	if err != nil {
+		return fmt.Errorf("Error reading API Management API Operation Policy (Operation %q / API %q / API Management Service %q / Resource Group %q): %+v", operationId, apiName, serviceName, resourceGroup, err)
+	}
+
+	d.Set("operation_id", operationId)
+	d.Set("api_name", apiName)
+	d.Set("api_management_name", serviceName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("xml_content", xmlContent)
+
+	return nil
+}
+
+func resourceArmApiManagementApiOperationPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementApiOperationPolicyClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	apiName := id.Path["apis"]
+	operationId := id.Path["operations"]
+
+	resp, err := client.Delete(resourceGroup, serviceName, apiName, operationId, "*")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting API Management API Operation Policy (Operation %q / API %q / API Management Service %q / Resource Group %q): %+v", operationId, apiName, serviceName, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMApiManagementApiOperationPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_api_management_api_operation_policy.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApiManagementApiOperationPolicy_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementApiOperationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementApiOperationPolicyExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "xml_content"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApiManagementApiOperationPolicyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		operationId := rs.Primary.Attributes["operation_id"]
+		apiName := rs.Primary.Attributes["api_name"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for API Management API Operation Policy: %s", operationId)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).apiManagementApiOperationPolicyClient
+		resp, err := conn.Get(resourceGroup, serviceName, apiName, operationId)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on apiManagementApiOperationPolicyClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: API Management API Operation Policy (Operation %q / API %q / API Management Service %q / resource group: %q) does not exist", operationId, apiName, serviceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMApiManagementApiOperationPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).apiManagementApiOperationPolicyClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_api_management_api_operation_policy" {
+			continue
+		}
+
+		operationId := rs.Primary.Attributes["operation_id"]
+		apiName := rs.Primary.Attributes["api_name"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(resourceGroup, serviceName, apiName, operationId)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("API Management API Operation Policy still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMApiManagementApiOperationPolicy_basic(rInt int, location string) string {
+	template := testAccAzureRMApiManagementApiOperation_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api_operation_policy" "test" {
+  operation_id        = "${azurerm_api_management_api_operation.test.operation_id}"
+  api_name            = "${azurerm_api_management_api.test.name}"
+  api_management_name = "${azurerm_api_management.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  xml_content = <<XML
+<policies>
+  <inbound>
+    <base />
+    <set-query-parameter name="format" exists-action="skip">
+      <value>json</value>
+    </set-query-parameter>
+  </inbound>
+  <backend>
+    <base />
+  </backend>
+  <outbound>
+    <base />
+  </outbound>
+  <on-error>
+    <base />
+  </on-error>
+</policies>
+XML
+}
+`, template)
+}
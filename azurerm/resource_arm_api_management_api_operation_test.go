@@ -0,0 +1,185 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMApiManagementApiOperation_basic(t *testing.T) {
+	resourceName := "azurerm_api_management_api_operation.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApiManagementApiOperation_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementApiOperationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementApiOperationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "method", "GET"),
+					resource.TestCheckResourceAttr(resourceName, "url_template", "/resource"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApiManagementApiOperation_complete(t *testing.T) {
+	resourceName := "azurerm_api_management_api_operation.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApiManagementApiOperation_complete(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementApiOperationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementApiOperationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "template_parameter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "template_parameter.0.name", "id"),
+					resource.TestCheckResourceAttr(resourceName, "request.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "request.0.header.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "request.0.query_parameter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "response.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "response.0.status_code", "200"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApiManagementApiOperationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		operationId := rs.Primary.Attributes["operation_id"]
+		apiName := rs.Primary.Attributes["api_name"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for API Management API Operation: %s", operationId)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).apiManagementApiOperationsClient
+		resp, err := conn.Get(resourceGroup, serviceName, apiName, operationId)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on apiManagementApiOperationsClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: API Management API Operation %q (API %q / API Management Service %q / resource group: %q) does not exist", operationId, apiName, serviceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMApiManagementApiOperationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).apiManagementApiOperationsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_api_management_api_operation" {
+			continue
+		}
+
+		operationId := rs.Primary.Attributes["operation_id"]
+		apiName := rs.Primary.Attributes["api_name"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(resourceGroup, serviceName, apiName, operationId)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("API Management API Operation still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMApiManagementApiOperation_basic(rInt int, location string) string {
+	template := testAccAzureRMApiManagementApi_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api_operation" "test" {
+  operation_id        = "retrieve-resource"
+  api_name            = "${azurerm_api_management_api.test.name}"
+  api_management_name = "${azurerm_api_management.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  display_name        = "Retrieve Resource"
+  method              = "GET"
+  url_template        = "/resource"
+}
+`, template)
+}
+
+func testAccAzureRMApiManagementApiOperation_complete(rInt int, location string) string {
+	template := testAccAzureRMApiManagementApi_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api_operation" "test" {
+  operation_id        = "retrieve-resource"
+  api_name            = "${azurerm_api_management_api.test.name}"
+  api_management_name = "${azurerm_api_management.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  display_name        = "Retrieve Resource"
+  description         = "Retrieves a single Resource"
+  method              = "GET"
+  url_template        = "/resource/{id}"
+
+  template_parameter {
+    name     = "id"
+    type     = "number"
+    required = true
+  }
+
+  request {
+    description = "The Resource to retrieve"
+
+    header {
+      name     = "X-Request-Id"
+      type     = "string"
+      required = false
+    }
+
+    query_parameter {
+      name          = "format"
+      type          = "string"
+      required      = false
+      default_value = "json"
+      values        = ["json", "xml"]
+    }
+  }
+
+  response {
+    status_code = 200
+    description = "The Resource was found"
+
+    representation {
+      content_type = "application/json"
+      sample       = "{ \"id\": 1 }"
+    }
+  }
+}
+`, template)
+}
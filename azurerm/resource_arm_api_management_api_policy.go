@@ -0,0 +1,109 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementApiPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementApiPolicyCreateUpdate,
+		Read:   resourceArmApiManagementApiPolicyRead,
+		Update: resourceArmApiManagementApiPolicyCreateUpdate,
+		Delete: resourceArmApiManagementApiPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("service", "apis"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_name": apiManagementChildNameSchema(),
+
+			"api_management_name": apiManagementServiceNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"xml_content": apiManagementPolicySchema(),
+		},
+	}
+}
+
+func resourceArmApiManagementApiPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	client := armClient.apiManagementApiPolicyClient
+
+	apiName := d.Get("api_name").(string)
+	serviceName := d.Get("api_management_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	xmlContent := d.Get("xml_content").(string)
+
+	log.Printf("[INFO] preparing arguments for API Management API Policy (API %q / API Management Service %q / Resource Group %q)", apiName, serviceName, resourceGroup)
+
+	if _, err := client.CreateOrUpdate(resourceGroup, serviceName, apiName, expandApiManagementPolicy(xmlContent), "*"); err != nil {
+		return fmt.Errorf("Error creating/updating API Management API Policy (API %q / API Management Service %q / Resource Group %q): %+v", apiName, serviceName, resourceGroup, err)
+	}
+
+	d.SetId(apiManagementResourceID(armClient.subscriptionId, resourceGroup, serviceName, "apis", apiName, "policies", "policy"))
+
+	return resourceArmApiManagementApiPolicyRead(d, meta)
+}
+
+func resourceArmApiManagementApiPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementApiPolicyClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	apiName := id.Path["apis"]
+
+	resp, err := client.Get(resourceGroup, serviceName, apiName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] API Management API Policy was not found in API %q / API Management Service %q / Resource Group %q - removing from state!", apiName, serviceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving API Management API Policy (API %q / API Management Service %q / Resource Group %q): %+v", apiName, serviceName, resourceGroup, err)
+	}
+
+	xmlContent, err := flattenApiManagementPolicy(resp.Value)
+	if err != nil {
+		return fmt.Errorf("Error reading API Management API Policy (API %q / API Management Service %q / Resource Group %q): %+v", apiName, serviceName, resourceGroup, err)
+	}
+
+	d.Set("api_name", apiName)
+	d.Set("api_management_name", serviceName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("xml_content", xmlContent)
+
+	return nil
+}
+
+func resourceArmApiManagementApiPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementApiPolicyClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	apiName := id.Path["apis"]
+
+	resp, err := client.Delete(resourceGroup, serviceName, apiName, "*")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting API Management API Policy (API %q / API Management Service %q / Resource Group %q): %+v", apiName, serviceName, resourceGroup, err)
+	}
+
+	return nil
+}
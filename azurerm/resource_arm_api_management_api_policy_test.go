@@ -0,0 +1,117 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMApiManagementApiPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_api_management_api_policy.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApiManagementApiPolicy_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementApiPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementApiPolicyExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "xml_content"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApiManagementApiPolicyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		apiName := rs.Primary.Attributes["api_name"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for API Management API Policy: %s", apiName)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).apiManagementApiPolicyClient
+		resp, err := conn.Get(resourceGroup, serviceName, apiName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on apiManagementApiPolicyClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: API Management API Policy (API %q / API Management Service %q / resource group: %q) does not exist", apiName, serviceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMApiManagementApiPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).apiManagementApiPolicyClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_api_management_api_policy" {
+			continue
+		}
+
+		apiName := rs.Primary.Attributes["api_name"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(resourceGroup, serviceName, apiName)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("API Management API Policy still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMApiManagementApiPolicy_basic(rInt int, location string) string {
+	template := testAccAzureRMApiManagementApi_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api_policy" "test" {
+  api_name            = "${azurerm_api_management_api.test.name}"
+  api_management_name = "${azurerm_api_management.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  xml_content = <<XML
+<policies>
+  <inbound>
+    <base />
+    <find-and-replace from="xyz" to="abc" />
+  </inbound>
+  <backend>
+    <base />
+  </backend>
+  <outbound>
+    <base />
+  </outbound>
+  <on-error>
+    <base />
+  </on-error>
+</policies>
+XML
+}
+`, template)
+}
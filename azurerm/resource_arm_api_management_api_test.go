@@ -0,0 +1,160 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMApiManagementApi_basic(t *testing.T) {
+	resourceName := "azurerm_api_management_api.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApiManagementApi_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Echo API"),
+					resource.TestCheckResourceAttr(resourceName, "path", "echo"),
+					resource.TestCheckResourceAttr(resourceName, "protocols.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "soap_pass_through", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApiManagementApi_update(t *testing.T) {
+	resourceName := "azurerm_api_management_api.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApiManagementApi_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "protocols.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMApiManagementApi_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementApiExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "An API which echoes requests"),
+					resource.TestCheckResourceAttr(resourceName, "protocols.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "subscription_key_parameter_names.0.header", "X-Echo-Key"),
+					resource.TestCheckResourceAttr(resourceName, "subscription_key_parameter_names.0.query", "echo-key"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApiManagementApiExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		apiName := rs.Primary.Attributes["name"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for API Management API: %s", apiName)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).apiManagementApisClient
+		resp, err := conn.Get(resourceGroup, serviceName, apiName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on apiManagementApisClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: API Management API %q (API Management Service %q / resource group: %q) does not exist", apiName, serviceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMApiManagementApiDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).apiManagementApisClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_api_management_api" {
+			continue
+		}
+
+		apiName := rs.Primary.Attributes["name"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(resourceGroup, serviceName, apiName)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("API Management API still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMApiManagementApi_basic(rInt int, location string) string {
+	template := testAccAzureRMApiManagementService_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api" "test" {
+  name                = "echo-api"
+  api_management_name = "${azurerm_api_management.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  display_name        = "Echo API"
+  path                = "echo"
+  service_url         = "http://echoapi.cloudapp.net/api"
+  protocols           = ["https"]
+}
+`, template)
+}
+
+func testAccAzureRMApiManagementApi_complete(rInt int, location string) string {
+	template := testAccAzureRMApiManagementService_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api" "test" {
+  name                = "echo-api"
+  api_management_name = "${azurerm_api_management.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  display_name        = "Echo API"
+  description         = "An API which echoes requests"
+  path                = "echo"
+  service_url         = "http://echoapi.cloudapp.net/api"
+  protocols           = ["http", "https"]
+
+  subscription_key_parameter_names {
+    header = "X-Echo-Key"
+    query  = "echo-key"
+  }
+}
+`, template)
+}
@@ -0,0 +1,359 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/apimanagement"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementBackend() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementBackendCreateUpdate,
+		Read:   resourceArmApiManagementBackendRead,
+		Update: resourceArmApiManagementBackendCreateUpdate,
+		Delete: resourceArmApiManagementBackendDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("service", "backends"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend_id": apiManagementChildNameSchema(),
+
+			"api_management_name": apiManagementServiceNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2000),
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(apimanagement.BackendProtocolHTTP),
+					string(apimanagement.BackendProtocolSoap),
+				}, false),
+			},
+
+			"title": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 300),
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2000),
+			},
+
+			"resource_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2000),
+			},
+
+			"credentials": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+
+						"certificate_thumbprints": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 32,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"header": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+
+						"query": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"tls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"validate_certificate_chain": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"validate_certificate_name": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmApiManagementBackendCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	client := armClient.apiManagementBackendsClient
+
+	backendId := d.Get("backend_id").(string)
+	serviceName := d.Get("api_management_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	log.Printf("[INFO] preparing arguments for API Management Backend %q (API Management Service %q / Resource Group %q)", backendId, serviceName, resourceGroup)
+
+	parameters := apimanagement.BackendContract{
+		URL:               utils.String(d.Get("url").(string)),
+		Protocol:          apimanagement.BackendProtocol(d.Get("protocol").(string)),
+		BackendProperties: expandApiManagementBackendTls(d.Get("tls").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("title"); ok {
+		parameters.Title = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		parameters.Description = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("resource_id"); ok {
+		parameters.ResourceID = utils.String(v.(string))
+	}
+
+	credentials := d.Get("credentials").([]interface{})
+	if len(credentials) > 0 && credentials[0] != nil {
+		creds := credentials[0].(map[string]interface{})
+
+		if v := creds["username"].(string); v != "" {
+			parameters.Username = utils.String(v)
+		}
+
+		if v := creds["password"].(string); v != "" {
+			parameters.Password = utils.String(v)
+		}
+
+		thumbprints := make([]string, 0)
+		for _, v := range creds["certificate_thumbprints"].([]interface{}) {
+			thumbprints = append(thumbprints, v.(string))
+		}
+		parameters.Certificate = &thumbprints
+
+		header := expandApiManagementBackendParameters(creds["header"].(map[string]interface{}))
+		parameters.Header = &header
+
+		query := expandApiManagementBackendParameters(creds["query"].(map[string]interface{}))
+		parameters.Query = &query
+	}
+
+	if _, err := client.CreateOrUpdate(resourceGroup, serviceName, backendId, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating API Management Backend %q (API Management Service %q / Resource Group %q): %+v", backendId, serviceName, resourceGroup, err)
+	}
+
+	if _, err := client.Get(resourceGroup, serviceName, backendId); err != nil {
+		return fmt.Errorf("Error retrieving API Management Backend %q (API Management Service %q / Resource Group %q): %+v", backendId, serviceName, resourceGroup, err)
+	}
+
+	d.SetId(apiManagementResourceID(armClient.subscriptionId, resourceGroup, serviceName, "backends", backendId))
+
+	return resourceArmApiManagementBackendRead(d, meta)
+}
+
+func resourceArmApiManagementBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementBackendsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	backendId := id.Path["backends"]
+
+	resp, err := client.Get(resourceGroup, serviceName, backendId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] API Management Backend %q was not found in API Management Service %q / Resource Group %q - removing from state!", backendId, serviceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving API Management Backend %q (API Management Service %q / Resource Group %q): %+v", backendId, serviceName, resourceGroup, err)
+	}
+
+	d.Set("backend_id", backendId)
+	d.Set("api_management_name", serviceName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("url", resp.URL)
+	d.Set("protocol", string(resp.Protocol))
+	d.Set("title", resp.Title)
+	d.Set("description", resp.Description)
+	d.Set("resource_id", resp.ResourceID)
+
+	if err := d.Set("credentials", flattenApiManagementBackendCredentials(d, resp)); err != nil {
+		return fmt.Errorf("Error flattening `credentials`: %+v", err)
+	}
+
+	if err := d.Set("tls", flattenApiManagementBackendTls(resp.BackendProperties)); err != nil {
+		return fmt.Errorf("Error flattening `tls`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmApiManagementBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementBackendsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	backendId := id.Path["backends"]
+
+	resp, err := client.Delete(resourceGroup, serviceName, backendId, "*")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting API Management Backend %q (API Management Service %q / Resource Group %q): %+v", backendId, serviceName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandApiManagementBackendTls(input []interface{}) *apimanagement.BackendProperties {
+	validateChain := true
+	validateName := true
+
+	if len(input) > 0 && input[0] != nil {
+		tls := input[0].(map[string]interface{})
+		validateChain = tls["validate_certificate_chain"].(bool)
+		validateName = tls["validate_certificate_name"].(bool)
+	}
+
+	return &apimanagement.BackendProperties{
+		SkipCertificateChainValidation: utils.Bool(!validateChain),
+		SkipCertificateNameValidation:  utils.Bool(!validateName),
+	}
+}
+
+func flattenApiManagementBackendTls(input *apimanagement.BackendProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	validateChain := true
+	if v := input.SkipCertificateChainValidation; v != nil {
+		validateChain = !*v
+	}
+
+	validateName := true
+	if v := input.SkipCertificateNameValidation; v != nil {
+		validateName = !*v
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"validate_certificate_chain": validateChain,
+			"validate_certificate_name":  validateName,
+		},
+	}
+}
+
+// Header and Query parameters can have multiple values, which are specified as a comma-separated string
+func expandApiManagementBackendParameters(input map[string]interface{}) map[string][]string {
+	output := make(map[string][]string, 0)
+
+	for k, v := range input {
+		values := make([]string, 0)
+		for _, value := range strings.Split(v.(string), ",") {
+			values = append(values, strings.TrimSpace(value))
+		}
+		output[k] = values
+	}
+
+	return output
+}
+
+func flattenApiManagementBackendParameters(input *map[string][]string) map[string]interface{} {
+	output := make(map[string]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for k, v := range *input {
+		output[k] = strings.Join(v, ",")
+	}
+
+	return output
+}
+
+func flattenApiManagementBackendCredentials(d *schema.ResourceData, input apimanagement.BackendResponse) []interface{} {
+	username := ""
+	if v := input.Username; v != nil {
+		username = *v
+	}
+
+	// the Password isn't always returned from the API - so we look it up from the state
+	password := d.Get("credentials.0.password").(string)
+	if v := input.Password; v != nil && *v != "" {
+		password = *v
+	}
+
+	thumbprints := make([]string, 0)
+	if v := input.Certificate; v != nil {
+		thumbprints = *v
+	}
+
+	header := flattenApiManagementBackendParameters(input.Header)
+	query := flattenApiManagementBackendParameters(input.Query)
+
+	if username == "" && password == "" && len(thumbprints) == 0 && len(header) == 0 && len(query) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"username":                username,
+			"password":                password,
+			"certificate_thumbprints": thumbprints,
+			"header":                  header,
+			"query":                   query,
+		},
+	}
+}
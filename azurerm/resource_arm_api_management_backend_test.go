@@ -0,0 +1,175 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMApiManagementBackend_basic(t *testing.T) {
+	resourceName := "azurerm_api_management_backend.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementBackendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApiManagementBackend_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementBackendExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "url", "https://backend.example.com/api"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "http"),
+					resource.TestCheckResourceAttr(resourceName, "credentials.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApiManagementBackend_update(t *testing.T) {
+	resourceName := "azurerm_api_management_backend.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementBackendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApiManagementBackend_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementBackendExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "http"),
+					resource.TestCheckResourceAttr(resourceName, "tls.0.validate_certificate_chain", "true"),
+				),
+			},
+			{
+				Config: testAccAzureRMApiManagementBackend_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementBackendExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "soap"),
+					resource.TestCheckResourceAttr(resourceName, "title", "Test Backend"),
+					resource.TestCheckResourceAttr(resourceName, "credentials.0.username", "backenduser"),
+					resource.TestCheckResourceAttr(resourceName, "credentials.0.header.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "credentials.0.header.x-version", "1,2"),
+					resource.TestCheckResourceAttr(resourceName, "credentials.0.query.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tls.0.validate_certificate_chain", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tls.0.validate_certificate_name", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApiManagementBackendExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		backendId := rs.Primary.Attributes["backend_id"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for API Management Backend: %s", backendId)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).apiManagementBackendsClient
+		resp, err := conn.Get(resourceGroup, serviceName, backendId)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on apiManagementBackendsClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: API Management Backend %q (API Management Service %q / resource group: %q) does not exist", backendId, serviceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMApiManagementBackendDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).apiManagementBackendsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_api_management_backend" {
+			continue
+		}
+
+		backendId := rs.Primary.Attributes["backend_id"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(resourceGroup, serviceName, backendId)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("API Management Backend still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMApiManagementBackend_basic(rInt int, location string) string {
+	template := testAccAzureRMApiManagementService_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_backend" "test" {
+  backend_id          = "test-backend"
+  api_management_name = "${azurerm_api_management.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  url                 = "https://backend.example.com/api"
+  protocol            = "http"
+}
+`, template)
+}
+
+func testAccAzureRMApiManagementBackend_complete(rInt int, location string) string {
+	template := testAccAzureRMApiManagementService_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_backend" "test" {
+  backend_id          = "test-backend"
+  api_management_name = "${azurerm_api_management.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  url                 = "https://backend.example.com/soap"
+  protocol            = "soap"
+  title               = "Test Backend"
+  description         = "A backend for testing"
+
+  credentials {
+    username = "backenduser"
+    password = "P@55w0rd1234!"
+
+    header {
+      x-version = "1,2"
+      x-client  = "terraform"
+    }
+
+    query {
+      code = "abc123"
+    }
+  }
+
+  tls {
+    validate_certificate_chain = false
+    validate_certificate_name  = false
+  }
+}
+`, template)
+}
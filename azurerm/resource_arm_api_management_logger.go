@@ -0,0 +1,181 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/apimanagement"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementLogger() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementLoggerCreateUpdate,
+		Read:   resourceArmApiManagementLoggerRead,
+		Update: resourceArmApiManagementLoggerCreateUpdate,
+		Delete: resourceArmApiManagementLoggerDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("service", "loggers"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"logger_id": apiManagementChildNameSchema(),
+
+			"api_management_name": apiManagementServiceNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"eventhub": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"connection_string": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+
+			"buffered": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+		},
+	}
+}
+
+func resourceArmApiManagementLoggerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	client := armClient.apiManagementLoggersClient
+
+	loggerId := d.Get("logger_id").(string)
+	serviceName := d.Get("api_management_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	log.Printf("[INFO] preparing arguments for API Management Logger %q (API Management Service %q / Resource Group %q)", loggerId, serviceName, resourceGroup)
+
+	eventHubs := d.Get("eventhub").([]interface{})
+	eventHub := eventHubs[0].(map[string]interface{})
+	credentials := map[string]*string{
+		"name":             utils.String(eventHub["name"].(string)),
+		"connectionString": utils.String(eventHub["connection_string"].(string)),
+	}
+
+	parameters := apimanagement.LoggerCreateParameters{
+		Type:        utils.String("AzureEventHub"),
+		Credentials: &credentials,
+		IsBuffered:  utils.Bool(d.Get("buffered").(bool)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		parameters.Description = utils.String(v.(string))
+	}
+
+	if _, err := client.CreateOrUpdate(resourceGroup, serviceName, loggerId, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating API Management Logger %q (API Management Service %q / Resource Group %q): %+v", loggerId, serviceName, resourceGroup, err)
+	}
+
+	if _, err := client.Get(resourceGroup, serviceName, loggerId); err != nil {
+		return fmt.Errorf("Error retrieving API Management Logger %q (API Management Service %q / Resource Group %q): %+v", loggerId, serviceName, resourceGroup, err)
+	}
+
+	d.SetId(apiManagementResourceID(armClient.subscriptionId, resourceGroup, serviceName, "loggers", loggerId))
+
+	return resourceArmApiManagementLoggerRead(d, meta)
+}
+
+func resourceArmApiManagementLoggerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementLoggersClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	loggerId := id.Path["loggers"]
+
+	resp, err := client.Get(resourceGroup, serviceName, loggerId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] API Management Logger %q was not found in API Management Service %q / Resource Group %q - removing from state!", loggerId, serviceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving API Management Logger %q (API Management Service %q / Resource Group %q): %+v", loggerId, serviceName, resourceGroup, err)
+	}
+
+	d.Set("logger_id", loggerId)
+	d.Set("api_management_name", serviceName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("description", resp.Description)
+
+	buffered := true
+	if v := resp.IsBuffered; v != nil {
+		buffered = *v
+	}
+	d.Set("buffered", buffered)
+
+	if err := d.Set("eventhub", flattenApiManagementLoggerEventHub(d, resp.Credentials)); err != nil {
+		return fmt.Errorf("Error flattening `eventhub`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmApiManagementLoggerDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementLoggersClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	loggerId := id.Path["loggers"]
+
+	resp, err := client.Delete(resourceGroup, serviceName, loggerId, "*")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting API Management Logger %q (API Management Service %q / Resource Group %q): %+v", loggerId, serviceName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func flattenApiManagementLoggerEventHub(d *schema.ResourceData, input *map[string]*string) []interface{} {
+	eventHub := make(map[string]interface{}, 0)
+
+	if input != nil {
+		if v := (*input)["name"]; v != nil {
+			eventHub["name"] = *v
+		}
+	}
+
+	// the API returns a reference to where the Connection String is stored, rather than the value - so we look it up from the state
+	eventHub["connection_string"] = d.Get("eventhub.0.connection_string").(string)
+
+	return []interface{}{eventHub}
+}
@@ -0,0 +1,105 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementPolicyCreateUpdate,
+		Read:   resourceArmApiManagementPolicyRead,
+		Update: resourceArmApiManagementPolicyCreateUpdate,
+		Delete: resourceArmApiManagementPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("service"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_management_name": apiManagementServiceNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"xml_content": apiManagementPolicySchema(),
+		},
+	}
+}
+
+func resourceArmApiManagementPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	client := armClient.apiManagementTenantPolicyClient
+
+	serviceName := d.Get("api_management_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	xmlContent := d.Get("xml_content").(string)
+
+	log.Printf("[INFO] preparing arguments for the Global Policy of API Management Service %q (Resource Group %q)", serviceName, resourceGroup)
+
+	// the Global Policy always exists, so it's always an update
+	if _, err := client.CreateOrUpdate(resourceGroup, serviceName, expandApiManagementPolicy(xmlContent), "*"); err != nil {
+		return fmt.Errorf("Error setting the Global Policy for API Management Service %q (Resource Group %q): %+v", serviceName, resourceGroup, err)
+	}
+
+	d.SetId(apiManagementResourceID(armClient.subscriptionId, resourceGroup, serviceName, "policies", "policy"))
+
+	return resourceArmApiManagementPolicyRead(d, meta)
+}
+
+func resourceArmApiManagementPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementTenantPolicyClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+
+	resp, err := client.Get(resourceGroup, serviceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] The Global Policy for API Management Service %q was not found in Resource Group %q - removing from state!", serviceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving the Global Policy for API Management Service %q (Resource Group %q): %+v", serviceName, resourceGroup, err)
+	}
+
+	xmlContent, err := flattenApiManagementPolicy(resp.Value)
+	if err != nil {
+		return fmt.Errorf("Error reading the Global Policy for API Management Service %q (Resource Group %q): %+v", serviceName, resourceGroup, err)
+	}
+
+	d.Set("api_management_name", serviceName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("xml_content", xmlContent)
+
+	return nil
+}
+
+func resourceArmApiManagementPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementTenantPolicyClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+
+	// deleting the Global Policy resets it to the default
+	resp, err := client.Delete(resourceGroup, serviceName, "*")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting the Global Policy for API Management Service %q (Resource Group %q): %+v", serviceName, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestApiManagementPolicyDiffSuppress(t *testing.T) {
+	cases := []struct {
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{
+			Old:      "<policies><inbound /></policies>",
+			New:      "<policies><inbound /></policies>",
+			Suppress: true,
+		},
+		{
+			Old:      "<policies>\r\n\t<inbound />\r\n</policies>",
+			New:      "<policies>\n  <inbound />\n</policies>\n",
+			Suppress: true,
+		},
+		{
+			Old:      "<policies><inbound /></policies>",
+			New:      "<policies><outbound /></policies>",
+			Suppress: false,
+		},
+	}
+
+	for _, tc := range cases {
+		suppress := apiManagementPolicyDiffSuppressFunc("xml_content", tc.Old, tc.New, nil)
+		if suppress != tc.Suppress {
+			t.Fatalf("Expected the diff between %q and %q to be suppressed %t but got %t", tc.Old, tc.New, tc.Suppress, suppress)
+		}
+	}
+}
+
+func TestAccAzureRMApiManagementPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_api_management_policy.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApiManagementPolicy_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		// the Global Policy is reset rather than removed, so we check the Service has gone
+		CheckDestroy: testCheckAzureRMApiManagementServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementPolicyExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "xml_content"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApiManagementPolicyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for the Global Policy of API Management Service: %s", serviceName)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).apiManagementTenantPolicyClient
+		resp, err := conn.Get(resourceGroup, serviceName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on apiManagementTenantPolicyClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Global Policy for API Management Service %q (resource group: %q) does not exist", serviceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMApiManagementPolicy_basic(rInt int, location string) string {
+	template := testAccAzureRMApiManagementService_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_policy" "test" {
+  api_management_name = "${azurerm_api_management.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  xml_content = <<XML
+<policies>
+  <inbound>
+    <set-header name="X-Terraform" exists-action="override">
+      <value>true</value>
+    </set-header>
+  </inbound>
+  <backend>
+    <forward-request />
+  </backend>
+  <outbound />
+  <on-error />
+</policies>
+XML
+}
+`, template)
+}
@@ -0,0 +1,188 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/apimanagement"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementProduct() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementProductCreateUpdate,
+		Read:   resourceArmApiManagementProductRead,
+		Update: resourceArmApiManagementProductCreateUpdate,
+		Delete: resourceArmApiManagementProductDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("service", "products"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"product_id": apiManagementChildNameSchema(),
+
+			"api_management_name": apiManagementServiceNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 300),
+			},
+
+			"published": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+
+			"subscription_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"approval_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"subscriptions_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"terms": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmApiManagementProductCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	client := armClient.apiManagementProductsClient
+
+	productId := d.Get("product_id").(string)
+	serviceName := d.Get("api_management_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	subscriptionRequired := d.Get("subscription_required").(bool)
+	approvalRequired := d.Get("approval_required").(bool)
+
+	log.Printf("[INFO] preparing arguments for API Management Product %q (API Management Service %q / Resource Group %q)", productId, serviceName, resourceGroup)
+
+	state := apimanagement.NotPublished
+	if d.Get("published").(bool) {
+		state = apimanagement.Published
+	}
+
+	parameters := apimanagement.ProductContract{
+		Name:                 utils.String(d.Get("display_name").(string)),
+		Description:          utils.String(d.Get("description").(string)),
+		Terms:                utils.String(d.Get("terms").(string)),
+		SubscriptionRequired: utils.Bool(subscriptionRequired),
+		State:                state,
+	}
+
+	// the API only accepts these fields when Subscriptions are required
+	if subscriptionRequired {
+		parameters.ApprovalRequired = utils.Bool(approvalRequired)
+
+		if v, ok := d.GetOk("subscriptions_limit"); ok {
+			parameters.SubscriptionsLimit = utils.Int32(int32(v.(int)))
+		}
+	} else if approvalRequired {
+		return fmt.Errorf("`approval_required` can only be set when `subscription_required` is set to `true`")
+	}
+
+	if _, err := client.CreateOrUpdate(resourceGroup, serviceName, productId, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating API Management Product %q (API Management Service %q / Resource Group %q): %+v", productId, serviceName, resourceGroup, err)
+	}
+
+	if _, err := client.Get(resourceGroup, serviceName, productId); err != nil {
+		return fmt.Errorf("Error retrieving API Management Product %q (API Management Service %q / Resource Group %q): %+v", productId, serviceName, resourceGroup, err)
+	}
+
+	d.SetId(apiManagementResourceID(armClient.subscriptionId, resourceGroup, serviceName, "products", productId))
+
+	return resourceArmApiManagementProductRead(d, meta)
+}
+
+func resourceArmApiManagementProductRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementProductsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	productId := id.Path["products"]
+
+	resp, err := client.Get(resourceGroup, serviceName, productId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] API Management Product %q was not found in API Management Service %q / Resource Group %q - removing from state!", productId, serviceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving API Management Product %q (API Management Service %q / Resource Group %q): %+v", productId, serviceName, resourceGroup, err)
+	}
+
+	d.Set("product_id", productId)
+	d.Set("api_management_name", serviceName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("display_name", resp.Name)
+	d.Set("description", resp.Description)
+	d.Set("terms", resp.Terms)
+	d.Set("published", resp.State == apimanagement.Published)
+	d.Set("subscription_required", resp.SubscriptionRequired)
+
+	approvalRequired := false
+	if v := resp.ApprovalRequired; v != nil {
+		approvalRequired = *v
+	}
+	d.Set("approval_required", approvalRequired)
+
+	if v := resp.SubscriptionsLimit; v != nil {
+		d.Set("subscriptions_limit", int(*v))
+	}
+
+	return nil
+}
+
+func resourceArmApiManagementProductDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementProductsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	productId := id.Path["products"]
+
+	// a Product can't be deleted whilst it has Subscriptions - so we remove them alongside it
+	deleteSubscriptions := true
+	resp, err := client.Delete(resourceGroup, serviceName, productId, "*", &deleteSubscriptions)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting API Management Product %q (API Management Service %q / Resource Group %q): %+v", productId, serviceName, resourceGroup, err)
+	}
+
+	return nil
+}
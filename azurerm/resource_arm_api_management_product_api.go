@@ -0,0 +1,134 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementProductApi() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementProductApiCreate,
+		Read:   resourceArmApiManagementProductApiRead,
+		Delete: resourceArmApiManagementProductApiDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("service", "products", "apis"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"product_id": apiManagementChildNameSchema(),
+
+			"api_name": apiManagementChildNameSchema(),
+
+			"api_management_name": apiManagementServiceNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+		},
+	}
+}
+
+func resourceArmApiManagementProductApiCreate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	client := armClient.apiManagementProductApisClient
+
+	productId := d.Get("product_id").(string)
+	apiName := d.Get("api_name").(string)
+	serviceName := d.Get("api_management_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	log.Printf("[INFO] Adding API %q to API Management Product %q (API Management Service %q / Resource Group %q)", apiName, productId, serviceName, resourceGroup)
+
+	if _, err := client.Create(resourceGroup, serviceName, productId, apiName); err != nil {
+		return fmt.Errorf("Error adding API %q to API Management Product %q (API Management Service %q / Resource Group %q): %+v", apiName, productId, serviceName, resourceGroup, err)
+	}
+
+	d.SetId(apiManagementResourceID(armClient.subscriptionId, resourceGroup, serviceName, "products", productId, "apis", apiName))
+
+	return resourceArmApiManagementProductApiRead(d, meta)
+}
+
+func resourceArmApiManagementProductApiRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementProductApisClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	productId := id.Path["products"]
+	apiName := id.Path["apis"]
+
+	// there's no API to retrieve a single API from a Product, so we have to look through them all
+	resp, err := client.ListByProducts(resourceGroup, serviceName, productId, "", nil, nil)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] API Management Product %q was not found in API Management Service %q / Resource Group %q - removing from state!", productId, serviceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving APIs for API Management Product %q (API Management Service %q / Resource Group %q): %+v", productId, serviceName, resourceGroup, err)
+	}
+
+	found := false
+	for {
+		if apis := resp.Value; apis != nil {
+			for _, api := range *apis {
+				if api.ID != nil && strings.EqualFold(*api.ID, fmt.Sprintf("/apis/%s", apiName)) {
+					found = true
+					break
+				}
+			}
+		}
+
+		if found || resp.NextLink == nil || *resp.NextLink == "" {
+			break
+		}
+
+		resp, err = client.ListByProductsNextResults(resp)
+		if err != nil {
+			return fmt.Errorf("Error retrieving APIs for API Management Product %q (API Management Service %q / Resource Group %q): %+v", productId, serviceName, resourceGroup, err)
+		}
+	}
+
+	if !found {
+		log.Printf("[DEBUG] API %q was not found in API Management Product %q (API Management Service %q / Resource Group %q) - removing from state!", apiName, productId, serviceName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("product_id", productId)
+	d.Set("api_name", apiName)
+	d.Set("api_management_name", serviceName)
+	d.Set("resource_group_name", resourceGroup)
+
+	return nil
+}
+
+func resourceArmApiManagementProductApiDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementProductApisClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	productId := id.Path["products"]
+	apiName := id.Path["apis"]
+
+	resp, err := client.Delete(resourceGroup, serviceName, productId, apiName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error removing API %q from API Management Product %q (API Management Service %q / Resource Group %q): %+v", apiName, productId, serviceName, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMApiManagementProductApi_basic(t *testing.T) {
+	resourceName := "azurerm_api_management_product_api.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApiManagementProductApi_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementProductApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementProductApiExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApiManagementProductApiExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		productId := rs.Primary.Attributes["product_id"]
+		apiName := rs.Primary.Attributes["api_name"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		exists, err := testCheckAzureRMApiManagementProductContainsApi(resourceGroup, serviceName, productId, apiName)
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			return fmt.Errorf("Bad: API %q is not assigned to API Management Product %q (API Management Service %q / resource group: %q)", apiName, productId, serviceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMApiManagementProductApiDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_api_management_product_api" {
+			continue
+		}
+
+		productId := rs.Primary.Attributes["product_id"]
+		apiName := rs.Primary.Attributes["api_name"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		exists, err := testCheckAzureRMApiManagementProductContainsApi(resourceGroup, serviceName, productId, apiName)
+		if err != nil {
+			return nil
+		}
+
+		if exists {
+			return fmt.Errorf("API %q is still assigned to API Management Product %q", apiName, productId)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMApiManagementProductContainsApi(resourceGroup, serviceName, productId, apiName string) (bool, error) {
+	conn := testAccProvider.Meta().(*ArmClient).apiManagementProductApisClient
+
+	resp, err := conn.ListByProducts(resourceGroup, serviceName, productId, "", nil, nil)
+	if err != nil {
+		return false, fmt.Errorf("Bad: ListByProducts on apiManagementProductApisClient: %+v", err)
+	}
+
+	if apis := resp.Value; apis != nil {
+		for _, api := range *apis {
+			if api.ID != nil && strings.EqualFold(*api.ID, fmt.Sprintf("/apis/%s", apiName)) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func testAccAzureRMApiManagementProductApi_basic(rInt int, location string) string {
+	template := testAccAzureRMApiManagementApi_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_product" "test" {
+  product_id            = "test-product"
+  api_management_name   = "${azurerm_api_management.test.name}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  display_name          = "Test Product"
+  published             = true
+  subscription_required = true
+}
+
+resource "azurerm_api_management_product_api" "test" {
+  product_id          = "${azurerm_api_management_product.test.product_id}"
+  api_name            = "${azurerm_api_management_api.test.name}"
+  api_management_name = "${azurerm_api_management.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, template)
+}
@@ -0,0 +1,109 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementProductPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementProductPolicyCreateUpdate,
+		Read:   resourceArmApiManagementProductPolicyRead,
+		Update: resourceArmApiManagementProductPolicyCreateUpdate,
+		Delete: resourceArmApiManagementProductPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("service", "products"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"product_id": apiManagementChildNameSchema(),
+
+			"api_management_name": apiManagementServiceNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"xml_content": apiManagementPolicySchema(),
+		},
+	}
+}
+
+func resourceArmApiManagementProductPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	client := armClient.apiManagementProductPolicyClient
+
+	productId := d.Get("product_id").(string)
+	serviceName := d.Get("api_management_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	xmlContent := d.Get("xml_content").(string)
+
+	log.Printf("[INFO] preparing arguments for API Management Product Policy (Product %q / API Management Service %q / Resource Group %q)", productId, serviceName, resourceGroup)
+
+	if _, err := client.CreateOrUpdate(resourceGroup, serviceName, productId, expandApiManagementPolicy(xmlContent), "*"); err != nil {
+		return fmt.Errorf("Error creating/updating API Management Product Policy (Product %q / API Management Service %q / Resource Group %q): %+v", productId, serviceName, resourceGroup, err)
+	}
+
+	d.SetId(apiManagementResourceID(armClient.subscriptionId, resourceGroup, serviceName, "products", productId, "policies", "policy"))
+
+	return resourceArmApiManagementProductPolicyRead(d, meta)
+}
+
+func resourceArmApiManagementProductPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementProductPolicyClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	productId := id.Path["products"]
+
+	resp, err := client.Get(resourceGroup, serviceName, productId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] API Management Product Policy was not found in Product %q / API Management Service %q / Resource Group %q - removing from state!", productId, serviceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving API Management Product Policy (Product %q / API Management Service %q / Resource Group %q): %+v", productId, serviceName, resourceGroup, err)
+	}
+
+	xmlContent, err := flattenApiManagementPolicy(resp.Value)
+	if err != nil {
+		return fmt.Errorf("Error reading API Management Product Policy (Product %q / API Management Service %q / Resource Group %q): %+v", productId, serviceName, resourceGroup, err)
+	}
+
+	d.Set("product_id", productId)
+	d.Set("api_management_name", serviceName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("xml_content", xmlContent)
+
+	return nil
+}
+
+func resourceArmApiManagementProductPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementProductPolicyClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	productId := id.Path["products"]
+
+	resp, err := client.Delete(resourceGroup, serviceName, productId, "*")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting API Management Product Policy (Product %q / API Management Service %q / Resource Group %q): %+v", productId, serviceName, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMApiManagementProduct_basic(t *testing.T) {
+	resourceName := "azurerm_api_management_product.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApiManagementProduct_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementProductExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Test Product"),
+					resource.TestCheckResourceAttr(resourceName, "published", "false"),
+					resource.TestCheckResourceAttr(resourceName, "subscription_required", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApiManagementProduct_update(t *testing.T) {
+	resourceName := "azurerm_api_management_product.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApiManagementProduct_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementProductExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "published", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMApiManagementProduct_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementProductExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "published", "true"),
+					resource.TestCheckResourceAttr(resourceName, "subscription_required", "true"),
+					resource.TestCheckResourceAttr(resourceName, "approval_required", "true"),
+					resource.TestCheckResourceAttr(resourceName, "subscriptions_limit", "2"),
+					resource.TestCheckResourceAttr(resourceName, "terms", "Use responsibly"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApiManagementProductExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		productId := rs.Primary.Attributes["product_id"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for API Management Product: %s", productId)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).apiManagementProductsClient
+		resp, err := conn.Get(resourceGroup, serviceName, productId)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on apiManagementProductsClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: API Management Product %q (API Management Service %q / resource group: %q) does not exist", productId, serviceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMApiManagementProductDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).apiManagementProductsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_api_management_product" {
+			continue
+		}
+
+		productId := rs.Primary.Attributes["product_id"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(resourceGroup, serviceName, productId)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("API Management Product still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMApiManagementProduct_basic(rInt int, location string) string {
+	template := testAccAzureRMApiManagementService_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_product" "test" {
+  product_id            = "test-product"
+  api_management_name   = "${azurerm_api_management.test.name}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  display_name          = "Test Product"
+  published             = false
+  subscription_required = false
+}
+`, template)
+}
+
+func testAccAzureRMApiManagementProduct_complete(rInt int, location string) string {
+	template := testAccAzureRMApiManagementService_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_product" "test" {
+  product_id            = "test-product"
+  api_management_name   = "${azurerm_api_management.test.name}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  display_name          = "Test Product"
+  description           = "A product for testing"
+  terms                 = "Use responsibly"
+  published             = true
+  subscription_required = true
+  approval_required     = true
+  subscriptions_limit   = 2
+}
+`, template)
+}
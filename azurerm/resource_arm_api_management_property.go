@@ -0,0 +1,172 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/arm/apimanagement"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementProperty() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementPropertyCreateUpdate,
+		Read:   resourceArmApiManagementPropertyRead,
+		Update: resourceArmApiManagementPropertyCreateUpdate,
+		Delete: resourceArmApiManagementPropertyDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("service", "properties"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"property_id": apiManagementChildNameSchema(),
+
+			"api_management_name": apiManagementServiceNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateApiManagementPropertyDisplayName,
+			},
+
+			"value": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+
+			"secret": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 32,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmApiManagementPropertyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	client := armClient.apiManagementPropertyClient
+
+	propertyId := d.Get("property_id").(string)
+	serviceName := d.Get("api_management_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	log.Printf("[INFO] preparing arguments for API Management Property %q (API Management Service %q / Resource Group %q)", propertyId, serviceName, resourceGroup)
+
+	tags := make([]string, 0)
+	for _, v := range d.Get("tags").([]interface{}) {
+		tags = append(tags, v.(string))
+	}
+
+	parameters := apimanagement.PropertyCreateParameters{
+		Name:   utils.String(d.Get("display_name").(string)),
+		Value:  utils.String(d.Get("value").(string)),
+		Secret: utils.Bool(d.Get("secret").(bool)),
+		Tags:   &tags,
+	}
+
+	if _, err := client.CreateOrUpdate(resourceGroup, serviceName, propertyId, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating API Management Property %q (API Management Service %q / Resource Group %q): %+v", propertyId, serviceName, resourceGroup, err)
+	}
+
+	if _, err := client.Get(resourceGroup, serviceName, propertyId); err != nil {
+		return fmt.Errorf("Error retrieving API Management Property %q (API Management Service %q / Resource Group %q): %+v", propertyId, serviceName, resourceGroup, err)
+	}
+
+	d.SetId(apiManagementResourceID(armClient.subscriptionId, resourceGroup, serviceName, "properties", propertyId))
+
+	return resourceArmApiManagementPropertyRead(d, meta)
+}
+
+func resourceArmApiManagementPropertyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementPropertyClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	propertyId := id.Path["properties"]
+
+	resp, err := client.Get(resourceGroup, serviceName, propertyId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] API Management Property %q was not found in API Management Service %q / Resource Group %q - removing from state!", propertyId, serviceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving API Management Property %q (API Management Service %q / Resource Group %q): %+v", propertyId, serviceName, resourceGroup, err)
+	}
+
+	d.Set("property_id", propertyId)
+	d.Set("api_management_name", serviceName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("display_name", resp.Name)
+
+	// the value of Secret Properties isn't always returned - so we only overwrite what's in the state when it is
+	if v := resp.Value; v != nil && *v != "" {
+		d.Set("value", *v)
+	}
+
+	secret := false
+	if v := resp.Secret; v != nil {
+		secret = *v
+	}
+	d.Set("secret", secret)
+
+	tags := make([]string, 0)
+	if v := resp.Tags; v != nil {
+		tags = *v
+	}
+	d.Set("tags", tags)
+
+	return nil
+}
+
+func resourceArmApiManagementPropertyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementPropertyClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	propertyId := id.Path["properties"]
+
+	resp, err := client.Delete(resourceGroup, serviceName, propertyId, "*")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting API Management Property %q (API Management Service %q / Resource Group %q): %+v", propertyId, serviceName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func validateApiManagementPropertyDisplayName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if matched := regexp.MustCompile(`^[A-Z0-9-._]{1,256}$`).MatchString(value); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 1-256 chars and may only contain uppercase letters, numbers, dashes, periods and underscores", k))
+	}
+
+	return
+}
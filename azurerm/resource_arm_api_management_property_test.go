@@ -0,0 +1,181 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestValidateApiManagementPropertyDisplayName(t *testing.T) {
+	validNames := []string{
+		"A",
+		"TEST_PROPERTY",
+		"BACKEND-URL.1",
+	}
+	for _, v := range validNames {
+		_, errors := validateApiManagementPropertyDisplayName(v, "display_name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid API Management Property Display Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"lowercase",
+		"WITH SPACE",
+		"INVALID#",
+	}
+	for _, v := range invalidNames {
+		_, errors := validateApiManagementPropertyDisplayName(v, "display_name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid API Management Property Display Name", v)
+		}
+	}
+}
+
+func TestAccAzureRMApiManagementProperty_basic(t *testing.T) {
+	resourceName := "azurerm_api_management_property.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementPropertyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApiManagementProperty_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementPropertyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "TEST_PROPERTY"),
+					resource.TestCheckResourceAttr(resourceName, "value", "Test Value"),
+					resource.TestCheckResourceAttr(resourceName, "secret", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApiManagementProperty_update(t *testing.T) {
+	resourceName := "azurerm_api_management_property.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementPropertyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApiManagementProperty_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementPropertyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "Test Value"),
+					resource.TestCheckResourceAttr(resourceName, "secret", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMApiManagementProperty_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementPropertyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "Updated Value"),
+					resource.TestCheckResourceAttr(resourceName, "secret", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.0", "tag1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.1", "tag2"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApiManagementPropertyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		propertyId := rs.Primary.Attributes["property_id"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for API Management Property: %s", propertyId)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).apiManagementPropertyClient
+		resp, err := conn.Get(resourceGroup, serviceName, propertyId)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on apiManagementPropertyClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: API Management Property %q (API Management Service %q / resource group: %q) does not exist", propertyId, serviceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMApiManagementPropertyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).apiManagementPropertyClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_api_management_property" {
+			continue
+		}
+
+		propertyId := rs.Primary.Attributes["property_id"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(resourceGroup, serviceName, propertyId)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("API Management Property still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMApiManagementProperty_basic(rInt int, location string) string {
+	template := testAccAzureRMApiManagementService_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_property" "test" {
+  property_id         = "test-property"
+  api_management_name = "${azurerm_api_management.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  display_name        = "TEST_PROPERTY"
+  value               = "Test Value"
+}
+`, template)
+}
+
+func testAccAzureRMApiManagementProperty_complete(rInt int, location string) string {
+	template := testAccAzureRMApiManagementService_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_property" "test" {
+  property_id         = "test-property"
+  api_management_name = "${azurerm_api_management.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  display_name        = "TEST_PROPERTY"
+  value               = "Updated Value"
+  secret              = true
+  tags                = ["tag1", "tag2"]
+}
+`, template)
+}
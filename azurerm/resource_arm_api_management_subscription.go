@@ -0,0 +1,214 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/apimanagement"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	uuid "github.com/satori/go.uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementSubscription() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementSubscriptionCreate,
+		Read:   resourceArmApiManagementSubscriptionRead,
+		Update: resourceArmApiManagementSubscriptionUpdate,
+		Delete: resourceArmApiManagementSubscriptionDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("service", "subscriptions"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"subscription_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateApiManagementChildName,
+			},
+
+			"api_management_name": apiManagementServiceNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"user_id": apiManagementChildNameSchema(),
+
+			"product_id": apiManagementChildNameSchema(),
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(apimanagement.Submitted),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(apimanagement.Active),
+					string(apimanagement.Cancelled),
+					string(apimanagement.Expired),
+					string(apimanagement.Rejected),
+					string(apimanagement.Submitted),
+					string(apimanagement.Suspended),
+				}, false),
+			},
+
+			"primary_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+
+			"secondary_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+		},
+	}
+}
+
+func resourceArmApiManagementSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	client := armClient.apiManagementSubscriptionsClient
+
+	serviceName := d.Get("api_management_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	subscriptionId := d.Get("subscription_id").(string)
+	if subscriptionId == "" {
+		subscriptionId = uuid.NewV4().String()
+	}
+
+	log.Printf("[INFO] preparing arguments for API Management Subscription %q (API Management Service %q / Resource Group %q)", subscriptionId, serviceName, resourceGroup)
+
+	parameters := apimanagement.SubscriptionCreateParameters{
+		UserID:    utils.String(fmt.Sprintf("/users/%s", d.Get("user_id").(string))),
+		ProductID: utils.String(fmt.Sprintf("/products/%s", d.Get("product_id").(string))),
+		Name:      utils.String(d.Get("display_name").(string)),
+		State:     apimanagement.SubscriptionStateContract(d.Get("state").(string)),
+	}
+
+	if v, ok := d.GetOk("primary_key"); ok {
+		parameters.PrimaryKey = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("secondary_key"); ok {
+		parameters.SecondaryKey = utils.String(v.(string))
+	}
+
+	if _, err := client.CreateOrUpdate(resourceGroup, serviceName, subscriptionId, parameters); err != nil {
+		return fmt.Errorf("Error creating API Management Subscription %q (API Management Service %q / Resource Group %q): %+v", subscriptionId, serviceName, resourceGroup, err)
+	}
+
+	if _, err := client.Get(resourceGroup, serviceName, subscriptionId); err != nil {
+		return fmt.Errorf("Error retrieving API Management Subscription %q (API Management Service %q / Resource Group %q): %+v", subscriptionId, serviceName, resourceGroup, err)
+	}
+
+	d.SetId(apiManagementResourceID(armClient.subscriptionId, resourceGroup, serviceName, "subscriptions", subscriptionId))
+
+	return resourceArmApiManagementSubscriptionRead(d, meta)
+}
+
+func resourceArmApiManagementSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementSubscriptionsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	subscriptionId := id.Path["subscriptions"]
+
+	parameters := apimanagement.SubscriptionUpdateParameters{
+		Name:  utils.String(d.Get("display_name").(string)),
+		State: apimanagement.SubscriptionStateContract(d.Get("state").(string)),
+	}
+
+	if d.HasChange("primary_key") {
+		parameters.PrimaryKey = utils.String(d.Get("primary_key").(string))
+	}
+	if d.HasChange("secondary_key") {
+		parameters.SecondaryKey = utils.String(d.Get("secondary_key").(string))
+	}
+
+	if _, err := client.Update(resourceGroup, serviceName, subscriptionId, parameters, "*"); err != nil {
+		return fmt.Errorf("Error updating API Management Subscription %q (API Management Service %q / Resource Group %q): %+v", subscriptionId, serviceName, resourceGroup, err)
+	}
+
+	return resourceArmApiManagementSubscriptionRead(d, meta)
+}
+
+func resourceArmApiManagementSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementSubscriptionsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	subscriptionId := id.Path["subscriptions"]
+
+	resp, err := client.Get(resourceGroup, serviceName, subscriptionId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] API Management Subscription %q was not found in API Management Service %q / Resource Group %q - removing from state!", subscriptionId, serviceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving API Management Subscription %q (API Management Service %q / Resource Group %q): %+v", subscriptionId, serviceName, resourceGroup, err)
+	}
+
+	d.Set("subscription_id", subscriptionId)
+	d.Set("api_management_name", serviceName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("display_name", resp.Name)
+	d.Set("state", string(resp.State))
+	d.Set("primary_key", resp.PrimaryKey)
+	d.Set("secondary_key", resp.SecondaryKey)
+
+	// the User and Product are returned as relative ID's e.g. `/users/1`
+	if v := resp.UserID; v != nil {
+		d.Set("user_id", strings.TrimPrefix(*v, "/users/"))
+	}
+	if v := resp.ProductID; v != nil {
+		d.Set("product_id", strings.TrimPrefix(*v, "/products/"))
+	}
+
+	return nil
+}
+
+func resourceArmApiManagementSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagementSubscriptionsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	subscriptionId := id.Path["subscriptions"]
+
+	resp, err := client.Delete(resourceGroup, serviceName, subscriptionId, "*")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting API Management Subscription %q (API Management Service %q / Resource Group %q): %+v", subscriptionId, serviceName, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMApiManagementSubscription_basic(t *testing.T) {
+	resourceName := "azurerm_api_management_subscription.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApiManagementSubscription_basic(ri, testLocation(), "Submitted")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementSubscriptionExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "subscription_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "secondary_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApiManagementSubscription_update(t *testing.T) {
+	resourceName := "azurerm_api_management_subscription.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApiManagementSubscription_basic(ri, location, "Submitted"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementSubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", "Submitted"),
+				),
+			},
+			{
+				Config: testAccAzureRMApiManagementSubscription_basic(ri, location, "Active"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementSubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "state", "Active"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApiManagementSubscriptionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		subscriptionId := rs.Primary.Attributes["subscription_id"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for API Management Subscription: %s", subscriptionId)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).apiManagementSubscriptionsClient
+		resp, err := conn.Get(resourceGroup, serviceName, subscriptionId)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on apiManagementSubscriptionsClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: API Management Subscription %q (API Management Service %q / resource group: %q) does not exist", subscriptionId, serviceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMApiManagementSubscriptionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).apiManagementSubscriptionsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_api_management_subscription" {
+			continue
+		}
+
+		subscriptionId := rs.Primary.Attributes["subscription_id"]
+		serviceName := rs.Primary.Attributes["api_management_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(resourceGroup, serviceName, subscriptionId)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("API Management Subscription still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMApiManagementSubscription_basic(rInt int, location string, state string) string {
+	template := testAccAzureRMApiManagementService_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_product" "test" {
+  product_id            = "test-product"
+  api_management_name   = "${azurerm_api_management.test.name}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  display_name          = "Test Product"
+  published             = true
+  subscription_required = true
+}
+
+resource "azurerm_api_management_subscription" "test" {
+  api_management_name = "${azurerm_api_management.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  user_id             = "1"
+  product_id          = "${azurerm_api_management_product.test.product_id}"
+  display_name        = "Test Subscription"
+  state               = "%s"
+}
+`, template, state)
+}
@@ -0,0 +1,236 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestValidateApiManagementServiceName(t *testing.T) {
+	validNames := []string{
+		"a",
+		"abc123",
+		"api-management-1",
+		"AcctestAM",
+	}
+	for _, v := range validNames {
+		_, errors := validateApiManagementServiceName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid API Management Service Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"1abc",
+		"abc-",
+		"abc_123",
+		"abc.123",
+		"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+	}
+	for _, v := range invalidNames {
+		_, errors := validateApiManagementServiceName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid API Management Service Name", v)
+		}
+	}
+}
+
+func TestExpandApiManagementServiceHostnames(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"management": []interface{}{},
+			"portal": []interface{}{
+				map[string]interface{}{
+					"host_name": "portal.example.com",
+				},
+			},
+			"proxy": []interface{}{
+				map[string]interface{}{
+					"host_name": "api.example.com",
+				},
+			},
+			"scm": []interface{}{},
+		},
+	}
+
+	hostnames := expandApiManagementServiceHostnames(input)
+	if len(hostnames) != 2 {
+		t.Fatalf("Expected 2 Hostnames but got %d", len(hostnames))
+	}
+
+	if v := hostnames["portal"]["host_name"]; v != "portal.example.com" {
+		t.Fatalf("Expected the Portal Hostname to be `portal.example.com` but got %q", v)
+	}
+
+	if v := hostnames["proxy"]["host_name"]; v != "api.example.com" {
+		t.Fatalf("Expected the Proxy Hostname to be `api.example.com` but got %q", v)
+	}
+
+	if len(expandApiManagementServiceHostnames([]interface{}{})) != 0 {
+		t.Fatalf("Expected no Hostnames when `hostname_configuration` isn't specified")
+	}
+}
+
+func TestAccAzureRMApiManagementService_basic(t *testing.T) {
+	resourceName := "azurerm_api_management.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMApiManagementService_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.name", "Developer"),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "gateway_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "portal_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "management_api_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "scm_url"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApiManagementService_update(t *testing.T) {
+	resourceName := "azurerm_api_management.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApiManagementService_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "publisher_name", "pub1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMApiManagementService_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "publisher_name", "pub2"),
+					resource.TestCheckResourceAttr(resourceName, "notification_sender_email", "notifications@terraform.io"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Acceptance", "Test"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMApiManagementServiceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		serviceName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for API Management Service: %s", serviceName)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).apiManagementServicesClient
+		resp, err := conn.Get(resourceGroup, serviceName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on apiManagementServicesClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: API Management Service %q (resource group: %q) does not exist", serviceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMApiManagementServiceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).apiManagementServicesClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_api_management" {
+			continue
+		}
+
+		serviceName := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(resourceGroup, serviceName)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("API Management Service still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMApiManagementService_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+
+  sku {
+    name = "Developer"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMApiManagementService_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                      = "acctestAM-%d"
+  location                  = "${azurerm_resource_group.test.location}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  publisher_name            = "pub2"
+  publisher_email           = "pub2@email.com"
+  notification_sender_email = "notifications@terraform.io"
+
+  sku {
+    name     = "Developer"
+    capacity = 1
+  }
+
+  tags {
+    Acceptance = "Test"
+  }
+}
+`, rInt, location, rInt)
+}
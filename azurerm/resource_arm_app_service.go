@@ -17,7 +17,7 @@ func resourceArmAppService() *schema.Resource {
 		Update: resourceArmAppServiceUpdate,
 		Delete: resourceArmAppServiceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("sites"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -41,130 +41,7 @@ func resourceArmAppService() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"site_config": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"always_on": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-
-						"default_documents": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-
-						"dotnet_framework_version": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Default:  "v4.0",
-							ValidateFunc: validation.StringInSlice([]string{
-								"v2.0",
-								"v4.0",
-							}, true),
-							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
-						},
-
-						"java_version": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								"1.7",
-								"1.8",
-							}, false),
-						},
-
-						"java_container": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								"JETTY",
-								"TOMCAT",
-							}, true),
-							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
-						},
-
-						"java_container_version": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-
-						"local_mysql_enabled": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Computed: true,
-						},
-
-						"managed_pipeline_mode": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(web.Classic),
-								string(web.Integrated),
-							}, true),
-							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
-						},
-
-						"php_version": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								"5.5",
-								"5.6",
-								"7.0",
-								"7.1",
-							}, false),
-						},
-
-						"python_version": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								"2.7",
-								"3.4",
-							}, false),
-						},
-
-						"remote_debugging_enabled": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-
-						"remote_debugging_version": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								"VS2012",
-								"VS2013",
-								"VS2015",
-								"VS2017",
-							}, true),
-							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
-						},
-
-						"use_32_bit_worker_process": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Computed: true,
-						},
-
-						"websockets_enabled": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Computed: true,
-						},
-					},
-				},
-			},
+			"site_config": appServiceSiteConfigSchema(),
 
 			"client_affinity_enabled": {
 				Type:     schema.TypeBool,
@@ -192,45 +69,193 @@ func resourceArmAppService() *schema.Resource {
 				Computed: true,
 			},
 
-			"connection_string": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"value": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"type": {
-							Type:     schema.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(web.APIHub),
-								string(web.Custom),
-								string(web.DocDb),
-								string(web.EventHub),
-								string(web.MySQL),
-								string(web.NotificationHub),
-								string(web.PostgreSQL),
-								string(web.RedisCache),
-								string(web.ServiceBus),
-								string(web.SQLAzure),
-								string(web.SQLServer),
-							}, true),
-							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
-						},
-					},
-				},
-			},
+			"connection_string": appServiceConnectionStringSchema(),
 
 			// TODO: (tombuildsstuff) support Update once the API is fixed:
 			// https://github.com/Azure/azure-rest-api-specs/issues/1697
 			"tags": tagsForceNewSchema(),
+
+			"default_site_hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"outbound_ip_addresses": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func appServiceSiteConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"always_on": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"default_documents": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+
+				"dotnet_framework_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "v4.0",
+					ValidateFunc: validation.StringInSlice([]string{
+						"v2.0",
+						"v4.0",
+					}, true),
+					DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				},
+
+				"java_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"1.7",
+						"1.8",
+					}, false),
+				},
+
+				"java_container": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"JETTY",
+						"TOMCAT",
+					}, true),
+					DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				},
+
+				"java_container_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+
+				"linux_fx_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+
+				"local_mysql_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Computed: true,
+				},
+
+				"managed_pipeline_mode": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(web.Classic),
+						string(web.Integrated),
+					}, true),
+					DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				},
+
+				"php_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"5.5",
+						"5.6",
+						"7.0",
+						"7.1",
+					}, false),
+				},
+
+				"python_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"2.7",
+						"3.4",
+					}, false),
+				},
+
+				"remote_debugging_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"remote_debugging_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"VS2012",
+						"VS2013",
+						"VS2015",
+						"VS2017",
+					}, true),
+					DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				},
+
+				"use_32_bit_worker_process": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Computed: true,
+				},
+
+				"websockets_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func appServiceConnectionStringSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"value": {
+					Type:      schema.TypeString,
+					Required:  true,
+					Sensitive: true,
+				},
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(web.APIHub),
+						string(web.Custom),
+						string(web.DocDb),
+						string(web.EventHub),
+						string(web.MySQL),
+						string(web.NotificationHub),
+						string(web.PostgreSQL),
+						string(web.RedisCache),
+						string(web.ServiceBus),
+						string(web.SQLAzure),
+						string(web.SQLServer),
+					}, true),
+					DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				},
+			},
 		},
 	}
 }
@@ -251,7 +276,7 @@ func resourceArmAppServiceCreate(d *schema.ResourceData, meta interface{}) error
 
 	siteEnvelope := web.Site{
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandResourceTags(d, tags, meta),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID: utils.String(appServicePlanId),
 			Enabled:      utils.Bool(enabled),
@@ -269,7 +294,9 @@ func resourceArmAppServiceCreate(d *schema.ResourceData, meta interface{}) error
 	forceDNSRegistration := false
 	skipCustomDomainVerification := true
 	ttlInSeconds := "60"
-	_, createErr := client.CreateOrUpdate(resGroup, name, siteEnvelope, &skipDNSRegistration, &skipCustomDomainVerification, &forceDNSRegistration, ttlInSeconds, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.CreateOrUpdate(resGroup, name, siteEnvelope, &skipDNSRegistration, &skipCustomDomainVerification, &forceDNSRegistration, ttlInSeconds, cancel)
 	err := <-createErr
 	if err != nil {
 		return err
@@ -378,12 +405,16 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("name", name)
 	d.Set("resource_group_name", resGroup)
-	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
 
 	if props := resp.SiteProperties; props != nil {
 		d.Set("app_service_plan_id", props.ServerFarmID)
 		d.Set("client_affinity_enabled", props.ClientAffinityEnabled)
 		d.Set("enabled", props.Enabled)
+		d.Set("default_site_hostname", props.DefaultHostName)
+		d.Set("outbound_ip_addresses", props.OutboundIPAddresses)
 	}
 
 	if err := d.Set("app_settings", flattenAppServiceAppSettings(appSettingsResp.Properties)); err != nil {
@@ -398,7 +429,7 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -469,6 +500,10 @@ func expandAppServiceSiteConfig(d *schema.ResourceData) web.SiteConfig {
 		siteConfig.JavaContainerVersion = utils.String(v.(string))
 	}
 
+	if v, ok := config["linux_fx_version"]; ok {
+		siteConfig.LinuxFxVersion = utils.String(v.(string))
+	}
+
 	if v, ok := config["local_mysql_enabled"]; ok {
 		siteConfig.LocalMySQLEnabled = utils.Bool(v.(bool))
 	}
@@ -542,6 +577,10 @@ func flattenAppServiceSiteConfig(input *web.SiteConfig) []interface{} {
 		result["java_container_version"] = *input.JavaContainerVersion
 	}
 
+	if input.LinuxFxVersion != nil {
+		result["linux_fx_version"] = *input.LinuxFxVersion
+	}
+
 	if input.LocalMySQLEnabled != nil {
 		result["local_mysql_enabled"] = *input.LocalMySQLEnabled
 	}
@@ -610,11 +649,21 @@ func expandAppServiceConnectionStrings(d *schema.ResourceData) *map[string]*web.
 func flattenAppServiceConnectionStrings(input *map[string]*web.ConnStringValueTypePair) interface{} {
 	results := make([]interface{}, 0)
 
+	if input == nil {
+		return results
+	}
+
 	for k, v := range *input {
+		if v == nil {
+			continue
+		}
+
 		result := make(map[string]interface{}, 0)
 		result["name"] = k
 		result["type"] = string(v.Type)
-		result["value"] = *v.Value
+		if v.Value != nil {
+			result["value"] = *v.Value
+		}
 		results = append(results, result)
 	}
 
@@ -623,8 +672,15 @@ func flattenAppServiceConnectionStrings(input *map[string]*web.ConnStringValueTy
 
 func flattenAppServiceAppSettings(input *map[string]*string) map[string]string {
 	output := make(map[string]string, 0)
+
+	if input == nil {
+		return output
+	}
+
 	for k, v := range *input {
-		output[k] = *v
+		if v != nil {
+			output[k] = *v
+		}
 	}
 
 	return output
@@ -0,0 +1,176 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/web"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var appServiceResourceName = "azurerm_app_service"
+
+func resourceArmAppServiceCustomHostnameBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppServiceCustomHostnameBindingCreate,
+		Read:   resourceArmAppServiceCustomHostnameBindingRead,
+		Delete: resourceArmAppServiceCustomHostnameBindingDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("sites", "hostNameBindings"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"hostname": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"app_service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"ssl_state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(web.IPBasedEnabled),
+					string(web.SniEnabled),
+				}, false),
+			},
+
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"virtual_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmAppServiceCustomHostnameBindingCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+
+	log.Printf("[INFO] preparing arguments for App Service Hostname Binding creation.")
+
+	resGroup := d.Get("resource_group_name").(string)
+	appServiceName := d.Get("app_service_name").(string)
+	hostname := d.Get("hostname").(string)
+	sslState := d.Get("ssl_state").(string)
+	thumbprint := d.Get("thumbprint").(string)
+
+	if (sslState == "") != (thumbprint == "") {
+		return fmt.Errorf("`ssl_state` and `thumbprint` must be specified together for Hostname Binding %q (App Service %q / Resource Group %q)", hostname, appServiceName, resGroup)
+	}
+
+	// the App Service can only process a single Hostname Binding at a time
+	azureRMLockByName(appServiceName, appServiceResourceName)
+	defer azureRMUnlockByName(appServiceName, appServiceResourceName)
+
+	properties := web.HostNameBinding{
+		HostNameBindingProperties: &web.HostNameBindingProperties{
+			SiteName: utils.String(appServiceName),
+		},
+	}
+
+	if sslState != "" {
+		properties.HostNameBindingProperties.SslState = web.SslState(sslState)
+		properties.HostNameBindingProperties.Thumbprint = utils.String(thumbprint)
+	}
+
+	_, err := client.CreateOrUpdateHostNameBinding(resGroup, appServiceName, hostname, properties)
+	if err != nil {
+		return fmt.Errorf("Error creating Hostname Binding %q (App Service %q / Resource Group %q): %+v", hostname, appServiceName, resGroup, err)
+	}
+
+	read, err := client.GetHostNameBinding(resGroup, appServiceName, hostname)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Hostname Binding %q (App Service %q / Resource Group %q): %+v", hostname, appServiceName, resGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Hostname Binding %q (App Service %q / Resource Group %q) ID", hostname, appServiceName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAppServiceCustomHostnameBindingRead(d, meta)
+}
+
+func resourceArmAppServiceCustomHostnameBindingRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+	hostname := id.Path["hostNameBindings"]
+
+	resp, err := client.GetHostNameBinding(resGroup, appServiceName, hostname)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Hostname Binding %q (App Service %q / Resource Group %q) was not found - removing from state", hostname, appServiceName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Hostname Binding %q (App Service %q / Resource Group %q): %+v", hostname, appServiceName, resGroup, err)
+	}
+
+	d.Set("hostname", hostname)
+	d.Set("app_service_name", appServiceName)
+	d.Set("resource_group_name", resGroup)
+
+	if props := resp.HostNameBindingProperties; props != nil {
+		if props.SslState != web.Disabled {
+			d.Set("ssl_state", string(props.SslState))
+		} else {
+			d.Set("ssl_state", "")
+		}
+		d.Set("thumbprint", props.Thumbprint)
+		d.Set("virtual_ip", props.VirtualIP)
+	}
+
+	return nil
+}
+
+func resourceArmAppServiceCustomHostnameBindingDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+	hostname := id.Path["hostNameBindings"]
+
+	azureRMLockByName(appServiceName, appServiceResourceName)
+	defer azureRMUnlockByName(appServiceName, appServiceResourceName)
+
+	log.Printf("[DEBUG] Deleting Hostname Binding %q (App Service %q / Resource Group %q)", hostname, appServiceName, resGroup)
+
+	resp, err := client.DeleteHostNameBinding(resGroup, appServiceName, hostname)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Hostname Binding %q (App Service %q / Resource Group %q): %+v", hostname, appServiceName, resGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Binding a Custom Hostname requires a DNS Zone which is delegated to Azure, which we can't create
+// as a part of the test - as such these tests only run when an existing DNS Zone is specified
+func testAccAzureRMAppServiceCustomHostnameBindingPreCheck(t *testing.T) (string, string) {
+	dnsZone := os.Getenv("ARM_TEST_DNS_ZONE")
+	dnsZoneResourceGroup := os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP")
+
+	if dnsZone == "" || dnsZoneResourceGroup == "" {
+		t.Skip("Skipping as ARM_TEST_DNS_ZONE and/or ARM_TEST_DATA_RESOURCE_GROUP are not specified")
+	}
+
+	return dnsZone, dnsZoneResourceGroup
+}
+
+func TestAccAzureRMAppServiceCustomHostnameBinding_basic(t *testing.T) {
+	dnsZone, dnsZoneResourceGroup := testAccAzureRMAppServiceCustomHostnameBindingPreCheck(t)
+
+	resourceName := "azurerm_app_service_custom_hostname_binding.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMAppServiceCustomHostnameBinding_basic(ri, testLocation(), dnsZone, dnsZoneResourceGroup)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceCustomHostnameBindingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAppServiceCustomHostnameBindingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "hostname", fmt.Sprintf("acctest%d.%s", ri, dnsZone)),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAppServiceCustomHostnameBindingDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).appServicesClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_app_service_custom_hostname_binding" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		appServiceName := rs.Primary.Attributes["app_service_name"]
+		hostname := rs.Primary.Attributes["hostname"]
+
+		resp, err := client.GetHostNameBinding(resourceGroup, appServiceName, hostname)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("App Service Custom Hostname Binding still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMAppServiceCustomHostnameBindingExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		appServiceName := rs.Primary.Attributes["app_service_name"]
+		hostname := rs.Primary.Attributes["hostname"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for App Service Custom Hostname Binding: %s", hostname)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).appServicesClient
+		resp, err := client.GetHostNameBinding(resourceGroup, appServiceName, hostname)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Hostname Binding %q (App Service %q / Resource Group: %q) does not exist", hostname, appServiceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: GetHostNameBinding on appServicesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMAppServiceCustomHostnameBinding_basic(rInt int, location string, dnsZone string, dnsZoneResourceGroup string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+}
+
+resource "azurerm_dns_cname_record" "test" {
+  name                = "acctest%d"
+  zone_name           = "%s"
+  resource_group_name = "%s"
+  ttl                 = 300
+  record              = "${azurerm_app_service.test.default_site_hostname}"
+}
+
+resource "azurerm_app_service_custom_hostname_binding" "test" {
+  hostname            = "${azurerm_dns_cname_record.test.name}.%s"
+  app_service_name    = "${azurerm_app_service.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt, rInt, rInt, dnsZone, dnsZoneResourceGroup, dnsZone)
+}
@@ -17,7 +17,7 @@ func resourceArmAppServicePlan() *schema.Resource {
 		Update: resourceArmAppServicePlanCreateUpdate,
 		Delete: resourceArmAppServicePlanDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("serverfarms"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -115,11 +115,13 @@ func resourceArmAppServicePlanCreateUpdate(d *schema.ResourceData, meta interfac
 		Location:                 &location,
 		AppServicePlanProperties: properties,
 		Kind: &kind,
-		Tags: expandTags(tags),
+		Tags: expandResourceTags(d, tags, meta),
 		Sku:  &sku,
 	}
 
-	_, createErr := client.CreateOrUpdate(resGroup, name, appServicePlan, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.CreateOrUpdate(resGroup, name, appServicePlan, cancel)
 	err := <-createErr
 	if err != nil {
 		return err
@@ -178,7 +180,7 @@ func resourceArmAppServicePlanRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("sku", flattenAppServicePlanSku(sku))
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
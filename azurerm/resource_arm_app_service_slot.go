@@ -0,0 +1,280 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/web"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAppServiceSlot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAppServiceSlotCreate,
+		Read:   resourceArmAppServiceSlotRead,
+		Update: resourceArmAppServiceSlotUpdate,
+		Delete: resourceArmAppServiceSlotDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("sites", "slots"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"app_service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"app_service_plan_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"site_config": appServiceSiteConfigSchema(),
+
+			"client_affinity_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+
+			"app_settings": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+
+			"connection_string": appServiceConnectionStringSchema(),
+
+			"tags": tagsForceNewSchema(),
+
+			"default_site_hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmAppServiceSlotCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+
+	log.Printf("[INFO] preparing arguments for AzureRM App Service Slot creation.")
+
+	slot := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	appServiceName := d.Get("app_service_name").(string)
+	location := d.Get("location").(string)
+	appServicePlanId := d.Get("app_service_plan_id").(string)
+	enabled := d.Get("enabled").(bool)
+	tags := d.Get("tags").(map[string]interface{})
+
+	siteConfig := expandAppServiceSiteConfig(d)
+
+	siteEnvelope := web.Site{
+		Location: &location,
+		Tags:     expandResourceTags(d, tags, meta),
+		SiteProperties: &web.SiteProperties{
+			ServerFarmID: utils.String(appServicePlanId),
+			Enabled:      utils.Bool(enabled),
+			SiteConfig:   &siteConfig,
+		},
+	}
+
+	if v, ok := d.GetOk("client_affinity_enabled"); ok {
+		enabled := v.(bool)
+		siteEnvelope.SiteProperties.ClientAffinityEnabled = utils.Bool(enabled)
+	}
+
+	// NOTE: these match the defaults used for the App Service
+	skipDNSRegistration := false
+	forceDNSRegistration := false
+	skipCustomDomainVerification := true
+	ttlInSeconds := "60"
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.CreateOrUpdateSlot(resGroup, appServiceName, siteEnvelope, slot, &skipDNSRegistration, &skipCustomDomainVerification, &forceDNSRegistration, ttlInSeconds, cancel)
+	err := <-createErr
+	if err != nil {
+		return fmt.Errorf("Error creating Slot %q (App Service %q / Resource Group %q): %+v", slot, appServiceName, resGroup, err)
+	}
+
+	read, err := client.GetSlot(resGroup, appServiceName, slot)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Slot %q (App Service %q / Resource Group %q): %+v", slot, appServiceName, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Slot %q (App Service %q / Resource Group %q) ID", slot, appServiceName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAppServiceSlotUpdate(d, meta)
+}
+
+func resourceArmAppServiceSlotUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+	slot := id.Path["slots"]
+
+	if d.HasChange("site_config") {
+		// update the main configuration
+		siteConfig := expandAppServiceSiteConfig(d)
+		siteConfigResource := web.SiteConfigResource{
+			SiteConfig: &siteConfig,
+		}
+		_, err := client.CreateOrUpdateConfigurationSlot(resGroup, appServiceName, siteConfigResource, slot)
+		if err != nil {
+			return fmt.Errorf("Error updating Configuration for Slot %q (App Service %q / Resource Group %q): %+v", slot, appServiceName, resGroup, err)
+		}
+	}
+
+	if d.HasChange("app_settings") {
+		// update the AppSettings
+		appSettings := expandAppServiceAppSettings(d)
+		settings := web.StringDictionary{
+			Properties: appSettings,
+		}
+
+		_, err := client.UpdateApplicationSettingsSlot(resGroup, appServiceName, settings, slot)
+		if err != nil {
+			return fmt.Errorf("Error updating Application Settings for Slot %q (App Service %q / Resource Group %q): %+v", slot, appServiceName, resGroup, err)
+		}
+	}
+
+	if d.HasChange("connection_string") {
+		// update the ConnectionStrings
+		connectionStrings := expandAppServiceConnectionStrings(d)
+		properties := web.ConnectionStringDictionary{
+			Properties: connectionStrings,
+		}
+
+		_, err := client.UpdateConnectionStringsSlot(resGroup, appServiceName, properties, slot)
+		if err != nil {
+			return fmt.Errorf("Error updating Connection Strings for Slot %q (App Service %q / Resource Group %q): %+v", slot, appServiceName, resGroup, err)
+		}
+	}
+
+	return resourceArmAppServiceSlotRead(d, meta)
+}
+
+func resourceArmAppServiceSlotRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+	slot := id.Path["slots"]
+
+	resp, err := client.GetSlot(resGroup, appServiceName, slot)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Slot %q (App Service %q / Resource Group %q) was not found - removing from state", slot, appServiceName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Slot %q (App Service %q / Resource Group %q): %+v", slot, appServiceName, resGroup, err)
+	}
+
+	configResp, err := client.GetConfigurationSlot(resGroup, appServiceName, slot)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Configuration for Slot %q (App Service %q / Resource Group %q): %+v", slot, appServiceName, resGroup, err)
+	}
+
+	appSettingsResp, err := client.ListApplicationSettingsSlot(resGroup, appServiceName, slot)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Application Settings for Slot %q (App Service %q / Resource Group %q): %+v", slot, appServiceName, resGroup, err)
+	}
+
+	connectionStringsResp, err := client.ListConnectionStringsSlot(resGroup, appServiceName, slot)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Connection Strings for Slot %q (App Service %q / Resource Group %q): %+v", slot, appServiceName, resGroup, err)
+	}
+
+	d.Set("name", slot)
+	d.Set("app_service_name", appServiceName)
+	d.Set("resource_group_name", resGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.SiteProperties; props != nil {
+		d.Set("app_service_plan_id", props.ServerFarmID)
+		d.Set("client_affinity_enabled", props.ClientAffinityEnabled)
+		d.Set("enabled", props.Enabled)
+		d.Set("default_site_hostname", props.DefaultHostName)
+	}
+
+	if err := d.Set("app_settings", flattenAppServiceAppSettings(appSettingsResp.Properties)); err != nil {
+		return err
+	}
+	if err := d.Set("connection_string", flattenAppServiceConnectionStrings(connectionStringsResp.Properties)); err != nil {
+		return err
+	}
+
+	siteConfig := flattenAppServiceSiteConfig(configResp.SiteConfig)
+	if err := d.Set("site_config", siteConfig); err != nil {
+		return err
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmAppServiceSlotDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appServicesClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	appServiceName := id.Path["sites"]
+	slot := id.Path["slots"]
+
+	log.Printf("[DEBUG] Deleting Slot %q (App Service %q / Resource Group %q)", slot, appServiceName, resGroup)
+
+	deleteMetrics := true
+	deleteEmptyServerFarm := false
+	skipDNSRegistration := true
+	resp, err := client.DeleteSlot(resGroup, appServiceName, slot, &deleteMetrics, &deleteEmptyServerFarm, &skipDNSRegistration)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Slot %q (App Service %q / Resource Group %q): %+v", slot, appServiceName, resGroup, err)
+		}
+	}
+
+	return nil
+}
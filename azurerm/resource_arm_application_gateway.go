@@ -0,0 +1,1690 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApplicationGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApplicationGatewayCreateUpdate,
+		Read:   resourceArmApplicationGatewayRead,
+		Update: resourceArmApplicationGatewayCreateUpdate,
+		Delete: resourceArmApplicationGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("applicationGateways"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"sku": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.StandardSmall),
+								string(network.StandardMedium),
+								string(network.StandardLarge),
+								string(network.WAFMedium),
+								string(network.WAFLarge),
+							}, true),
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+						},
+
+						"tier": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.Standard),
+								string(network.WAF),
+							}, true),
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+						},
+
+						"capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 10),
+						},
+					},
+				},
+			},
+
+			"disabled_ssl_protocols": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.TLSv10),
+						string(network.TLSv11),
+						string(network.TLSv12),
+					}, false),
+				},
+			},
+
+			"gateway_ip_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"frontend_port": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"frontend_ip_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+
+						"public_ip_address_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+
+						"private_ip_address_allocation": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.Dynamic),
+								string(network.Static),
+							}, true),
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"backend_address_pool": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"ip_address_list": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"fqdn_list": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"backend_http_settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+
+						"protocol": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validateApplicationGatewayProtocol(),
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+						},
+
+						"cookie_based_affinity": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.Enabled),
+								string(network.Disabled),
+							}, true),
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+						},
+
+						"request_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      30,
+							ValidateFunc: validation.IntBetween(1, 86400),
+						},
+
+						"probe_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"authentication_certificate": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"probe_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"http_listener": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"frontend_ip_configuration_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"frontend_port_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"protocol": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validateApplicationGatewayProtocol(),
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+						},
+
+						"host_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"ssl_certificate_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"require_sni": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+
+						"frontend_ip_configuration_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"frontend_port_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"ssl_certificate_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"probe": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"protocol": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validateApplicationGatewayProtocol(),
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+						},
+
+						"path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"host": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"interval": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"timeout": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"unhealthy_threshold": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"request_routing_rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"rule_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.Basic),
+								string(network.PathBasedRouting),
+							}, true),
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+						},
+
+						"http_listener_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"backend_address_pool_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"backend_http_settings_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"url_path_map_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"http_listener_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"backend_address_pool_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"backend_http_settings_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"url_path_map_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"url_path_map": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"default_backend_address_pool_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"default_backend_http_settings_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"path_rule": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"paths": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"backend_address_pool_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"backend_http_settings_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"authentication_certificate": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"data": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"ssl_certificate": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"data": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+
+						"password": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+
+						"public_cert_data": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"waf_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"firewall_mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.Detection),
+								string(network.Prevention),
+							}, true),
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+						},
+
+						"rule_set_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "OWASP",
+						},
+
+						"rule_set_version": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"2.2.9",
+								"3.0",
+							}, false),
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	client := armClient.appGatewayClient
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Application Gateway creation.")
+
+	name := d.Get("name").(string)
+	location := d.Get("location").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	// sub-resources within the Application Gateway are referenced by their ID, which we build from the names given
+	gatewayID := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationGateways/%s", armClient.subscriptionId, resGroup, name)
+
+	properties := network.ApplicationGatewayPropertiesFormat{
+		Sku:                           expandApplicationGatewaySku(d),
+		SslPolicy:                     expandApplicationGatewaySslPolicy(d),
+		GatewayIPConfigurations:       expandApplicationGatewayIPConfigurations(d),
+		FrontendPorts:                 expandApplicationGatewayFrontendPorts(d),
+		FrontendIPConfigurations:      expandApplicationGatewayFrontendIPConfigurations(d),
+		BackendAddressPools:           expandApplicationGatewayBackendAddressPools(d),
+		BackendHTTPSettingsCollection: expandApplicationGatewayBackendHTTPSettings(d, gatewayID),
+		HTTPListeners:                 expandApplicationGatewayHTTPListeners(d, gatewayID),
+		Probes:                        expandApplicationGatewayProbes(d),
+		RequestRoutingRules:           expandApplicationGatewayRequestRoutingRules(d, gatewayID),
+		URLPathMaps:                   expandApplicationGatewayURLPathMaps(d, gatewayID),
+		AuthenticationCertificates:    expandApplicationGatewayAuthenticationCertificates(d),
+		SslCertificates:               expandApplicationGatewaySslCertificates(d),
+	}
+
+	if _, ok := d.GetOk("waf_configuration"); ok {
+		properties.WebApplicationFirewallConfiguration = expandApplicationGatewayWafConfig(d)
+	}
+
+	gateway := network.ApplicationGateway{
+		Name:                               utils.String(name),
+		Location:                           utils.String(location),
+		Tags:                               expandResourceTags(d, tags, meta),
+		ApplicationGatewayPropertiesFormat: &properties,
+	}
+
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.CreateOrUpdate(resGroup, name, gateway, cancel)
+	err := <-createErr
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Application Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	read, err := client.Get(resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Application Gateway %q (Resource Group %q) ID", name, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmApplicationGatewayRead(d, meta)
+}
+
+func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appGatewayClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["applicationGateways"]
+
+	resp, err := client.Get(resGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Application Gateway %q was not found - removing from state", name)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Application Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil {
+		if err := d.Set("sku", flattenApplicationGatewaySku(props.Sku)); err != nil {
+			return fmt.Errorf("Error setting `sku`: %+v", err)
+		}
+
+		if err := d.Set("disabled_ssl_protocols", flattenApplicationGatewaySslPolicy(props.SslPolicy)); err != nil {
+			return fmt.Errorf("Error setting `disabled_ssl_protocols`: %+v", err)
+		}
+
+		if err := d.Set("gateway_ip_configuration", flattenApplicationGatewayIPConfigurations(props.GatewayIPConfigurations)); err != nil {
+			return fmt.Errorf("Error setting `gateway_ip_configuration`: %+v", err)
+		}
+
+		if err := d.Set("frontend_port", flattenApplicationGatewayFrontendPorts(props.FrontendPorts)); err != nil {
+			return fmt.Errorf("Error setting `frontend_port`: %+v", err)
+		}
+
+		if err := d.Set("frontend_ip_configuration", flattenApplicationGatewayFrontendIPConfigurations(props.FrontendIPConfigurations)); err != nil {
+			return fmt.Errorf("Error setting `frontend_ip_configuration`: %+v", err)
+		}
+
+		if err := d.Set("backend_address_pool", flattenApplicationGatewayBackendAddressPools(props.BackendAddressPools)); err != nil {
+			return fmt.Errorf("Error setting `backend_address_pool`: %+v", err)
+		}
+
+		if err := d.Set("backend_http_settings", flattenApplicationGatewayBackendHTTPSettings(props.BackendHTTPSettingsCollection)); err != nil {
+			return fmt.Errorf("Error setting `backend_http_settings`: %+v", err)
+		}
+
+		if err := d.Set("http_listener", flattenApplicationGatewayHTTPListeners(props.HTTPListeners)); err != nil {
+			return fmt.Errorf("Error setting `http_listener`: %+v", err)
+		}
+
+		if err := d.Set("probe", flattenApplicationGatewayProbes(props.Probes)); err != nil {
+			return fmt.Errorf("Error setting `probe`: %+v", err)
+		}
+
+		if err := d.Set("request_routing_rule", flattenApplicationGatewayRequestRoutingRules(props.RequestRoutingRules)); err != nil {
+			return fmt.Errorf("Error setting `request_routing_rule`: %+v", err)
+		}
+
+		if err := d.Set("url_path_map", flattenApplicationGatewayURLPathMaps(props.URLPathMaps)); err != nil {
+			return fmt.Errorf("Error setting `url_path_map`: %+v", err)
+		}
+
+		if err := d.Set("authentication_certificate", flattenApplicationGatewayAuthenticationCertificates(d, props.AuthenticationCertificates)); err != nil {
+			return fmt.Errorf("Error setting `authentication_certificate`: %+v", err)
+		}
+
+		if err := d.Set("ssl_certificate", flattenApplicationGatewaySslCertificates(d, props.SslCertificates)); err != nil {
+			return fmt.Errorf("Error setting `ssl_certificate`: %+v", err)
+		}
+
+		if err := d.Set("waf_configuration", flattenApplicationGatewayWafConfig(props.WebApplicationFirewallConfiguration)); err != nil {
+			return fmt.Errorf("Error setting `waf_configuration`: %+v", err)
+		}
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmApplicationGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appGatewayClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["applicationGateways"]
+
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := client.Delete(resGroup, name, cancel)
+	resp := <-deleteResp
+	err = <-deleteErr
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Application Gateway %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	return nil
+}
+
+func validateApplicationGatewayProtocol() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		string(network.HTTP),
+		string(network.HTTPS),
+	}, true)
+}
+
+// applicationGatewaySubResource returns a reference to the named sub-resource of the Application Gateway
+func applicationGatewaySubResource(gatewayID, resourceType, name string) *network.SubResource {
+	return &network.SubResource{
+		ID: utils.String(fmt.Sprintf("%s/%s/%s", gatewayID, resourceType, name)),
+	}
+}
+
+// applicationGatewaySubResourceName returns the name of a referenced sub-resource, which is the last segment of its ID
+func applicationGatewaySubResourceName(input *network.SubResource) string {
+	if input == nil || input.ID == nil {
+		return ""
+	}
+
+	segments := strings.Split(*input.ID, "/")
+	return segments[len(segments)-1]
+}
+
+func applicationGatewaySubResourceID(input *network.SubResource) string {
+	if input == nil || input.ID == nil {
+		return ""
+	}
+
+	return *input.ID
+}
+
+func expandApplicationGatewaySku(d *schema.ResourceData) *network.ApplicationGatewaySku {
+	skuSet := d.Get("sku").([]interface{})
+	sku := skuSet[0].(map[string]interface{})
+
+	return &network.ApplicationGatewaySku{
+		Name:     network.ApplicationGatewaySkuName(sku["name"].(string)),
+		Tier:     network.ApplicationGatewayTier(sku["tier"].(string)),
+		Capacity: utils.Int32(int32(sku["capacity"].(int))),
+	}
+}
+
+func flattenApplicationGatewaySku(input *network.ApplicationGatewaySku) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := map[string]interface{}{
+		"name": string(input.Name),
+		"tier": string(input.Tier),
+	}
+
+	if capacity := input.Capacity; capacity != nil {
+		output["capacity"] = int(*capacity)
+	}
+
+	return []interface{}{output}
+}
+
+func expandApplicationGatewaySslPolicy(d *schema.ResourceData) *network.ApplicationGatewaySslPolicy {
+	disabledProtocols := d.Get("disabled_ssl_protocols").([]interface{})
+	protocols := make([]network.ApplicationGatewaySslProtocol, 0, len(disabledProtocols))
+
+	for _, protocol := range disabledProtocols {
+		protocols = append(protocols, network.ApplicationGatewaySslProtocol(protocol.(string)))
+	}
+
+	return &network.ApplicationGatewaySslPolicy{
+		DisabledSslProtocols: &protocols,
+	}
+}
+
+func flattenApplicationGatewaySslPolicy(input *network.ApplicationGatewaySslPolicy) []interface{} {
+	output := make([]interface{}, 0)
+
+	if input == nil || input.DisabledSslProtocols == nil {
+		return output
+	}
+
+	for _, protocol := range *input.DisabledSslProtocols {
+		output = append(output, string(protocol))
+	}
+
+	return output
+}
+
+func expandApplicationGatewayIPConfigurations(d *schema.ResourceData) *[]network.ApplicationGatewayIPConfiguration {
+	configs := d.Get("gateway_ip_configuration").([]interface{})
+	results := make([]network.ApplicationGatewayIPConfiguration, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		results = append(results, network.ApplicationGatewayIPConfiguration{
+			Name: utils.String(data["name"].(string)),
+			ApplicationGatewayIPConfigurationPropertiesFormat: &network.ApplicationGatewayIPConfigurationPropertiesFormat{
+				Subnet: &network.SubResource{
+					ID: utils.String(data["subnet_id"].(string)),
+				},
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenApplicationGatewayIPConfigurations(input *[]network.ApplicationGatewayIPConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input == nil {
+		return results
+	}
+
+	for _, config := range *input {
+		output := map[string]interface{}{}
+
+		if config.ID != nil {
+			output["id"] = *config.ID
+		}
+
+		if config.Name != nil {
+			output["name"] = *config.Name
+		}
+
+		if props := config.ApplicationGatewayIPConfigurationPropertiesFormat; props != nil {
+			output["subnet_id"] = applicationGatewaySubResourceID(props.Subnet)
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func expandApplicationGatewayFrontendPorts(d *schema.ResourceData) *[]network.ApplicationGatewayFrontendPort {
+	configs := d.Get("frontend_port").([]interface{})
+	results := make([]network.ApplicationGatewayFrontendPort, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		results = append(results, network.ApplicationGatewayFrontendPort{
+			Name: utils.String(data["name"].(string)),
+			ApplicationGatewayFrontendPortPropertiesFormat: &network.ApplicationGatewayFrontendPortPropertiesFormat{
+				Port: utils.Int32(int32(data["port"].(int))),
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenApplicationGatewayFrontendPorts(input *[]network.ApplicationGatewayFrontendPort) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input == nil {
+		return results
+	}
+
+	for _, config := range *input {
+		output := map[string]interface{}{}
+
+		if config.ID != nil {
+			output["id"] = *config.ID
+		}
+
+		if config.Name != nil {
+			output["name"] = *config.Name
+		}
+
+		if props := config.ApplicationGatewayFrontendPortPropertiesFormat; props != nil && props.Port != nil {
+			output["port"] = int(*props.Port)
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func expandApplicationGatewayFrontendIPConfigurations(d *schema.ResourceData) *[]network.ApplicationGatewayFrontendIPConfiguration {
+	configs := d.Get("frontend_ip_configuration").([]interface{})
+	results := make([]network.ApplicationGatewayFrontendIPConfiguration, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		properties := network.ApplicationGatewayFrontendIPConfigurationPropertiesFormat{
+			PrivateIPAllocationMethod: network.IPAllocationMethod(data["private_ip_address_allocation"].(string)),
+		}
+
+		if v := data["subnet_id"].(string); v != "" {
+			properties.Subnet = &network.SubResource{
+				ID: utils.String(v),
+			}
+		}
+
+		if v := data["private_ip_address"].(string); v != "" {
+			properties.PrivateIPAddress = utils.String(v)
+		}
+
+		if v := data["public_ip_address_id"].(string); v != "" {
+			properties.PublicIPAddress = &network.SubResource{
+				ID: utils.String(v),
+			}
+		}
+
+		results = append(results, network.ApplicationGatewayFrontendIPConfiguration{
+			Name: utils.String(data["name"].(string)),
+			ApplicationGatewayFrontendIPConfigurationPropertiesFormat: &properties,
+		})
+	}
+
+	return &results
+}
+
+func flattenApplicationGatewayFrontendIPConfigurations(input *[]network.ApplicationGatewayFrontendIPConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input == nil {
+		return results
+	}
+
+	for _, config := range *input {
+		output := map[string]interface{}{}
+
+		if config.ID != nil {
+			output["id"] = *config.ID
+		}
+
+		if config.Name != nil {
+			output["name"] = *config.Name
+		}
+
+		if props := config.ApplicationGatewayFrontendIPConfigurationPropertiesFormat; props != nil {
+			output["private_ip_address_allocation"] = string(props.PrivateIPAllocationMethod)
+			output["subnet_id"] = applicationGatewaySubResourceID(props.Subnet)
+			output["public_ip_address_id"] = applicationGatewaySubResourceID(props.PublicIPAddress)
+
+			if props.PrivateIPAddress != nil {
+				output["private_ip_address"] = *props.PrivateIPAddress
+			}
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func expandApplicationGatewayBackendAddressPools(d *schema.ResourceData) *[]network.ApplicationGatewayBackendAddressPool {
+	configs := d.Get("backend_address_pool").([]interface{})
+	results := make([]network.ApplicationGatewayBackendAddressPool, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		addresses := make([]network.ApplicationGatewayBackendAddress, 0)
+
+		for _, ip := range data["ip_address_list"].([]interface{}) {
+			addresses = append(addresses, network.ApplicationGatewayBackendAddress{
+				IPAddress: utils.String(ip.(string)),
+			})
+		}
+
+		for _, fqdn := range data["fqdn_list"].([]interface{}) {
+			addresses = append(addresses, network.ApplicationGatewayBackendAddress{
+				Fqdn: utils.String(fqdn.(string)),
+			})
+		}
+
+		results = append(results, network.ApplicationGatewayBackendAddressPool{
+			Name: utils.String(data["name"].(string)),
+			ApplicationGatewayBackendAddressPoolPropertiesFormat: &network.ApplicationGatewayBackendAddressPoolPropertiesFormat{
+				BackendAddresses: &addresses,
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenApplicationGatewayBackendAddressPools(input *[]network.ApplicationGatewayBackendAddressPool) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input == nil {
+		return results
+	}
+
+	for _, config := range *input {
+		ipAddressList := make([]interface{}, 0)
+		fqdnList := make([]interface{}, 0)
+
+		if props := config.ApplicationGatewayBackendAddressPoolPropertiesFormat; props != nil && props.BackendAddresses != nil {
+			for _, address := range *props.BackendAddresses {
+				if address.IPAddress != nil {
+					ipAddressList = append(ipAddressList, *address.IPAddress)
+				}
+
+				if address.Fqdn != nil {
+					fqdnList = append(fqdnList, *address.Fqdn)
+				}
+			}
+		}
+
+		output := map[string]interface{}{
+			"ip_address_list": ipAddressList,
+			"fqdn_list":       fqdnList,
+		}
+
+		if config.ID != nil {
+			output["id"] = *config.ID
+		}
+
+		if config.Name != nil {
+			output["name"] = *config.Name
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func expandApplicationGatewayBackendHTTPSettings(d *schema.ResourceData, gatewayID string) *[]network.ApplicationGatewayBackendHTTPSettings {
+	configs := d.Get("backend_http_settings").([]interface{})
+	results := make([]network.ApplicationGatewayBackendHTTPSettings, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		properties := network.ApplicationGatewayBackendHTTPSettingsPropertiesFormat{
+			Port:                utils.Int32(int32(data["port"].(int))),
+			Protocol:            network.ApplicationGatewayProtocol(data["protocol"].(string)),
+			CookieBasedAffinity: network.ApplicationGatewayCookieBasedAffinity(data["cookie_based_affinity"].(string)),
+			RequestTimeout:      utils.Int32(int32(data["request_timeout"].(int))),
+		}
+
+		if probeName := data["probe_name"].(string); probeName != "" {
+			properties.Probe = applicationGatewaySubResource(gatewayID, "probes", probeName)
+		}
+
+		if certs := data["authentication_certificate"].([]interface{}); len(certs) > 0 {
+			certificates := make([]network.SubResource, 0, len(certs))
+			for _, certRaw := range certs {
+				cert := certRaw.(map[string]interface{})
+				certificates = append(certificates, *applicationGatewaySubResource(gatewayID, "authenticationCertificates", cert["name"].(string)))
+			}
+			properties.AuthenticationCertificates = &certificates
+		}
+
+		results = append(results, network.ApplicationGatewayBackendHTTPSettings{
+			Name: utils.String(data["name"].(string)),
+			ApplicationGatewayBackendHTTPSettingsPropertiesFormat: &properties,
+		})
+	}
+
+	return &results
+}
+
+func flattenApplicationGatewayBackendHTTPSettings(input *[]network.ApplicationGatewayBackendHTTPSettings) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input == nil {
+		return results
+	}
+
+	for _, config := range *input {
+		output := map[string]interface{}{}
+
+		if config.ID != nil {
+			output["id"] = *config.ID
+		}
+
+		if config.Name != nil {
+			output["name"] = *config.Name
+		}
+
+		if props := config.ApplicationGatewayBackendHTTPSettingsPropertiesFormat; props != nil {
+			output["protocol"] = string(props.Protocol)
+			output["cookie_based_affinity"] = string(props.CookieBasedAffinity)
+
+			if props.Port != nil {
+				output["port"] = int(*props.Port)
+			}
+
+			if props.RequestTimeout != nil {
+				output["request_timeout"] = int(*props.RequestTimeout)
+			}
+
+			if props.Probe != nil {
+				output["probe_name"] = applicationGatewaySubResourceName(props.Probe)
+				output["probe_id"] = applicationGatewaySubResourceID(props.Probe)
+			}
+
+			certificates := make([]interface{}, 0)
+			if certs := props.AuthenticationCertificates; certs != nil {
+				for _, cert := range *certs {
+					certificates = append(certificates, map[string]interface{}{
+						"name": applicationGatewaySubResourceName(&cert),
+						"id":   applicationGatewaySubResourceID(&cert),
+					})
+				}
+			}
+			output["authentication_certificate"] = certificates
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func expandApplicationGatewayHTTPListeners(d *schema.ResourceData, gatewayID string) *[]network.ApplicationGatewayHTTPListener {
+	configs := d.Get("http_listener").([]interface{})
+	results := make([]network.ApplicationGatewayHTTPListener, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		properties := network.ApplicationGatewayHTTPListenerPropertiesFormat{
+			FrontendIPConfiguration:     applicationGatewaySubResource(gatewayID, "frontendIPConfigurations", data["frontend_ip_configuration_name"].(string)),
+			FrontendPort:                applicationGatewaySubResource(gatewayID, "frontendPorts", data["frontend_port_name"].(string)),
+			Protocol:                    network.ApplicationGatewayProtocol(data["protocol"].(string)),
+			RequireServerNameIndication: utils.Bool(data["require_sni"].(bool)),
+		}
+
+		if host := data["host_name"].(string); host != "" {
+			properties.HostName = utils.String(host)
+		}
+
+		if certName := data["ssl_certificate_name"].(string); certName != "" {
+			properties.SslCertificate = applicationGatewaySubResource(gatewayID, "sslCertificates", certName)
+		}
+
+		results = append(results, network.ApplicationGatewayHTTPListener{
+			Name: utils.String(data["name"].(string)),
+			ApplicationGatewayHTTPListenerPropertiesFormat: &properties,
+		})
+	}
+
+	return &results
+}
+
+func flattenApplicationGatewayHTTPListeners(input *[]network.ApplicationGatewayHTTPListener) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input == nil {
+		return results
+	}
+
+	for _, config := range *input {
+		output := map[string]interface{}{}
+
+		if config.ID != nil {
+			output["id"] = *config.ID
+		}
+
+		if config.Name != nil {
+			output["name"] = *config.Name
+		}
+
+		if props := config.ApplicationGatewayHTTPListenerPropertiesFormat; props != nil {
+			output["frontend_ip_configuration_name"] = applicationGatewaySubResourceName(props.FrontendIPConfiguration)
+			output["frontend_ip_configuration_id"] = applicationGatewaySubResourceID(props.FrontendIPConfiguration)
+			output["frontend_port_name"] = applicationGatewaySubResourceName(props.FrontendPort)
+			output["frontend_port_id"] = applicationGatewaySubResourceID(props.FrontendPort)
+			output["ssl_certificate_name"] = applicationGatewaySubResourceName(props.SslCertificate)
+			output["ssl_certificate_id"] = applicationGatewaySubResourceID(props.SslCertificate)
+			output["protocol"] = string(props.Protocol)
+
+			if props.HostName != nil {
+				output["host_name"] = *props.HostName
+			}
+
+			if props.RequireServerNameIndication != nil {
+				output["require_sni"] = *props.RequireServerNameIndication
+			}
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func expandApplicationGatewayProbes(d *schema.ResourceData) *[]network.ApplicationGatewayProbe {
+	configs := d.Get("probe").([]interface{})
+	results := make([]network.ApplicationGatewayProbe, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		results = append(results, network.ApplicationGatewayProbe{
+			Name: utils.String(data["name"].(string)),
+			ApplicationGatewayProbePropertiesFormat: &network.ApplicationGatewayProbePropertiesFormat{
+				Protocol:           network.ApplicationGatewayProtocol(data["protocol"].(string)),
+				Path:               utils.String(data["path"].(string)),
+				Host:               utils.String(data["host"].(string)),
+				Interval:           utils.Int32(int32(data["interval"].(int))),
+				Timeout:            utils.Int32(int32(data["timeout"].(int))),
+				UnhealthyThreshold: utils.Int32(int32(data["unhealthy_threshold"].(int))),
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenApplicationGatewayProbes(input *[]network.ApplicationGatewayProbe) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input == nil {
+		return results
+	}
+
+	for _, config := range *input {
+		output := map[string]interface{}{}
+
+		if config.ID != nil {
+			output["id"] = *config.ID
+		}
+
+		if config.Name != nil {
+			output["name"] = *config.Name
+		}
+
+		if props := config.ApplicationGatewayProbePropertiesFormat; props != nil {
+			output["protocol"] = string(props.Protocol)
+
+			if props.Path != nil {
+				output["path"] = *props.Path
+			}
+
+			if props.Host != nil {
+				output["host"] = *props.Host
+			}
+
+			if props.Interval != nil {
+				output["interval"] = int(*props.Interval)
+			}
+
+			if props.Timeout != nil {
+				output["timeout"] = int(*props.Timeout)
+			}
+
+			if props.UnhealthyThreshold != nil {
+				output["unhealthy_threshold"] = int(*props.UnhealthyThreshold)
+			}
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func expandApplicationGatewayRequestRoutingRules(d *schema.ResourceData, gatewayID string) *[]network.ApplicationGatewayRequestRoutingRule {
+	configs := d.Get("request_routing_rule").([]interface{})
+	results := make([]network.ApplicationGatewayRequestRoutingRule, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		properties := network.ApplicationGatewayRequestRoutingRulePropertiesFormat{
+			RuleType:     network.ApplicationGatewayRequestRoutingRuleType(data["rule_type"].(string)),
+			HTTPListener: applicationGatewaySubResource(gatewayID, "httpListeners", data["http_listener_name"].(string)),
+		}
+
+		if poolName := data["backend_address_pool_name"].(string); poolName != "" {
+			properties.BackendAddressPool = applicationGatewaySubResource(gatewayID, "backendAddressPools", poolName)
+		}
+
+		if settingsName := data["backend_http_settings_name"].(string); settingsName != "" {
+			properties.BackendHTTPSettings = applicationGatewaySubResource(gatewayID, "backendHttpSettingsCollection", settingsName)
+		}
+
+		if pathMapName := data["url_path_map_name"].(string); pathMapName != "" {
+			properties.URLPathMap = applicationGatewaySubResource(gatewayID, "urlPathMaps", pathMapName)
+		}
+
+		results = append(results, network.ApplicationGatewayRequestRoutingRule{
+			Name: utils.String(data["name"].(string)),
+			ApplicationGatewayRequestRoutingRulePropertiesFormat: &properties,
+		})
+	}
+
+	return &results
+}
+
+func flattenApplicationGatewayRequestRoutingRules(input *[]network.ApplicationGatewayRequestRoutingRule) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input == nil {
+		return results
+	}
+
+	for _, config := range *input {
+		output := map[string]interface{}{}
+
+		if config.ID != nil {
+			output["id"] = *config.ID
+		}
+
+		if config.Name != nil {
+			output["name"] = *config.Name
+		}
+
+		if props := config.ApplicationGatewayRequestRoutingRulePropertiesFormat; props != nil {
+			output["rule_type"] = string(props.RuleType)
+			output["http_listener_name"] = applicationGatewaySubResourceName(props.HTTPListener)
+			output["http_listener_id"] = applicationGatewaySubResourceID(props.HTTPListener)
+			output["backend_address_pool_name"] = applicationGatewaySubResourceName(props.BackendAddressPool)
+			output["backend_address_pool_id"] = applicationGatewaySubResourceID(props.BackendAddressPool)
+			output["backend_http_settings_name"] = applicationGatewaySubResourceName(props.BackendHTTPSettings)
+			output["backend_http_settings_id"] = applicationGatewaySubResourceID(props.BackendHTTPSettings)
+			output["url_path_map_name"] = applicationGatewaySubResourceName(props.URLPathMap)
+			output["url_path_map_id"] = applicationGatewaySubResourceID(props.URLPathMap)
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func expandApplicationGatewayURLPathMaps(d *schema.ResourceData, gatewayID string) *[]network.ApplicationGatewayURLPathMap {
+	configs := d.Get("url_path_map").([]interface{})
+	results := make([]network.ApplicationGatewayURLPathMap, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		pathRulesConfig := data["path_rule"].([]interface{})
+		pathRules := make([]network.ApplicationGatewayPathRule, 0, len(pathRulesConfig))
+		for _, ruleRaw := range pathRulesConfig {
+			rule := ruleRaw.(map[string]interface{})
+
+			paths := make([]string, 0)
+			for _, path := range rule["paths"].([]interface{}) {
+				paths = append(paths, path.(string))
+			}
+
+			pathRules = append(pathRules, network.ApplicationGatewayPathRule{
+				Name: utils.String(rule["name"].(string)),
+				ApplicationGatewayPathRulePropertiesFormat: &network.ApplicationGatewayPathRulePropertiesFormat{
+					Paths:               &paths,
+					BackendAddressPool:  applicationGatewaySubResource(gatewayID, "backendAddressPools", rule["backend_address_pool_name"].(string)),
+					BackendHTTPSettings: applicationGatewaySubResource(gatewayID, "backendHttpSettingsCollection", rule["backend_http_settings_name"].(string)),
+				},
+			})
+		}
+
+		results = append(results, network.ApplicationGatewayURLPathMap{
+			Name: utils.String(data["name"].(string)),
+			ApplicationGatewayURLPathMapPropertiesFormat: &network.ApplicationGatewayURLPathMapPropertiesFormat{
+				DefaultBackendAddressPool:  applicationGatewaySubResource(gatewayID, "backendAddressPools", data["default_backend_address_pool_name"].(string)),
+				DefaultBackendHTTPSettings: applicationGatewaySubResource(gatewayID, "backendHttpSettingsCollection", data["default_backend_http_settings_name"].(string)),
+				PathRules:                  &pathRules,
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenApplicationGatewayURLPathMaps(input *[]network.ApplicationGatewayURLPathMap) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input == nil {
+		return results
+	}
+
+	for _, config := range *input {
+		output := map[string]interface{}{}
+
+		if config.ID != nil {
+			output["id"] = *config.ID
+		}
+
+		if config.Name != nil {
+			output["name"] = *config.Name
+		}
+
+		if props := config.ApplicationGatewayURLPathMapPropertiesFormat; props != nil {
+			output["default_backend_address_pool_name"] = applicationGatewaySubResourceName(props.DefaultBackendAddressPool)
+			output["default_backend_http_settings_name"] = applicationGatewaySubResourceName(props.DefaultBackendHTTPSettings)
+
+			pathRules := make([]interface{}, 0)
+			if rules := props.PathRules; rules != nil {
+				for _, rule := range *rules {
+					ruleOutput := map[string]interface{}{}
+
+					if rule.ID != nil {
+						ruleOutput["id"] = *rule.ID
+					}
+
+					if rule.Name != nil {
+						ruleOutput["name"] = *rule.Name
+					}
+
+					if ruleProps := rule.ApplicationGatewayPathRulePropertiesFormat; ruleProps != nil {
+						ruleOutput["backend_address_pool_name"] = applicationGatewaySubResourceName(ruleProps.BackendAddressPool)
+						ruleOutput["backend_http_settings_name"] = applicationGatewaySubResourceName(ruleProps.BackendHTTPSettings)
+
+						paths := make([]interface{}, 0)
+						if ruleProps.Paths != nil {
+							for _, path := range *ruleProps.Paths {
+								paths = append(paths, path)
+							}
+						}
+						ruleOutput["paths"] = paths
+					}
+
+					pathRules = append(pathRules, ruleOutput)
+				}
+			}
+			output["path_rule"] = pathRules
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func expandApplicationGatewayAuthenticationCertificates(d *schema.ResourceData) *[]network.ApplicationGatewayAuthenticationCertificate {
+	configs := d.Get("authentication_certificate").([]interface{})
+	results := make([]network.ApplicationGatewayAuthenticationCertificate, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		results = append(results, network.ApplicationGatewayAuthenticationCertificate{
+			Name: utils.String(data["name"].(string)),
+			ApplicationGatewayAuthenticationCertificatePropertiesFormat: &network.ApplicationGatewayAuthenticationCertificatePropertiesFormat{
+				Data: utils.String(data["data"].(string)),
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenApplicationGatewayAuthenticationCertificates(d *schema.ResourceData, input *[]network.ApplicationGatewayAuthenticationCertificate) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input == nil {
+		return results
+	}
+
+	// the certificate data isn't returned from the API, so we pull it from the config by name
+	existing := applicationGatewayConfigByName(d, "authentication_certificate")
+
+	for _, config := range *input {
+		output := map[string]interface{}{}
+
+		if config.ID != nil {
+			output["id"] = *config.ID
+		}
+
+		if config.Name != nil {
+			output["name"] = *config.Name
+
+			if v, ok := existing[*config.Name]; ok {
+				output["data"] = v["data"]
+			}
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func expandApplicationGatewaySslCertificates(d *schema.ResourceData) *[]network.ApplicationGatewaySslCertificate {
+	configs := d.Get("ssl_certificate").([]interface{})
+	results := make([]network.ApplicationGatewaySslCertificate, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		results = append(results, network.ApplicationGatewaySslCertificate{
+			Name: utils.String(data["name"].(string)),
+			ApplicationGatewaySslCertificatePropertiesFormat: &network.ApplicationGatewaySslCertificatePropertiesFormat{
+				Data:     utils.String(data["data"].(string)),
+				Password: utils.String(data["password"].(string)),
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenApplicationGatewaySslCertificates(d *schema.ResourceData, input *[]network.ApplicationGatewaySslCertificate) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input == nil {
+		return results
+	}
+
+	// the certificate data & password aren't returned from the API, so we pull them from the config by name
+	existing := applicationGatewayConfigByName(d, "ssl_certificate")
+
+	for _, config := range *input {
+		output := map[string]interface{}{}
+
+		if config.ID != nil {
+			output["id"] = *config.ID
+		}
+
+		if config.Name != nil {
+			output["name"] = *config.Name
+
+			if v, ok := existing[*config.Name]; ok {
+				output["data"] = v["data"]
+				output["password"] = v["password"]
+			}
+		}
+
+		if props := config.ApplicationGatewaySslCertificatePropertiesFormat; props != nil && props.PublicCertData != nil {
+			output["public_cert_data"] = *props.PublicCertData
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func applicationGatewayConfigByName(d *schema.ResourceData, key string) map[string]map[string]interface{} {
+	output := make(map[string]map[string]interface{}, 0)
+
+	for _, configRaw := range d.Get(key).([]interface{}) {
+		data := configRaw.(map[string]interface{})
+		output[data["name"].(string)] = data
+	}
+
+	return output
+}
+
+func expandApplicationGatewayWafConfig(d *schema.ResourceData) *network.ApplicationGatewayWebApplicationFirewallConfiguration {
+	wafSet := d.Get("waf_configuration").([]interface{})
+	waf := wafSet[0].(map[string]interface{})
+
+	return &network.ApplicationGatewayWebApplicationFirewallConfiguration{
+		Enabled:        utils.Bool(waf["enabled"].(bool)),
+		FirewallMode:   network.ApplicationGatewayFirewallMode(waf["firewall_mode"].(string)),
+		RuleSetType:    utils.String(waf["rule_set_type"].(string)),
+		RuleSetVersion: utils.String(waf["rule_set_version"].(string)),
+	}
+}
+
+func flattenApplicationGatewayWafConfig(input *network.ApplicationGatewayWebApplicationFirewallConfiguration) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := map[string]interface{}{
+		"firewall_mode": string(input.FirewallMode),
+	}
+
+	if input.Enabled != nil {
+		output["enabled"] = *input.Enabled
+	}
+
+	if input.RuleSetType != nil {
+		output["rule_set_type"] = *input.RuleSetType
+	}
+
+	if input.RuleSetVersion != nil {
+		output["rule_set_version"] = *input.RuleSetVersion
+	}
+
+	return []interface{}{output}
+}
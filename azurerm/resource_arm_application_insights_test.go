@@ -24,6 +24,8 @@ func TestAccAzureRMApplicationInsights_basicWeb(t *testing.T) {
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMApplicationInsightsExists("azurerm_application_insights.test"),
+					resource.TestCheckResourceAttrSet("azurerm_application_insights.test", "app_id"),
+					resource.TestCheckResourceAttrSet("azurerm_application_insights.test", "instrumentation_key"),
 				),
 			},
 		},
@@ -44,6 +46,29 @@ func TestAccAzureRMApplicationInsights_basicOther(t *testing.T) {
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMApplicationInsightsExists("azurerm_application_insights.test"),
+					resource.TestCheckResourceAttrSet("azurerm_application_insights.test", "app_id"),
+					resource.TestCheckResourceAttrSet("azurerm_application_insights.test", "instrumentation_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationInsights_appService(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccAzureRMApplicationInsights_appService(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationInsightsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationInsightsExists("azurerm_application_insights.test"),
+					testCheckAzureRMAppServiceExists("azurerm_app_service.test"),
+					resource.TestCheckResourceAttrPair("azurerm_app_service.test", "app_settings.APPINSIGHTS_INSTRUMENTATIONKEY", "azurerm_application_insights.test", "instrumentation_key"),
 				),
 			},
 		},
@@ -135,3 +160,41 @@ resource "azurerm_application_insights" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMApplicationInsights_appService(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_application_insights" "test" {
+  name                = "acctestappinsights-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  application_type    = "web"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+
+resource "azurerm_app_service" "test" {
+  name                = "acctestAS-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  app_service_plan_id = "${azurerm_app_service_plan.test.id}"
+
+  app_settings {
+    "APPINSIGHTS_INSTRUMENTATIONKEY" = "${azurerm_application_insights.test.instrumentation_key}"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
@@ -0,0 +1,277 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/appinsights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApplicationInsightsWebTest() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApplicationInsightsWebTestCreateUpdate,
+		Read:   resourceArmApplicationInsightsWebTestRead,
+		Update: resourceArmApplicationInsightsWebTestCreateUpdate,
+		Delete: resourceArmApplicationInsightsWebTestDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("webtests"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"application_insights_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": locationSchema(),
+
+			"kind": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(appinsights.Multistep),
+					string(appinsights.Ping),
+				}, false),
+			},
+
+			"frequency": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  300,
+				ValidateFunc: validateIntInSlice([]int{
+					300,
+					600,
+					900,
+				}),
+			},
+
+			"timeout": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  30,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"retry_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"geo_locations": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"configuration": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"tags": tagsSchema(),
+
+			"synthetic_monitor_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmApplicationInsightsWebTestCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsWebTestsClient
+	log.Printf("[INFO] preparing arguments for AzureRM Application Insights Web Test creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	appInsightsId := d.Get("application_insights_id").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+
+	kind := d.Get("kind").(string)
+	description := d.Get("description").(string)
+	frequency := int32(d.Get("frequency").(int))
+	timeout := int32(d.Get("timeout").(int))
+	isEnabled := d.Get("enabled").(bool)
+	retryEnabled := d.Get("retry_enabled").(bool)
+	geoLocations := expandApplicationInsightsWebTestGeoLocations(d.Get("geo_locations").([]interface{}))
+	testConf := d.Get("configuration").(string)
+
+	tags := d.Get("tags").(map[string]interface{})
+	expandedTags := expandResourceTags(d, tags, meta)
+
+	// Web Tests are linked to an Application Insights component using a "hidden link" tag
+	(*expandedTags)[fmt.Sprintf("hidden-link:%s", appInsightsId)] = utils.String("Resource")
+
+	webTest := appinsights.WebTest{
+		Name:     &name,
+		Location: &location,
+		Kind:     appinsights.WebTestKind(kind),
+		WebTestProperties: &appinsights.WebTestProperties{
+			SyntheticMonitorID: &name,
+			WebTestName:        &name,
+			Description:        &description,
+			Enabled:            &isEnabled,
+			Frequency:          &frequency,
+			Timeout:            &timeout,
+			WebTestKind:        appinsights.WebTestKind(kind),
+			RetryEnabled:       &retryEnabled,
+			Locations:          &geoLocations,
+			Configuration: &appinsights.WebTestPropertiesConfiguration{
+				WebTest: &testConf,
+			},
+		},
+		Tags: expandedTags,
+	}
+
+	_, err := client.CreateOrUpdate(resGroup, name, webTest)
+	if err != nil {
+		return fmt.Errorf("Error creating Application Insights Web Test %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	read, err := client.Get(resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Application Insights Web Test %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Application Insights Web Test %q (Resource Group %q) ID", name, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmApplicationInsightsWebTestRead(d, meta)
+}
+
+func resourceArmApplicationInsightsWebTestRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsWebTestsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	name := id.Path["webtests"]
+
+	resp, err := client.Get(resGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Application Insights Web Test %q was not found in Resource Group %q - removing from state", name, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Application Insights Web Test %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("kind", string(resp.Kind))
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	// the Application Insights component is linked via a "hidden link" tag, which we don't want to expose as a user tag
+	tags := make(map[string]*string, 0)
+	if resp.Tags != nil {
+		for k, v := range *resp.Tags {
+			if strings.HasPrefix(k, "hidden-link:") {
+				d.Set("application_insights_id", strings.TrimPrefix(k, "hidden-link:"))
+				continue
+			}
+			tags[k] = v
+		}
+	}
+	flattenAndSetResourceTags(d, &tags, meta)
+
+	if props := resp.WebTestProperties; props != nil {
+		d.Set("synthetic_monitor_id", props.SyntheticMonitorID)
+		d.Set("description", props.Description)
+		d.Set("enabled", props.Enabled)
+		d.Set("frequency", props.Frequency)
+		d.Set("timeout", props.Timeout)
+		d.Set("retry_enabled", props.RetryEnabled)
+
+		if config := props.Configuration; config != nil {
+			d.Set("configuration", config.WebTest)
+		}
+
+		if err := d.Set("geo_locations", flattenApplicationInsightsWebTestGeoLocations(props.Locations)); err != nil {
+			return fmt.Errorf("Error setting `geo_locations`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmApplicationInsightsWebTestDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).appInsightsWebTestsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["webtests"]
+
+	resp, err := client.Delete(resGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Application Insights Web Test %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	return nil
+}
+
+func expandApplicationInsightsWebTestGeoLocations(input []interface{}) []appinsights.WebTestGeolocation {
+	locations := make([]appinsights.WebTestGeolocation, 0)
+
+	for _, v := range input {
+		locations = append(locations, appinsights.WebTestGeolocation{
+			Location: utils.String(v.(string)),
+		})
+	}
+
+	return locations
+}
+
+func flattenApplicationInsightsWebTestGeoLocations(input *[]appinsights.WebTestGeolocation) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, prop := range *input {
+		if prop.Location != nil {
+			results = append(results, *prop.Location)
+		}
+	}
+
+	return results
+}
@@ -17,7 +17,7 @@ func resourceArmAutomationAccount() *schema.Resource {
 		Update: resourceArmAutomationAccountCreateUpdate,
 		Delete: resourceArmAutomationAccountDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("automationAccounts"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -51,6 +51,23 @@ func resourceArmAutomationAccount() *schema.Resource {
 			},
 
 			"tags": tagsSchema(),
+
+			"dsc_server_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"dsc_primary_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"dsc_secondary_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 		},
 	}
 }
@@ -72,7 +89,7 @@ func resourceArmAutomationAccountCreateUpdate(d *schema.ResourceData, meta inter
 		},
 
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandResourceTags(d, tags, meta),
 	}
 
 	_, err := client.CreateOrUpdate(resGroup, name, parameters)
@@ -96,6 +113,7 @@ func resourceArmAutomationAccountCreateUpdate(d *schema.ResourceData, meta inter
 
 func resourceArmAutomationAccountRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).automationAccountClient
+	registrationClient := meta.(*ArmClient).automationAgentRegistrationInfoClient
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
@@ -118,7 +136,19 @@ func resourceArmAutomationAccountRead(d *schema.ResourceData, meta interface{})
 	d.Set("resource_group_name", resGroup)
 	flattenAndSetSku(d, resp.Sku)
 
-	flattenAndSetTags(d, resp.Tags)
+	// the DSC Registration details allow Nodes to be onboarded to State Configuration
+	registration, err := registrationClient.Get(resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving DSC Registration Information for AzureRM Automation Account '%s': %+v", name, err)
+	}
+
+	d.Set("dsc_server_endpoint", registration.Endpoint)
+	if keys := registration.Keys; keys != nil {
+		d.Set("dsc_primary_access_key", keys.Primary)
+		d.Set("dsc_secondary_access_key", keys.Secondary)
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
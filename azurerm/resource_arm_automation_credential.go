@@ -16,7 +16,7 @@ func resourceArmAutomationCredential() *schema.Resource {
 		Update: resourceArmAutomationCredentialCreateUpdate,
 		Delete: resourceArmAutomationCredentialDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("automationAccounts", "credentials"),
 		},
 
 		Schema: map[string]*schema.Schema{
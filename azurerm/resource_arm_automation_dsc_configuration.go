@@ -0,0 +1,183 @@
+package azurerm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/automation"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAutomationDscConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAutomationDscConfigurationCreateUpdate,
+		Read:   resourceArmAutomationDscConfigurationRead,
+		Update: resourceArmAutomationDscConfigurationCreateUpdate,
+		Delete: resourceArmAutomationDscConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("automationAccounts", "configurations"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"content_embedded": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"log_verbose": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmAutomationDscConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationDscConfigurationClient
+	log.Printf("[INFO] preparing arguments for AzureRM Automation Dsc Configuration creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	accName := d.Get("account_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	contentEmbedded := d.Get("content_embedded").(string)
+	logVerbose := d.Get("log_verbose").(bool)
+	description := d.Get("description").(string)
+
+	parameters := automation.DscConfigurationCreateOrUpdateParameters{
+		DscConfigurationCreateOrUpdateProperties: &automation.DscConfigurationCreateOrUpdateProperties{
+			LogVerbose:  &logVerbose,
+			Description: &description,
+			Source: &automation.ContentSource{
+				Type:  automation.EmbeddedContent,
+				Value: &contentEmbedded,
+			},
+		},
+		Location: &location,
+		Name:     &name,
+	}
+
+	_, err := client.CreateOrUpdate(resGroup, accName, name, parameters)
+	if err != nil {
+		return err
+	}
+
+	read, err := client.Get(resGroup, accName, name)
+	if err != nil {
+		return err
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Automation Dsc Configuration '%s' (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAutomationDscConfigurationRead(d, meta)
+}
+
+func resourceArmAutomationDscConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationDscConfigurationClient
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	accName := id.Path["automationAccounts"]
+	name := id.Path["configurations"]
+
+	resp, err := client.Get(resGroup, accName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on AzureRM Automation Dsc Configuration '%s': %+v", name, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("account_name", accName)
+
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.DscConfigurationProperties; props != nil {
+		d.Set("log_verbose", props.LogVerbose)
+		d.Set("description", props.Description)
+		d.Set("state", string(props.State))
+	}
+
+	contentResp, err := client.GetContent(resGroup, accName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the content of AzureRM Automation Dsc Configuration '%s': %+v", name, err)
+	}
+
+	if v := contentResp.Value; v != nil && *v != nil {
+		body := *v
+		defer body.Close()
+
+		content, err := ioutil.ReadAll(body)
+		if err != nil {
+			return fmt.Errorf("Error reading the content of AzureRM Automation Dsc Configuration '%s': %+v", name, err)
+		}
+		d.Set("content_embedded", string(content))
+	}
+
+	return nil
+}
+
+func resourceArmAutomationDscConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationDscConfigurationClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	accName := id.Path["automationAccounts"]
+	name := id.Path["configurations"]
+
+	resp, err := client.Delete(resGroup, accName, name)
+
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error issuing AzureRM delete request for Automation Dsc Configuration '%s': %+v", name, err)
+	}
+
+	return nil
+}
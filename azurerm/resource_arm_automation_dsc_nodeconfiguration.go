@@ -0,0 +1,160 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/automation"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAutomationDscNodeConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAutomationDscNodeConfigurationCreateUpdate,
+		Read:   resourceArmAutomationDscNodeConfigurationRead,
+		Update: resourceArmAutomationDscNodeConfigurationCreateUpdate,
+		Delete: resourceArmAutomationDscNodeConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("automationAccounts", "nodeConfigurations"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAutomationDscNodeConfigurationName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"content_embedded": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"configuration_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmAutomationDscNodeConfigurationCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationDscNodeConfigurationClient
+	log.Printf("[INFO] preparing arguments for AzureRM Automation Dsc Node Configuration creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	accName := d.Get("account_name").(string)
+	content := d.Get("content_embedded").(string)
+
+	// the name of a Node Configuration is in the format `{configurationName}.{nodeName}`
+	configurationName := strings.Split(name, ".")[0]
+
+	parameters := automation.DscNodeConfigurationCreateOrUpdateParameters{
+		Source: &automation.ContentSource{
+			Type:  automation.EmbeddedContent,
+			Value: &content,
+		},
+		Configuration: &automation.DscConfigurationAssociationProperty{
+			Name: &configurationName,
+		},
+		Name: &name,
+	}
+
+	_, err := client.CreateOrUpdate(resGroup, accName, name, parameters)
+	if err != nil {
+		return err
+	}
+
+	read, err := client.Get(resGroup, accName, name)
+	if err != nil {
+		return err
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Automation Dsc Node Configuration '%s' (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAutomationDscNodeConfigurationRead(d, meta)
+}
+
+func resourceArmAutomationDscNodeConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationDscNodeConfigurationClient
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	accName := id.Path["automationAccounts"]
+	name := id.Path["nodeConfigurations"]
+
+	resp, err := client.Get(resGroup, accName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on AzureRM Automation Dsc Node Configuration '%s': %+v", name, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("account_name", accName)
+
+	if configuration := resp.Configuration; configuration != nil {
+		d.Set("configuration_name", configuration.Name)
+	}
+
+	// the content of a Node Configuration isn't returned from the API, so we use the value from the state
+
+	return nil
+}
+
+func resourceArmAutomationDscNodeConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationDscNodeConfigurationClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	accName := id.Path["automationAccounts"]
+	name := id.Path["nodeConfigurations"]
+
+	resp, err := client.Delete(resGroup, accName, name)
+
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error issuing AzureRM delete request for Automation Dsc Node Configuration '%s': %+v", name, err)
+	}
+
+	return nil
+}
+
+func validateAutomationDscNodeConfigurationName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	segments := strings.Split(value, ".")
+	if len(segments) != 2 || segments[0] == "" || segments[1] == "" {
+		errors = append(errors, fmt.Errorf("%q must be in the format `{configurationName}.{nodeName}`: %q", k, value))
+	}
+
+	return
+}
@@ -0,0 +1,176 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestValidateAutomationDscNodeConfigurationName(t *testing.T) {
+	validNames := []string{
+		"acctest.localhost",
+		"webserver.node1",
+	}
+	for _, v := range validNames {
+		_, errors := validateAutomationDscNodeConfigurationName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Automation Dsc Node Configuration Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"acctest",
+		"acctest.",
+		".localhost",
+		"acctest.local.host",
+	}
+	for _, v := range invalidNames {
+		_, errors := validateAutomationDscNodeConfigurationName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Automation Dsc Node Configuration Name", v)
+		}
+	}
+}
+
+func TestAccAzureRMAutomationDscNodeConfiguration_basic(t *testing.T) {
+	resourceName := "azurerm_automation_dsc_nodeconfiguration.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMAutomationDscNodeConfiguration_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationDscNodeConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationDscNodeConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "configuration_name", "acctest"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAutomationDscNodeConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).automationDscNodeConfigurationClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_automation_dsc_nodeconfiguration" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		accName := rs.Primary.Attributes["account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(resourceGroup, accName, name)
+
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Automation Dsc Node Configuration still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMAutomationDscNodeConfigurationExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		accName := rs.Primary.Attributes["account_name"]
+
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Automation Dsc Node Configuration: '%s'", name)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).automationDscNodeConfigurationClient
+
+		resp, err := conn.Get(resourceGroup, accName, name)
+
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Automation Dsc Node Configuration '%s' (resource group: '%s') does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on automationDscNodeConfigurationClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMAutomationDscNodeConfiguration_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+ name = "acctestRG-%d"
+ location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku {
+	name = "Basic"
+  }
+}
+
+resource "azurerm_automation_dsc_configuration" "test" {
+  name                = "acctest"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  account_name        = "${azurerm_automation_account.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  content_embedded    = "configuration acctest {}"
+}
+
+resource "azurerm_automation_dsc_nodeconfiguration" "test" {
+  name                = "acctest.localhost"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  account_name        = "${azurerm_automation_account.test.name}"
+  depends_on          = ["azurerm_automation_dsc_configuration.test"]
+
+  content_embedded = <<mofcontent
+instance of MSFT_FileDirectoryConfiguration as $MSFT_FileDirectoryConfiguration1ref
+{
+  ResourceID = "[File]bla";
+  Ensure = "Present";
+  Contents = "bogus Content";
+  DestinationPath = "c:\\bogus.txt";
+  ModuleName = "PSDesiredStateConfiguration";
+  SourceInfo = "::3::9::file";
+  ModuleVersion = "1.0";
+  ConfigurationName = "bla";
+};
+instance of OMI_ConfigurationDocument
+{
+  Version="2.0.0";
+  MinimumCompatibleVersion = "1.0.0";
+  CompatibleVersionAdditionalProperties= {"Omi_BaseResource:ConfigurationName"};
+  Author="bogusAuthor";
+  GenerationDate="06/15/2018 14:06:24";
+  GenerationHost="bogusComputer";
+  Name="acctest";
+};
+mofcontent
+}
+`, rInt, location, rInt)
+}
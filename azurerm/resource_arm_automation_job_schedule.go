@@ -0,0 +1,218 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/automation"
+	"github.com/hashicorp/terraform/helper/schema"
+	uuid "github.com/satori/go.uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAutomationJobSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAutomationJobScheduleCreate,
+		Read:   resourceArmAutomationJobScheduleRead,
+		Delete: resourceArmAutomationJobScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("automationAccounts", "jobSchedules"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"schedule_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"runbook_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"parameters": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAutomationJobScheduleParameters,
+			},
+
+			"run_on": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"job_schedule_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateUUID,
+			},
+		},
+	}
+}
+
+func resourceArmAutomationJobScheduleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationJobScheduleClient
+	log.Printf("[INFO] preparing arguments for AzureRM Automation Job Schedule creation.")
+
+	resGroup := d.Get("resource_group_name").(string)
+	accName := d.Get("account_name").(string)
+	scheduleName := d.Get("schedule_name").(string)
+	runbookName := d.Get("runbook_name").(string)
+
+	jobScheduleId := uuid.NewV4()
+	if v, ok := d.GetOk("job_schedule_id"); ok {
+		id, err := uuid.FromString(v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing `job_schedule_id` %q: %+v", v.(string), err)
+		}
+		jobScheduleId = id
+	}
+
+	parameters := make(map[string]*string, 0)
+	for k, v := range d.Get("parameters").(map[string]interface{}) {
+		parameters[k] = utils.String(v.(string))
+	}
+
+	properties := automation.JobScheduleCreateParameters{
+		JobScheduleCreateProperties: &automation.JobScheduleCreateProperties{
+			Schedule: &automation.ScheduleAssociationProperty{
+				Name: &scheduleName,
+			},
+			Runbook: &automation.RunbookAssociationProperty{
+				Name: &runbookName,
+			},
+			Parameters: &parameters,
+		},
+	}
+
+	if v, ok := d.GetOk("run_on"); ok {
+		properties.JobScheduleCreateProperties.RunOn = utils.String(v.(string))
+	}
+
+	_, err := client.Create(resGroup, accName, jobScheduleId, properties)
+	if err != nil {
+		return err
+	}
+
+	read, err := client.Get(resGroup, accName, jobScheduleId)
+	if err != nil {
+		return err
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Automation Job Schedule '%s' (resource group %s) ID", jobScheduleId.String(), resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAutomationJobScheduleRead(d, meta)
+}
+
+func resourceArmAutomationJobScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationJobScheduleClient
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	accName := id.Path["automationAccounts"]
+
+	jobScheduleId, err := uuid.FromString(id.Path["jobSchedules"])
+	if err != nil {
+		return fmt.Errorf("Error parsing Automation Job Schedule ID %q: %+v", id.Path["jobSchedules"], err)
+	}
+
+	resp, err := client.Get(resGroup, accName, jobScheduleId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on AzureRM Automation Job Schedule '%s': %+v", jobScheduleId.String(), err)
+	}
+
+	d.Set("job_schedule_id", jobScheduleId.String())
+	d.Set("resource_group_name", resGroup)
+	d.Set("account_name", accName)
+
+	if props := resp.JobScheduleProperties; props != nil {
+		if schedule := props.Schedule; schedule != nil {
+			d.Set("schedule_name", schedule.Name)
+		}
+
+		if runbook := props.Runbook; runbook != nil {
+			d.Set("runbook_name", runbook.Name)
+		}
+
+		d.Set("run_on", props.RunOn)
+
+		parameters := make(map[string]interface{}, 0)
+		if v := props.Parameters; v != nil {
+			for key, value := range *v {
+				if value != nil {
+					parameters[key] = *value
+				}
+			}
+		}
+		d.Set("parameters", parameters)
+	}
+
+	return nil
+}
+
+func resourceArmAutomationJobScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationJobScheduleClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	accName := id.Path["automationAccounts"]
+
+	jobScheduleId, err := uuid.FromString(id.Path["jobSchedules"])
+	if err != nil {
+		return fmt.Errorf("Error parsing Automation Job Schedule ID %q: %+v", id.Path["jobSchedules"], err)
+	}
+
+	resp, err := client.Delete(resGroup, accName, jobScheduleId)
+
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error issuing AzureRM delete request for Automation Job Schedule '%s': %+v", jobScheduleId.String(), err)
+	}
+
+	return nil
+}
+
+// the API returns the names of Parameters in lower-case, so we require them to be specified that way to avoid a diff
+func validateAutomationJobScheduleParameters(v interface{}, k string) (ws []string, errors []error) {
+	parameters := v.(map[string]interface{})
+
+	for name := range parameters {
+		if name != strings.ToLower(name) {
+			errors = append(errors, fmt.Errorf("The name of the Parameter %q in %q must be lower-case", name, k))
+		}
+	}
+
+	return
+}
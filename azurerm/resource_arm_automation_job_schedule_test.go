@@ -0,0 +1,198 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	uuid "github.com/satori/go.uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMAutomationJobSchedule_basic(t *testing.T) {
+	resourceName := "azurerm_automation_job_schedule.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMAutomationJobSchedule_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationJobScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationJobScheduleExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "job_schedule_id"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAutomationJobSchedule_parameters(t *testing.T) {
+	resourceName := "azurerm_automation_job_schedule.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMAutomationJobSchedule_parameters(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationJobScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationJobScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.output", "Hello World"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAutomationJobScheduleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).automationJobScheduleClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_automation_job_schedule" {
+			continue
+		}
+
+		accName := rs.Primary.Attributes["account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		jobScheduleId, err := uuid.FromString(rs.Primary.Attributes["job_schedule_id"])
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.Get(resourceGroup, accName, jobScheduleId)
+
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Automation Job Schedule still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMAutomationJobScheduleExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		accName := rs.Primary.Attributes["account_name"]
+
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Automation Job Schedule: '%s'", name)
+		}
+
+		jobScheduleId, err := uuid.FromString(rs.Primary.Attributes["job_schedule_id"])
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).automationJobScheduleClient
+
+		resp, err := conn.Get(resourceGroup, accName, jobScheduleId)
+
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Automation Job Schedule '%s' (resource group: '%s') does not exist", jobScheduleId.String(), resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on automationJobScheduleClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMAutomationJobSchedule_template(rInt int, location string) string {
+	startTime := time.Now().UTC().Add(time.Duration(7) * time.Minute)
+	startTime = startTime.Add(time.Duration(-1*startTime.Second()) * time.Second)
+
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+ name = "acctestRG-%d"
+ location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku {
+	name = "Basic"
+  }
+}
+
+resource "azurerm_automation_runbook" "test" {
+  name                = "Output-HelloWorld"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  account_name        = "${azurerm_automation_account.test.name}"
+  log_verbose         = "true"
+  log_progress        = "true"
+  runbook_type        = "PowerShell"
+  content             = "param([string]$Output = 'Hello World') Write-Output $Output"
+}
+
+resource "azurerm_automation_schedule" "test" {
+  name                = "OneTimer-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  account_name        = "${azurerm_automation_account.test.name}"
+  frequency           = "OneTime"
+  timezone            = "Central Europe Standard Time"
+  start_time          = "%s"
+}
+`, rInt, location, rInt, rInt, startTime.Format(time.RFC3339))
+}
+
+func testAccAzureRMAutomationJobSchedule_basic(rInt int, location string) string {
+	template := testAccAzureRMAutomationJobSchedule_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_job_schedule" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  account_name        = "${azurerm_automation_account.test.name}"
+  schedule_name       = "${azurerm_automation_schedule.test.name}"
+  runbook_name        = "${azurerm_automation_runbook.test.name}"
+}
+`, template)
+}
+
+func testAccAzureRMAutomationJobSchedule_parameters(rInt int, location string) string {
+	template := testAccAzureRMAutomationJobSchedule_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_job_schedule" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  account_name        = "${azurerm_automation_account.test.name}"
+  schedule_name       = "${azurerm_automation_schedule.test.name}"
+  runbook_name        = "${azurerm_automation_runbook.test.name}"
+
+  parameters {
+    output = "Hello World"
+  }
+}
+`, template)
+}
@@ -2,7 +2,9 @@ package azurerm
 
 import (
 	"fmt"
+	"io/ioutil"
 	"log"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/arm/automation"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -17,7 +19,7 @@ func resourceArmAutomationRunbook() *schema.Resource {
 		Update: resourceArmAutomationRunbookCreateUpdate,
 		Delete: resourceArmAutomationRunbookDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("automationAccounts", "runbooks"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -48,6 +50,7 @@ func resourceArmAutomationRunbook() *schema.Resource {
 					string(automation.GraphPowerShellWorkflow),
 					string(automation.PowerShell),
 					string(automation.PowerShellWorkflow),
+					"Python2",
 					string(automation.Script),
 				}, true),
 			},
@@ -67,9 +70,16 @@ func resourceArmAutomationRunbook() *schema.Resource {
 				Optional: true,
 			},
 
+			"content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"publish_content_link"},
+			},
+
 			"publish_content_link": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -123,19 +133,27 @@ func resourceArmAutomationRunbookCreateUpdate(d *schema.ResourceData, meta inter
 	logVerbose := d.Get("log_verbose").(bool)
 	description := d.Get("description").(string)
 
-	contentLink := expandContentLink(d)
-
 	parameters := automation.RunbookCreateOrUpdateParameters{
 		RunbookCreateOrUpdateProperties: &automation.RunbookCreateOrUpdateProperties{
-			LogVerbose:         &logVerbose,
-			LogProgress:        &logProgress,
-			RunbookType:        runbookType,
-			Description:        &description,
-			PublishContentLink: &contentLink,
+			LogVerbose:  &logVerbose,
+			LogProgress: &logProgress,
+			RunbookType: runbookType,
+			Description: &description,
 		},
 
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandResourceTags(d, tags, meta),
+	}
+
+	content := d.Get("content").(string)
+	if _, ok := d.GetOk("publish_content_link"); ok {
+		contentLink := expandContentLink(d)
+		parameters.RunbookCreateOrUpdateProperties.PublishContentLink = &contentLink
+	} else if content != "" {
+		// the content is uploaded as a Draft below, which is then published
+		parameters.RunbookCreateOrUpdateProperties.Draft = &automation.RunbookDraft{}
+	} else {
+		return fmt.Errorf("One of `content` or `publish_content_link` must be specified for Automation Runbook '%s'", name)
 	}
 
 	_, err := client.CreateOrUpdate(resGroup, accName, name, parameters)
@@ -143,6 +161,24 @@ func resourceArmAutomationRunbookCreateUpdate(d *schema.ResourceData, meta inter
 		return err
 	}
 
+	if parameters.RunbookCreateOrUpdateProperties.Draft != nil {
+		draftClient := meta.(*ArmClient).automationRunbookDraftClient
+
+		cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+		defer release()
+		_, errChan := draftClient.CreateOrUpdate(resGroup, accName, name, ioutil.NopCloser(strings.NewReader(content)), cancel)
+		if err := <-errChan; err != nil {
+			return fmt.Errorf("Error uploading the Draft content of AzureRM Automation Runbook '%s': %+v", name, err)
+		}
+
+		cancel, release = cancelAfter(meta, createUpdateTimeout(d))
+		defer release()
+		_, errChan = draftClient.Publish(resGroup, accName, name, cancel)
+		if err := <-errChan; err != nil {
+			return fmt.Errorf("Error publishing the Draft of AzureRM Automation Runbook '%s': %+v", name, err)
+		}
+	}
+
 	read, err := client.Get(resGroup, accName, name)
 	if err != nil {
 		return err
@@ -189,7 +225,24 @@ func resourceArmAutomationRunbookRead(d *schema.ResourceData, meta interface{})
 		d.Set("description", props.Description)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	contentResp, err := client.GetContent(resGroup, accName, name)
+	if err != nil {
+		// a Runbook which hasn't been published has no content
+		if !utils.ResponseWasNotFound(contentResp.Response) {
+			return fmt.Errorf("Error retrieving the content of AzureRM Automation Runbook '%s': %+v", name, err)
+		}
+	} else if v := contentResp.Value; v != nil && *v != nil {
+		body := *v
+		defer body.Close()
+
+		content, err := ioutil.ReadAll(body)
+		if err != nil {
+			return fmt.Errorf("Error reading the content of AzureRM Automation Runbook '%s': %+v", name, err)
+		}
+		d.Set("content", string(content))
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
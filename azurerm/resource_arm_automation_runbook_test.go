@@ -52,6 +52,28 @@ func TestAccAzureRMAutomationRunbook_PSWorkflowWithHash(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMAutomationRunbook_PSWorkflowWithContent(t *testing.T) {
+	resourceName := "azurerm_automation_runbook.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMAutomationRunbook_PSWorkflowWithContent(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationRunbookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationRunbookExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "runbook_type", "PowerShellWorkflow"),
+					resource.TestCheckResourceAttr(resourceName, "content", "workflow Get-AzureVMTutorial { Write-Output 'Hello World' }"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMAutomationRunbookDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*ArmClient).automationRunbookClient
 
@@ -183,3 +205,34 @@ resource "azurerm_automation_runbook" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMAutomationRunbook_PSWorkflowWithContent(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+ name = "acctestRG-%d"
+ location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku {
+	name = "Basic"
+  }
+}
+
+resource "azurerm_automation_runbook" "test" {
+  name                = "Get-AzureVMTutorial"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  account_name        = "${azurerm_automation_account.test.name}"
+  log_verbose         = "true"
+  log_progress        = "true"
+  description         = "This is a test runbook for terraform acceptance test"
+  runbook_type        = "PowerShellWorkflow"
+  content             = "workflow Get-AzureVMTutorial { Write-Output 'Hello World' }"
+}
+`, rInt, location, rInt)
+}
@@ -19,7 +19,7 @@ func resourceArmAutomationSchedule() *schema.Resource {
 		Update: resourceArmAutomationScheduleCreateUpdate,
 		Delete: resourceArmAutomationScheduleDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("automationAccounts", "schedules"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -119,13 +119,7 @@ func resourceArmAutomationScheduleCreateUpdate(d *schema.ResourceData, meta inte
 		return fmt.Errorf("Cannot parse start_time: %q", cst)
 	}
 
-	expirytime, teperr := time.Parse(time.RFC3339, cst)
-	if teperr != nil {
-		return fmt.Errorf("Cannot parse expiry_time: %q", cst)
-	}
-
 	stdt := date.Time{Time: starttime}
-	etdt := date.Time{Time: expirytime}
 
 	description := d.Get("description").(string)
 	timezone := d.Get("timezone").(string)
@@ -136,11 +130,21 @@ func resourceArmAutomationScheduleCreateUpdate(d *schema.ResourceData, meta inte
 			Description: &description,
 			Frequency:   freq,
 			StartTime:   &stdt,
-			ExpiryTime:  &etdt,
 			TimeZone:    &timezone,
 		},
 	}
 
+	if v, ok := d.GetOk("expiry_time"); ok {
+		cet := v.(string)
+		expirytime, teperr := time.Parse(time.RFC3339, cet)
+		if teperr != nil {
+			return fmt.Errorf("Cannot parse expiry_time: %q", cet)
+		}
+
+		etdt := date.Time{Time: expirytime}
+		parameters.ScheduleCreateOrUpdateProperties.ExpiryTime = &etdt
+	}
+
 	_, err := client.CreateOrUpdate(resGroup, accName, name, parameters)
 	if err != nil {
 		return err
@@ -183,11 +187,19 @@ func resourceArmAutomationScheduleRead(d *schema.ResourceData, meta interface{})
 	d.Set("name", resp.Name)
 	d.Set("resource_group_name", resGroup)
 	d.Set("account_name", accName)
-	d.Set("frequency", resp.Frequency)
-	d.Set("description", resp.Description)
-	d.Set("start_time", string(resp.StartTime.Format(time.RFC3339)))
-	d.Set("expiry_time", string(resp.ExpiryTime.Format(time.RFC3339)))
-	d.Set("timezone", resp.TimeZone)
+
+	if props := resp.ScheduleProperties; props != nil {
+		d.Set("frequency", string(props.Frequency))
+		d.Set("description", props.Description)
+		if props.StartTime != nil {
+			d.Set("start_time", string(props.StartTime.Format(time.RFC3339)))
+		}
+		if props.ExpiryTime != nil {
+			d.Set("expiry_time", string(props.ExpiryTime.Format(time.RFC3339)))
+		}
+		d.Set("timezone", props.TimeZone)
+	}
+
 	return nil
 }
 
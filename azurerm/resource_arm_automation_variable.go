@@ -0,0 +1,172 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/automation"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAutomationVariable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAutomationVariableCreateUpdate,
+		Read:   resourceArmAutomationVariableRead,
+		Update: resourceArmAutomationVariableCreateUpdate,
+		Delete: resourceArmAutomationVariableDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("automationAccounts", "variables"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"value": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmAutomationVariableCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationVariableClient
+	log.Printf("[INFO] preparing arguments for AzureRM Automation Variable creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	accName := d.Get("account_name").(string)
+	encrypted := d.Get("encrypted").(bool)
+	description := d.Get("description").(string)
+
+	// the API stores Variables as serialized JSON, so String Variables need to be quoted
+	value, err := json.Marshal(d.Get("value").(string))
+	if err != nil {
+		return fmt.Errorf("Error serializing the value of Automation Variable '%s': %+v", name, err)
+	}
+
+	parameters := automation.VariableCreateOrUpdateParameters{
+		Name: &name,
+		VariableCreateOrUpdateProperties: &automation.VariableCreateOrUpdateProperties{
+			Value:       utils.String(string(value)),
+			Description: &description,
+			IsEncrypted: &encrypted,
+		},
+	}
+
+	_, err = client.CreateOrUpdate(resGroup, accName, name, parameters)
+	if err != nil {
+		return err
+	}
+
+	read, err := client.Get(resGroup, accName, name)
+	if err != nil {
+		return err
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Automation Variable '%s' (resource group %s) ID", name, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAutomationVariableRead(d, meta)
+}
+
+func resourceArmAutomationVariableRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationVariableClient
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	accName := id.Path["automationAccounts"]
+	name := id.Path["variables"]
+
+	resp, err := client.Get(resGroup, accName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on AzureRM Automation Variable '%s': %+v", name, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("account_name", accName)
+
+	if props := resp.VariableProperties; props != nil {
+		d.Set("description", props.Description)
+
+		encrypted := false
+		if v := props.IsEncrypted; v != nil {
+			encrypted = *v
+		}
+		d.Set("encrypted", encrypted)
+
+		// the values of Encrypted Variables aren't returned from the API
+		if v := props.Value; v != nil && !encrypted {
+			var value string
+			if err := json.Unmarshal([]byte(*v), &value); err != nil {
+				// this isn't a String Variable - so we expose the serialized value
+				value = *v
+			}
+			d.Set("value", value)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmAutomationVariableDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automationVariableClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	accName := id.Path["automationAccounts"]
+	name := id.Path["variables"]
+
+	resp, err := client.Delete(resGroup, accName, name)
+
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error issuing AzureRM delete request for Automation Variable '%s': %+v", name, err)
+	}
+
+	return nil
+}
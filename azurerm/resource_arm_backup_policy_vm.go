@@ -0,0 +1,636 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/recoveryservicesbackup"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmBackupProtectionPolicyVM() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmBackupProtectionPolicyVMCreateUpdate,
+		Read:   resourceArmBackupProtectionPolicyVMRead,
+		Update: resourceArmBackupProtectionPolicyVMCreateUpdate,
+		Delete: resourceArmBackupProtectionPolicyVMDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("vaults", "backupPolicies"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateBackupPolicyName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"recovery_vault_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRecoveryServicesVaultName,
+			},
+
+			"timezone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "UTC",
+			},
+
+			"backup": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"frequency": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(recoveryservicesbackup.ScheduleRunTypeDaily),
+								string(recoveryservicesbackup.ScheduleRunTypeWeekly),
+							}, false),
+						},
+
+						"time": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateBackupPolicyTime,
+						},
+
+						"weekdays": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateBackupPolicyWeekday(),
+							},
+						},
+					},
+				},
+			},
+
+			"retention_daily": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 9999),
+						},
+					},
+				},
+			},
+
+			"retention_weekly": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 9999),
+						},
+
+						"weekdays": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateBackupPolicyWeekday(),
+							},
+						},
+					},
+				},
+			},
+
+			"retention_monthly": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 9999),
+						},
+
+						"weeks": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateBackupPolicyWeekOfMonth(),
+							},
+						},
+
+						"weekdays": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateBackupPolicyWeekday(),
+							},
+						},
+					},
+				},
+			},
+
+			"retention_yearly": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 99),
+						},
+
+						"months": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateBackupPolicyMonth(),
+							},
+						},
+
+						"weeks": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateBackupPolicyWeekOfMonth(),
+							},
+						},
+
+						"weekdays": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateBackupPolicyWeekday(),
+							},
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmBackupProtectionPolicyVMCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesProtectionPoliciesClient
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	log.Printf("[DEBUG] Creating/updating Backup Policy %q (Recovery Services Vault %q / Resource Group %q)", name, vaultName, resourceGroup)
+
+	// the Retention Times must match the time of the Backup, so these are parsed once and shared
+	backups := d.Get("backup").([]interface{})
+	backup := backups[0].(map[string]interface{})
+	backupTime, err := time.Parse(time.RFC3339, fmt.Sprintf("2018-07-30T%s:00Z", backup["time"].(string)))
+	if err != nil {
+		return fmt.Errorf("Error parsing `backup.0.time`: %+v", err)
+	}
+	times := []date.Time{{Time: backupTime}}
+
+	schedulePolicy, err := expandBackupProtectionPolicyVMSchedule(backup, times)
+	if err != nil {
+		return err
+	}
+
+	retentionPolicy, err := expandBackupProtectionPolicyVMRetention(d, schedulePolicy.ScheduleRunFrequency, times)
+	if err != nil {
+		return err
+	}
+
+	policy := recoveryservicesbackup.ProtectionPolicyResource{
+		Tags: expandResourceTags(d, tags, meta),
+		Properties: &recoveryservicesbackup.AzureIaaSVMProtectionPolicy{
+			TimeZone:        utils.String(d.Get("timezone").(string)),
+			SchedulePolicy:  schedulePolicy,
+			RetentionPolicy: retentionPolicy,
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(vaultName, resourceGroup, name, policy); err != nil {
+		return fmt.Errorf("Error creating/updating Backup Policy %q (Recovery Services Vault %q / Resource Group %q): %+v", name, vaultName, resourceGroup, err)
+	}
+
+	read, err := client.Get(vaultName, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Backup Policy %q (Recovery Services Vault %q / Resource Group %q): %+v", name, vaultName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Backup Policy %q (Recovery Services Vault %q / Resource Group %q) ID", name, vaultName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmBackupProtectionPolicyVMRead(d, meta)
+}
+
+func resourceArmBackupProtectionPolicyVMRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesProtectionPoliciesClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	name := id.Path["backupPolicies"]
+
+	resp, err := client.Get(vaultName, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Backup Policy %q was not found in Recovery Services Vault %q (Resource Group %q) - removing from state!", name, vaultName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Backup Policy %q (Recovery Services Vault %q / Resource Group %q): %+v", name, vaultName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("recovery_vault_name", vaultName)
+
+	if resp.Properties != nil {
+		properties, ok := resp.Properties.AsAzureIaaSVMProtectionPolicy()
+		if !ok {
+			return fmt.Errorf("Backup Policy %q (Recovery Services Vault %q / Resource Group %q) is not an Azure IaaS VM Policy", name, vaultName, resourceGroup)
+		}
+
+		d.Set("timezone", properties.TimeZone)
+
+		if properties.SchedulePolicy != nil {
+			if schedule, ok := properties.SchedulePolicy.AsSimpleSchedulePolicy(); ok {
+				if err := d.Set("backup", flattenBackupProtectionPolicyVMSchedule(schedule)); err != nil {
+					return fmt.Errorf("Error setting `backup`: %+v", err)
+				}
+			}
+		}
+
+		if properties.RetentionPolicy != nil {
+			if retention, ok := properties.RetentionPolicy.AsLongTermRetentionPolicy(); ok {
+				if err := flattenBackupProtectionPolicyVMRetention(d, retention); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmBackupProtectionPolicyVMDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesProtectionPoliciesClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	name := id.Path["backupPolicies"]
+
+	resp, err := client.Delete(vaultName, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Backup Policy %q (Recovery Services Vault %q / Resource Group %q): %+v", name, vaultName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandBackupProtectionPolicyVMSchedule(backup map[string]interface{}, times []date.Time) (*recoveryservicesbackup.SimpleSchedulePolicy, error) {
+	frequency := recoveryservicesbackup.ScheduleRunType(backup["frequency"].(string))
+	weekdays := expandBackupProtectionPolicyVMWeekdays(backup["weekdays"].(*schema.Set))
+
+	schedule := recoveryservicesbackup.SimpleSchedulePolicy{
+		ScheduleRunFrequency: frequency,
+		ScheduleRunTimes:     &times,
+	}
+
+	switch frequency {
+	case recoveryservicesbackup.ScheduleRunTypeDaily:
+		if len(weekdays) > 0 {
+			return nil, fmt.Errorf("`backup.0.weekdays` can only be specified when `backup.0.frequency` is %q", string(recoveryservicesbackup.ScheduleRunTypeWeekly))
+		}
+	case recoveryservicesbackup.ScheduleRunTypeWeekly:
+		if len(weekdays) == 0 {
+			return nil, fmt.Errorf("`backup.0.weekdays` must be specified when `backup.0.frequency` is %q", string(frequency))
+		}
+		schedule.ScheduleRunDays = &weekdays
+	}
+
+	return &schedule, nil
+}
+
+func expandBackupProtectionPolicyVMRetention(d *schema.ResourceData, frequency recoveryservicesbackup.ScheduleRunType, times []date.Time) (*recoveryservicesbackup.LongTermRetentionPolicy, error) {
+	retention := recoveryservicesbackup.LongTermRetentionPolicy{}
+
+	if v, ok := d.GetOk("retention_daily"); ok {
+		if frequency != recoveryservicesbackup.ScheduleRunTypeDaily {
+			return nil, fmt.Errorf("`retention_daily` can only be specified when `backup.0.frequency` is %q", string(recoveryservicesbackup.ScheduleRunTypeDaily))
+		}
+
+		block := v.([]interface{})[0].(map[string]interface{})
+		retention.DailySchedule = &recoveryservicesbackup.DailyRetentionSchedule{
+			RetentionTimes: &times,
+			RetentionDuration: &recoveryservicesbackup.RetentionDuration{
+				Count:        utils.Int32(int32(block["count"].(int))),
+				DurationType: recoveryservicesbackup.RetentionDurationTypeDays,
+			},
+		}
+	} else if frequency == recoveryservicesbackup.ScheduleRunTypeDaily {
+		return nil, fmt.Errorf("`retention_daily` must be specified when `backup.0.frequency` is %q", string(frequency))
+	}
+
+	if v, ok := d.GetOk("retention_weekly"); ok {
+		block := v.([]interface{})[0].(map[string]interface{})
+		weekdays := expandBackupProtectionPolicyVMWeekdays(block["weekdays"].(*schema.Set))
+
+		retention.WeeklySchedule = &recoveryservicesbackup.WeeklyRetentionSchedule{
+			DaysOfTheWeek:  &weekdays,
+			RetentionTimes: &times,
+			RetentionDuration: &recoveryservicesbackup.RetentionDuration{
+				Count:        utils.Int32(int32(block["count"].(int))),
+				DurationType: recoveryservicesbackup.RetentionDurationTypeWeeks,
+			},
+		}
+	} else if frequency == recoveryservicesbackup.ScheduleRunTypeWeekly {
+		return nil, fmt.Errorf("`retention_weekly` must be specified when `backup.0.frequency` is %q", string(frequency))
+	}
+
+	if v, ok := d.GetOk("retention_monthly"); ok {
+		block := v.([]interface{})[0].(map[string]interface{})
+		weekdays := expandBackupProtectionPolicyVMWeekdays(block["weekdays"].(*schema.Set))
+		weeks := expandBackupProtectionPolicyVMWeeks(block["weeks"].(*schema.Set))
+
+		retention.MonthlySchedule = &recoveryservicesbackup.MonthlyRetentionSchedule{
+			RetentionScheduleFormatType: recoveryservicesbackup.RetentionScheduleFormatWeekly,
+			RetentionScheduleWeekly: &recoveryservicesbackup.WeeklyRetentionFormat{
+				DaysOfTheWeek:   &weekdays,
+				WeeksOfTheMonth: &weeks,
+			},
+			RetentionTimes: &times,
+			RetentionDuration: &recoveryservicesbackup.RetentionDuration{
+				Count:        utils.Int32(int32(block["count"].(int))),
+				DurationType: recoveryservicesbackup.RetentionDurationTypeMonths,
+			},
+		}
+	}
+
+	if v, ok := d.GetOk("retention_yearly"); ok {
+		block := v.([]interface{})[0].(map[string]interface{})
+		weekdays := expandBackupProtectionPolicyVMWeekdays(block["weekdays"].(*schema.Set))
+		weeks := expandBackupProtectionPolicyVMWeeks(block["weeks"].(*schema.Set))
+		months := expandBackupProtectionPolicyVMMonths(block["months"].(*schema.Set))
+
+		retention.YearlySchedule = &recoveryservicesbackup.YearlyRetentionSchedule{
+			RetentionScheduleFormatType: recoveryservicesbackup.RetentionScheduleFormatWeekly,
+			MonthsOfYear:                &months,
+			RetentionScheduleWeekly: &recoveryservicesbackup.WeeklyRetentionFormat{
+				DaysOfTheWeek:   &weekdays,
+				WeeksOfTheMonth: &weeks,
+			},
+			RetentionTimes: &times,
+			RetentionDuration: &recoveryservicesbackup.RetentionDuration{
+				Count:        utils.Int32(int32(block["count"].(int))),
+				DurationType: recoveryservicesbackup.RetentionDurationTypeYears,
+			},
+		}
+	}
+
+	return &retention, nil
+}
+
+func expandBackupProtectionPolicyVMWeekdays(input *schema.Set) []recoveryservicesbackup.DayOfWeek {
+	days := make([]recoveryservicesbackup.DayOfWeek, 0)
+	for _, v := range input.List() {
+		days = append(days, recoveryservicesbackup.DayOfWeek(v.(string)))
+	}
+	return days
+}
+
+func expandBackupProtectionPolicyVMWeeks(input *schema.Set) []recoveryservicesbackup.WeekOfMonth {
+	weeks := make([]recoveryservicesbackup.WeekOfMonth, 0)
+	for _, v := range input.List() {
+		weeks = append(weeks, recoveryservicesbackup.WeekOfMonth(v.(string)))
+	}
+	return weeks
+}
+
+func expandBackupProtectionPolicyVMMonths(input *schema.Set) []recoveryservicesbackup.MonthOfYear {
+	months := make([]recoveryservicesbackup.MonthOfYear, 0)
+	for _, v := range input.List() {
+		months = append(months, recoveryservicesbackup.MonthOfYear(v.(string)))
+	}
+	return months
+}
+
+func flattenBackupProtectionPolicyVMSchedule(schedule *recoveryservicesbackup.SimpleSchedulePolicy) []interface{} {
+	block := map[string]interface{}{
+		"frequency": string(schedule.ScheduleRunFrequency),
+	}
+
+	if times := schedule.ScheduleRunTimes; times != nil && len(*times) > 0 {
+		block["time"] = (*times)[0].Format("15:04")
+	}
+
+	weekdays := schema.NewSet(schema.HashString, []interface{}{})
+	if days := schedule.ScheduleRunDays; days != nil {
+		for _, d := range *days {
+			weekdays.Add(string(d))
+		}
+	}
+	block["weekdays"] = weekdays
+
+	return []interface{}{block}
+}
+
+func flattenBackupProtectionPolicyVMRetention(d *schema.ResourceData, retention *recoveryservicesbackup.LongTermRetentionPolicy) error {
+	daily := make([]interface{}, 0)
+	if schedule := retention.DailySchedule; schedule != nil {
+		block := map[string]interface{}{}
+		if duration := schedule.RetentionDuration; duration != nil && duration.Count != nil {
+			block["count"] = int(*duration.Count)
+		}
+		daily = append(daily, block)
+	}
+	if err := d.Set("retention_daily", daily); err != nil {
+		return fmt.Errorf("Error setting `retention_daily`: %+v", err)
+	}
+
+	weekly := make([]interface{}, 0)
+	if schedule := retention.WeeklySchedule; schedule != nil {
+		block := map[string]interface{}{
+			"weekdays": flattenBackupProtectionPolicyVMWeekdays(schedule.DaysOfTheWeek),
+		}
+		if duration := schedule.RetentionDuration; duration != nil && duration.Count != nil {
+			block["count"] = int(*duration.Count)
+		}
+		weekly = append(weekly, block)
+	}
+	if err := d.Set("retention_weekly", weekly); err != nil {
+		return fmt.Errorf("Error setting `retention_weekly`: %+v", err)
+	}
+
+	monthly := make([]interface{}, 0)
+	if schedule := retention.MonthlySchedule; schedule != nil {
+		block := map[string]interface{}{}
+		if duration := schedule.RetentionDuration; duration != nil && duration.Count != nil {
+			block["count"] = int(*duration.Count)
+		}
+		if format := schedule.RetentionScheduleWeekly; format != nil {
+			block["weekdays"] = flattenBackupProtectionPolicyVMWeekdays(format.DaysOfTheWeek)
+			block["weeks"] = flattenBackupProtectionPolicyVMWeeks(format.WeeksOfTheMonth)
+		}
+		monthly = append(monthly, block)
+	}
+	if err := d.Set("retention_monthly", monthly); err != nil {
+		return fmt.Errorf("Error setting `retention_monthly`: %+v", err)
+	}
+
+	yearly := make([]interface{}, 0)
+	if schedule := retention.YearlySchedule; schedule != nil {
+		block := map[string]interface{}{}
+		if duration := schedule.RetentionDuration; duration != nil && duration.Count != nil {
+			block["count"] = int(*duration.Count)
+		}
+		if format := schedule.RetentionScheduleWeekly; format != nil {
+			block["weekdays"] = flattenBackupProtectionPolicyVMWeekdays(format.DaysOfTheWeek)
+			block["weeks"] = flattenBackupProtectionPolicyVMWeeks(format.WeeksOfTheMonth)
+		}
+
+		months := schema.NewSet(schema.HashString, []interface{}{})
+		if v := schedule.MonthsOfYear; v != nil {
+			for _, m := range *v {
+				months.Add(string(m))
+			}
+		}
+		block["months"] = months
+
+		yearly = append(yearly, block)
+	}
+	if err := d.Set("retention_yearly", yearly); err != nil {
+		return fmt.Errorf("Error setting `retention_yearly`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenBackupProtectionPolicyVMWeekdays(input *[]recoveryservicesbackup.DayOfWeek) *schema.Set {
+	days := schema.NewSet(schema.HashString, []interface{}{})
+	if input != nil {
+		for _, d := range *input {
+			days.Add(string(d))
+		}
+	}
+	return days
+}
+
+func flattenBackupProtectionPolicyVMWeeks(input *[]recoveryservicesbackup.WeekOfMonth) *schema.Set {
+	weeks := schema.NewSet(schema.HashString, []interface{}{})
+	if input != nil {
+		for _, w := range *input {
+			weeks.Add(string(w))
+		}
+	}
+	return weeks
+}
+
+func validateBackupPolicyName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[a-zA-Z][-_!a-zA-Z0-9]{2,149}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must start with a letter, may only contain letters, numbers, hyphens, underscores and exclamation marks and must be between 3-150 chars: %q", k, value))
+	}
+
+	return
+}
+
+func validateBackupPolicyTime(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be a time in the format `HH:MM`: %q", k, value))
+	}
+
+	return
+}
+
+func validateBackupPolicyWeekday() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		string(recoveryservicesbackup.Monday),
+		string(recoveryservicesbackup.Tuesday),
+		string(recoveryservicesbackup.Wednesday),
+		string(recoveryservicesbackup.Thursday),
+		string(recoveryservicesbackup.Friday),
+		string(recoveryservicesbackup.Saturday),
+		string(recoveryservicesbackup.Sunday),
+	}, false)
+}
+
+func validateBackupPolicyWeekOfMonth() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		string(recoveryservicesbackup.First),
+		string(recoveryservicesbackup.Second),
+		string(recoveryservicesbackup.Third),
+		string(recoveryservicesbackup.Fourth),
+		string(recoveryservicesbackup.Last),
+	}, false)
+}
+
+func validateBackupPolicyMonth() schema.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		string(recoveryservicesbackup.MonthOfYearJanuary),
+		string(recoveryservicesbackup.MonthOfYearFebruary),
+		string(recoveryservicesbackup.MonthOfYearMarch),
+		string(recoveryservicesbackup.MonthOfYearApril),
+		string(recoveryservicesbackup.MonthOfYearMay),
+		string(recoveryservicesbackup.MonthOfYearJune),
+		string(recoveryservicesbackup.MonthOfYearJuly),
+		string(recoveryservicesbackup.MonthOfYearAugust),
+		string(recoveryservicesbackup.MonthOfYearSeptember),
+		string(recoveryservicesbackup.MonthOfYearOctober),
+		string(recoveryservicesbackup.MonthOfYearNovember),
+		string(recoveryservicesbackup.MonthOfYearDecember),
+	}, false)
+}
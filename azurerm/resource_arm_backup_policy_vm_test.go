@@ -0,0 +1,271 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestValidateBackupPolicyName(t *testing.T) {
+	validNames := []string{
+		"abc",
+		"policy1",
+		"backup-policy_1!",
+	}
+	for _, v := range validNames {
+		_, errors := validateBackupPolicyName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Backup Policy Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"ab",
+		"1policy",
+		"-policy",
+		"policy.1",
+		"policy 1",
+	}
+	for _, v := range invalidNames {
+		_, errors := validateBackupPolicyName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Backup Policy Name", v)
+		}
+	}
+}
+
+func TestValidateBackupPolicyTime(t *testing.T) {
+	validTimes := []string{
+		"00:00",
+		"09:30",
+		"23:59",
+	}
+	for _, v := range validTimes {
+		_, errors := validateBackupPolicyTime(v, "time")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Backup Policy Time: %q", v, errors)
+		}
+	}
+
+	invalidTimes := []string{
+		"",
+		"9:30",
+		"24:00",
+		"12:60",
+		"12:00:00",
+	}
+	for _, v := range invalidTimes {
+		_, errors := validateBackupPolicyTime(v, "time")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Backup Policy Time", v)
+		}
+	}
+}
+
+func TestAccAzureRMBackupProtectionPolicyVM_basicDaily(t *testing.T) {
+	resourceName := "azurerm_backup_policy_vm.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMBackupProtectionPolicyVM_basicDaily(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBackupProtectionPolicyVMDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupProtectionPolicyVMExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "timezone", "UTC"),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.frequency", "Daily"),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.time", "23:00"),
+					resource.TestCheckResourceAttr(resourceName, "retention_daily.0.count", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMBackupProtectionPolicyVM_completeWeekly(t *testing.T) {
+	resourceName := "azurerm_backup_policy_vm.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMBackupProtectionPolicyVM_completeWeekly(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBackupProtectionPolicyVMDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupProtectionPolicyVMExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.frequency", "Weekly"),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.weekdays.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "retention_weekly.0.count", "42"),
+					resource.TestCheckResourceAttr(resourceName, "retention_weekly.0.weekdays.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "retention_monthly.0.count", "7"),
+					resource.TestCheckResourceAttr(resourceName, "retention_monthly.0.weeks.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "retention_monthly.0.weekdays.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "retention_yearly.0.count", "77"),
+					resource.TestCheckResourceAttr(resourceName, "retention_yearly.0.months.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMBackupProtectionPolicyVM_updateDailyToWeekly(t *testing.T) {
+	resourceName := "azurerm_backup_policy_vm.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBackupProtectionPolicyVMDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMBackupProtectionPolicyVM_basicDaily(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupProtectionPolicyVMExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.frequency", "Daily"),
+					resource.TestCheckResourceAttr(resourceName, "retention_daily.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMBackupProtectionPolicyVM_completeWeekly(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupProtectionPolicyVMExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.frequency", "Weekly"),
+					resource.TestCheckResourceAttr(resourceName, "retention_daily.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "retention_weekly.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMBackupProtectionPolicyVMExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		policyName := rs.Primary.Attributes["name"]
+		vaultName := rs.Primary.Attributes["recovery_vault_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Backup Policy: %q", policyName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).recoveryServicesProtectionPoliciesClient
+		resp, err := client.Get(vaultName, resourceGroup, policyName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Backup Policy %q (Recovery Services Vault %q / Resource Group %q) does not exist", policyName, vaultName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on recoveryServicesProtectionPoliciesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMBackupProtectionPolicyVMDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).recoveryServicesProtectionPoliciesClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_backup_policy_vm" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		vaultName := rs.Primary.Attributes["recovery_vault_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(vaultName, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Backup Policy still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMBackupProtectionPolicyVM_basicDaily(rInt int, location string) string {
+	template := testAccAzureRMRecoveryServicesVault_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_policy_vm" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+
+  backup {
+    frequency = "Daily"
+    time      = "23:00"
+  }
+
+  retention_daily {
+    count = 10
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMBackupProtectionPolicyVM_completeWeekly(rInt int, location string) string {
+	template := testAccAzureRMRecoveryServicesVault_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_policy_vm" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+
+  backup {
+    frequency = "Weekly"
+    time      = "23:00"
+    weekdays  = ["Sunday", "Wednesday"]
+  }
+
+  retention_weekly {
+    count    = 42
+    weekdays = ["Sunday", "Wednesday"]
+  }
+
+  retention_monthly {
+    count    = 7
+    weekdays = ["Sunday", "Wednesday"]
+    weeks    = ["First", "Last"]
+  }
+
+  retention_yearly {
+    count    = 77
+    weekdays = ["Sunday", "Wednesday"]
+    weeks    = ["First", "Last"]
+    months   = ["January", "July"]
+  }
+
+  tags {
+    env = "test"
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,210 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/recoveryservicesbackup"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmBackupProtectedVM() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmBackupProtectedVMCreateUpdate,
+		Read:   resourceArmBackupProtectedVMRead,
+		Update: resourceArmBackupProtectedVMCreateUpdate,
+		Delete: resourceArmBackupProtectedVMDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("vaults", "protectionContainers", "protectedItems"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"recovery_vault_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRecoveryServicesVaultName,
+			},
+
+			"source_vm_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"backup_policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmBackupProtectedVMCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesProtectedItemsClient
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+	vmId := d.Get("source_vm_id").(string)
+	policyId := d.Get("backup_policy_id").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	parsedVmId, err := parseAzureResourceID(vmId)
+	if err != nil {
+		return fmt.Errorf("Error parsing `source_vm_id` %q: %+v", vmId, err)
+	}
+	vmName, ok := parsedVmId.Path["virtualMachines"]
+	if !ok {
+		return fmt.Errorf("Error parsing `source_vm_id` %q: no Virtual Machine name was found", vmId)
+	}
+
+	// the Container and Protected Item names for Azure VM's are derived from the Resource Group and Name of the VM
+	containerName := fmt.Sprintf("iaasvmcontainer;iaasvmcontainerv2;%s;%s", parsedVmId.ResourceGroup, vmName)
+	protectedItemName := fmt.Sprintf("vm;iaasvmcontainerv2;%s;%s", parsedVmId.ResourceGroup, vmName)
+
+	log.Printf("[DEBUG] Creating/updating Backup Protected VM %q (Recovery Services Vault %q / Resource Group %q)", protectedItemName, vaultName, resourceGroup)
+
+	item := recoveryservicesbackup.ProtectedItemResource{
+		Tags: expandResourceTags(d, tags, meta),
+		Properties: &recoveryservicesbackup.AzureIaaSComputeVMProtectedItem{
+			PolicyID:         &policyId,
+			SourceResourceID: &vmId,
+			WorkloadType:     recoveryservicesbackup.DataSourceTypeVM,
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(vaultName, resourceGroup, backupFabricName, containerName, protectedItemName, item); err != nil {
+		return fmt.Errorf("Error creating/updating Backup Protected VM %q (Recovery Services Vault %q / Resource Group %q): %+v", protectedItemName, vaultName, resourceGroup, err)
+	}
+
+	// the Protected Item is created asynchronously, so we need to wait for it to become available
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"NotFound"},
+		Target:  []string{"Found"},
+		Refresh: backupProtectedItemStateRefreshFunc(client, vaultName, resourceGroup, containerName, protectedItemName),
+		Timeout: createUpdateTimeout(d),
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Backup Protected VM %q (Recovery Services Vault %q / Resource Group %q) to become available: %+v", protectedItemName, vaultName, resourceGroup, err)
+	}
+
+	read, err := client.Get(vaultName, resourceGroup, backupFabricName, containerName, protectedItemName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Backup Protected VM %q (Recovery Services Vault %q / Resource Group %q): %+v", protectedItemName, vaultName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Backup Protected VM %q (Recovery Services Vault %q / Resource Group %q) ID", protectedItemName, vaultName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmBackupProtectedVMRead(d, meta)
+}
+
+func resourceArmBackupProtectedVMRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesProtectedItemsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	containerName := id.Path["protectionContainers"]
+	protectedItemName := id.Path["protectedItems"]
+
+	resp, err := client.Get(vaultName, resourceGroup, backupFabricName, containerName, protectedItemName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Backup Protected VM %q was not found in Recovery Services Vault %q (Resource Group %q) - removing from state!", protectedItemName, vaultName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Backup Protected VM %q (Recovery Services Vault %q / Resource Group %q): %+v", protectedItemName, vaultName, resourceGroup, err)
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("recovery_vault_name", vaultName)
+
+	if resp.Properties != nil {
+		if properties, ok := resp.Properties.AsAzureIaaSComputeVMProtectedItem(); ok {
+			d.Set("source_vm_id", properties.SourceResourceID)
+
+			// the ID of the Policy isn't always returned in the same casing as it was sent
+			policyId := ""
+			if v := properties.PolicyID; v != nil {
+				policyId = *v
+			}
+			if !strings.EqualFold(policyId, d.Get("backup_policy_id").(string)) {
+				d.Set("backup_policy_id", policyId)
+			}
+		}
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmBackupProtectedVMDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesProtectedItemsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	containerName := id.Path["protectionContainers"]
+	protectedItemName := id.Path["protectedItems"]
+
+	resp, err := client.Delete(vaultName, resourceGroup, backupFabricName, containerName, protectedItemName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Backup Protected VM %q (Recovery Services Vault %q / Resource Group %q): %+v", protectedItemName, vaultName, resourceGroup, err)
+	}
+
+	// the Protected Item is deleted asynchronously, so we need to wait for it to be gone
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Found"},
+		Target:  []string{"NotFound"},
+		Refresh: backupProtectedItemStateRefreshFunc(client, vaultName, resourceGroup, containerName, protectedItemName),
+		Timeout: d.Timeout(schema.TimeoutDelete),
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Backup Protected VM %q (Recovery Services Vault %q / Resource Group %q) to be deleted: %+v", protectedItemName, vaultName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// Azure VM's are always registered in the `Azure` Backup Fabric
+const backupFabricName = "Azure"
+
+func backupProtectedItemStateRefreshFunc(client recoveryservicesbackup.ProtectedItemsClient, vaultName, resourceGroup, containerName, protectedItemName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(vaultName, resourceGroup, backupFabricName, containerName, protectedItemName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return resp, "NotFound", nil
+			}
+
+			return nil, "", fmt.Errorf("Error retrieving Backup Protected Item %q (Recovery Services Vault %q / Resource Group %q): %+v", protectedItemName, vaultName, resourceGroup, err)
+		}
+
+		return resp, "Found", nil
+	}
+}
@@ -0,0 +1,303 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/arm/batch"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmBatchAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmBatchAccountCreate,
+		Read:   resourceArmBatchAccountRead,
+		Update: resourceArmBatchAccountUpdate,
+		Delete: resourceArmBatchAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("batchAccounts"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateBatchAccountName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"pool_allocation_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(batch.BatchService),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(batch.BatchService),
+					string(batch.UserSubscription),
+				}, false),
+			},
+
+			"storage_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"key_vault_reference": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"account_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmBatchAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).batchAccountClient
+
+	log.Printf("[INFO] preparing arguments for Azure Batch Account creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	poolAllocationMode := d.Get("pool_allocation_mode").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	keyVaultReference := expandBatchAccountKeyVaultReference(d)
+	if poolAllocationMode == string(batch.UserSubscription) && keyVaultReference == nil {
+		return fmt.Errorf("`key_vault_reference` must be specified when `pool_allocation_mode` is %q", poolAllocationMode)
+	}
+
+	parameters := batch.AccountCreateParameters{
+		Location: &location,
+		AccountCreateProperties: &batch.AccountCreateProperties{
+			PoolAllocationMode: batch.PoolAllocationMode(poolAllocationMode),
+			KeyVaultReference:  keyVaultReference,
+		},
+		Tags: expandResourceTags(d, tags, meta),
+	}
+
+	if v, ok := d.GetOk("storage_account_id"); ok {
+		parameters.AccountCreateProperties.AutoStorage = &batch.AutoStorageBaseProperties{
+			StorageAccountID: utils.String(v.(string)),
+		}
+	}
+
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.Create(resourceGroup, name, parameters, cancel)
+	err := <-createErr
+	if err != nil {
+		return fmt.Errorf("Error creating Batch Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Batch Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Batch Account %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmBatchAccountRead(d, meta)
+}
+
+func resourceArmBatchAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).batchAccountClient
+
+	log.Printf("[INFO] preparing arguments for Azure Batch Account update.")
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["batchAccounts"]
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := batch.AccountUpdateParameters{
+		AccountUpdateProperties: &batch.AccountUpdateProperties{
+			AutoStorage: &batch.AutoStorageBaseProperties{
+				StorageAccountID: utils.String(d.Get("storage_account_id").(string)),
+			},
+		},
+		Tags: expandResourceTags(d, tags, meta),
+	}
+
+	if _, err := client.Update(resourceGroup, name, parameters); err != nil {
+		return fmt.Errorf("Error updating Batch Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return resourceArmBatchAccountRead(d, meta)
+}
+
+func resourceArmBatchAccountRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).batchAccountClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["batchAccounts"]
+
+	resp, err := client.Get(resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Batch Account %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading the state of Batch Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.AccountProperties; props != nil {
+		d.Set("account_endpoint", props.AccountEndpoint)
+		d.Set("pool_allocation_mode", string(props.PoolAllocationMode))
+
+		storageAccountId := ""
+		if autoStorage := props.AutoStorage; autoStorage != nil && autoStorage.StorageAccountID != nil {
+			storageAccountId = *autoStorage.StorageAccountID
+		}
+		d.Set("storage_account_id", storageAccountId)
+
+		if err := d.Set("key_vault_reference", flattenBatchAccountKeyVaultReference(props.KeyVaultReference)); err != nil {
+			return fmt.Errorf("Error setting `key_vault_reference`: %+v", err)
+		}
+	}
+
+	// the Access Keys are only available when Pools are allocated by the Batch Service
+	if d.Get("pool_allocation_mode").(string) == string(batch.BatchService) {
+		keys, err := client.GetKeys(resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving the Access Keys for Batch Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		d.Set("primary_access_key", keys.Primary)
+		d.Set("secondary_access_key", keys.Secondary)
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmBatchAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).batchAccountClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["batchAccounts"]
+
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := client.Delete(resourceGroup, name, cancel)
+	resp := <-deleteResp
+	err = <-deleteErr
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Batch Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandBatchAccountKeyVaultReference(d *schema.ResourceData) *batch.KeyVaultReference {
+	vs := d.Get("key_vault_reference").([]interface{})
+	if len(vs) == 0 {
+		return nil
+	}
+
+	v := vs[0].(map[string]interface{})
+	id := v["id"].(string)
+	url := v["url"].(string)
+
+	return &batch.KeyVaultReference{
+		ID:  &id,
+		URL: &url,
+	}
+}
+
+func flattenBatchAccountKeyVaultReference(input *batch.KeyVaultReference) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{}, 0)
+
+	if id := input.ID; id != nil {
+		output["id"] = *id
+	}
+
+	if url := input.URL; url != nil {
+		output["url"] = *url
+	}
+
+	return []interface{}{output}
+}
+
+func validateBatchAccountName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[a-z0-9]{3,24}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q may only contain lowercase letters and numbers and must be between 3-24 chars: %q", k, value))
+	}
+
+	return
+}
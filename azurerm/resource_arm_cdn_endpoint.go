@@ -20,7 +20,7 @@ func resourceArmCdnEndpoint() *schema.Resource {
 		Update: resourceArmCdnEndpointUpdate,
 		Delete: resourceArmCdnEndpointDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("endpoints"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -184,10 +184,12 @@ func resourceArmCdnEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 	cdnEndpoint := cdn.Endpoint{
 		Location:           &location,
 		EndpointProperties: &properties,
-		Tags:               expandTags(tags),
+		Tags:               expandResourceTags(d, tags, meta),
 	}
 
-	_, error := cdnEndpointsClient.Create(resGroup, profileName, name, cdnEndpoint, make(<-chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, error := cdnEndpointsClient.Create(resGroup, profileName, name, cdnEndpoint, cancel)
 	err := <-error
 	if err != nil {
 		return err
@@ -249,7 +251,7 @@ func resourceArmCdnEndpointRead(d *schema.ResourceData, meta interface{}) error
 	}
 	d.Set("origin", flattenAzureRMCdnEndpointOrigin(resp.EndpointProperties.Origins))
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -295,11 +297,13 @@ func resourceArmCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	updateProps := cdn.EndpointUpdateParameters{
-		Tags: expandTags(newTags),
+		Tags: expandResourceTags(d, newTags, meta),
 		EndpointPropertiesUpdateParameters: &properties,
 	}
 
-	_, error := cdnEndpointsClient.Update(resGroup, profileName, name, updateProps, make(<-chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, error := cdnEndpointsClient.Update(resGroup, profileName, name, updateProps, cancel)
 	err := <-error
 	if err != nil {
 		return fmt.Errorf("Error issuing Azure ARM update request to update CDN Endpoint %q: %s", name, err)
@@ -322,7 +326,9 @@ func resourceArmCdnEndpointDelete(d *schema.ResourceData, meta interface{}) erro
 	}
 	name := id.Path["endpoints"]
 
-	accResp, error := client.Delete(resGroup, profileName, name, make(<-chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	accResp, error := client.Delete(resGroup, profileName, name, cancel)
 	resp := <-accResp
 	err = <-error
 	if err != nil {
@@ -0,0 +1,275 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/cdn"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmCdnEndpointCustomDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmCdnEndpointCustomDomainCreate,
+		Read:   resourceArmCdnEndpointCustomDomainRead,
+		Update: resourceArmCdnEndpointCustomDomainUpdate,
+		Delete: resourceArmCdnEndpointCustomDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("profiles", "endpoints"),
+		},
+
+		// validating the domain and issuing the CDN-managed certificate can take several hours
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(12 * time.Hour),
+			Update: schema.DefaultTimeout(12 * time.Hour),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"profile_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"endpoint_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"host_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"cdn_managed_https_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceArmCdnEndpointCustomDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cdnCustomDomainsClient
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	profileName := d.Get("profile_name").(string)
+	endpointName := d.Get("endpoint_name").(string)
+	hostName := d.Get("host_name").(string)
+
+	log.Printf("[DEBUG] Creating CDN Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q)", name, endpointName, profileName, resourceGroup)
+
+	parameters := cdn.CustomDomainParameters{
+		CustomDomainPropertiesParameters: &cdn.CustomDomainPropertiesParameters{
+			HostName: utils.String(hostName),
+		},
+	}
+
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.Create(resourceGroup, profileName, endpointName, name, parameters, cancel)
+	err := <-createErr
+	if err != nil {
+		return fmt.Errorf("Error creating CDN Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	read, err := client.Get(resourceGroup, profileName, endpointName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving CDN Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read CDN Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q) ID", name, endpointName, profileName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	if d.Get("cdn_managed_https_enabled").(bool) {
+		if err := enableArmCdnEndpointCustomDomainHTTPS(client, resourceGroup, profileName, endpointName, name, createUpdateTimeout(d)); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmCdnEndpointCustomDomainRead(d, meta)
+}
+
+func resourceArmCdnEndpointCustomDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cdnCustomDomainsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	profileName := id.Path["profiles"]
+	endpointName := id.Path["endpoints"]
+	name := cdnEndpointCustomDomainNameFromID(id)
+
+	if d.HasChange("cdn_managed_https_enabled") {
+		if d.Get("cdn_managed_https_enabled").(bool) {
+			if err := enableArmCdnEndpointCustomDomainHTTPS(client, resourceGroup, profileName, endpointName, name, createUpdateTimeout(d)); err != nil {
+				return err
+			}
+		} else {
+			if err := disableArmCdnEndpointCustomDomainHTTPS(client, resourceGroup, profileName, endpointName, name, createUpdateTimeout(d)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceArmCdnEndpointCustomDomainRead(d, meta)
+}
+
+func resourceArmCdnEndpointCustomDomainRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cdnCustomDomainsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	profileName := id.Path["profiles"]
+	endpointName := id.Path["endpoints"]
+	name := cdnEndpointCustomDomainNameFromID(id)
+
+	resp, err := client.Get(resourceGroup, profileName, endpointName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] CDN Custom Domain %q was not found in Endpoint %q (Profile %q / Resource Group %q) - removing from state!", name, endpointName, profileName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on CDN Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("profile_name", profileName)
+	d.Set("endpoint_name", endpointName)
+
+	if props := resp.CustomDomainProperties; props != nil {
+		d.Set("host_name", props.HostName)
+
+		httpsEnabled := props.CustomHTTPSProvisioningState == cdn.Enabled || props.CustomHTTPSProvisioningState == cdn.Enabling
+		d.Set("cdn_managed_https_enabled", httpsEnabled)
+	}
+
+	return nil
+}
+
+func resourceArmCdnEndpointCustomDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cdnCustomDomainsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	profileName := id.Path["profiles"]
+	endpointName := id.Path["endpoints"]
+	name := cdnEndpointCustomDomainNameFromID(id)
+
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := client.Delete(resourceGroup, profileName, endpointName, name, cancel)
+	resp := <-deleteResp
+	err = <-deleteErr
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting CDN Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func enableArmCdnEndpointCustomDomainHTTPS(client cdn.CustomDomainsClient, resourceGroup, profileName, endpointName, name string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Enabling HTTPS for CDN Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q)", name, endpointName, profileName, resourceGroup)
+
+	if _, err := client.EnableCustomHTTPS(resourceGroup, profileName, endpointName, name); err != nil {
+		return fmt.Errorf("Error enabling HTTPS for CDN Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	// validating the domain and issuing the CDN-managed certificate can take several hours
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{string(cdn.Disabled), string(cdn.Enabling)},
+		Target:     []string{string(cdn.Enabled)},
+		Refresh:    cdnEndpointCustomDomainHTTPSStateRefreshFunc(client, resourceGroup, profileName, endpointName, name),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for HTTPS to be enabled for CDN Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func disableArmCdnEndpointCustomDomainHTTPS(client cdn.CustomDomainsClient, resourceGroup, profileName, endpointName, name string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Disabling HTTPS for CDN Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q)", name, endpointName, profileName, resourceGroup)
+
+	if _, err := client.DisableCustomHTTPS(resourceGroup, profileName, endpointName, name); err != nil {
+		return fmt.Errorf("Error disabling HTTPS for CDN Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{string(cdn.Enabled), string(cdn.Disabling)},
+		Target:     []string{string(cdn.Disabled)},
+		Refresh:    cdnEndpointCustomDomainHTTPSStateRefreshFunc(client, resourceGroup, profileName, endpointName, name),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for HTTPS to be disabled for CDN Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func cdnEndpointCustomDomainHTTPSStateRefreshFunc(client cdn.CustomDomainsClient, resourceGroup, profileName, endpointName, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(resourceGroup, profileName, endpointName, name)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error retrieving CDN Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+		}
+
+		props := resp.CustomDomainProperties
+		if props == nil {
+			return nil, "", fmt.Errorf("Error retrieving CDN Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): `properties` was nil", name, endpointName, profileName, resourceGroup)
+		}
+
+		if props.CustomHTTPSProvisioningState == cdn.Failed {
+			return resp, string(props.CustomHTTPSProvisioningState), fmt.Errorf("Provisioning HTTPS for CDN Custom Domain %q failed (substate %q)", name, string(props.CustomHTTPSProvisioningSubstate))
+		}
+
+		return resp, string(props.CustomHTTPSProvisioningState), nil
+	}
+}
+
+// the API returns the ID of a Custom Domain using `customdomains` rather than `customDomains`
+func cdnEndpointCustomDomainNameFromID(id *ResourceID) string {
+	if name, ok := id.Path["customDomains"]; ok {
+		return name
+	}
+
+	return id.Path["customdomains"]
+}
@@ -0,0 +1,188 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Custom Domains must resolve to the CDN Endpoint via a CNAME record before they can be added,
+// as such these tests require an existing DNS Zone which is delegated to Azure DNS
+func testAccAzureRMCdnEndpointCustomDomainPreCheck(t *testing.T) (string, string) {
+	zoneName := os.Getenv("ARM_TEST_DNS_ZONE_NAME")
+	zoneResourceGroup := os.Getenv("ARM_TEST_DNS_ZONE_RESOURCE_GROUP_NAME")
+	if zoneName == "" || zoneResourceGroup == "" {
+		t.Skip("Skipping as ARM_TEST_DNS_ZONE_NAME and/or ARM_TEST_DNS_ZONE_RESOURCE_GROUP_NAME are not specified")
+	}
+
+	return zoneName, zoneResourceGroup
+}
+
+func TestAccAzureRMCdnEndpointCustomDomain_basic(t *testing.T) {
+	zoneName, zoneResourceGroup := testAccAzureRMCdnEndpointCustomDomainPreCheck(t)
+	resourceName := "azurerm_cdn_endpoint_custom_domain.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMCdnEndpointCustomDomain_basic(ri, testLocation(), zoneName, zoneResourceGroup, false)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCdnEndpointCustomDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointCustomDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "host_name", fmt.Sprintf("acctest%d.%s", ri, zoneName)),
+					resource.TestCheckResourceAttr(resourceName, "cdn_managed_https_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCdnEndpointCustomDomain_cdnManagedHttps(t *testing.T) {
+	zoneName, zoneResourceGroup := testAccAzureRMCdnEndpointCustomDomainPreCheck(t)
+	resourceName := "azurerm_cdn_endpoint_custom_domain.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCdnEndpointCustomDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCdnEndpointCustomDomain_basic(ri, location, zoneName, zoneResourceGroup, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointCustomDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cdn_managed_https_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMCdnEndpointCustomDomain_basic(ri, location, zoneName, zoneResourceGroup, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointCustomDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cdn_managed_https_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAzureRMCdnEndpointCustomDomain_basic(ri, location, zoneName, zoneResourceGroup, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointCustomDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cdn_managed_https_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMCdnEndpointCustomDomainExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		customDomainName := rs.Primary.Attributes["name"]
+		profileName := rs.Primary.Attributes["profile_name"]
+		endpointName := rs.Primary.Attributes["endpoint_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for CDN Custom Domain: %q", customDomainName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).cdnCustomDomainsClient
+		resp, err := client.Get(resourceGroup, profileName, endpointName, customDomainName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: CDN Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q) does not exist", customDomainName, endpointName, profileName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on cdnCustomDomainsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMCdnEndpointCustomDomainDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).cdnCustomDomainsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_cdn_endpoint_custom_domain" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		profileName := rs.Primary.Attributes["profile_name"]
+		endpointName := rs.Primary.Attributes["endpoint_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(resourceGroup, profileName, endpointName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("CDN Custom Domain still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMCdnEndpointCustomDomain_basic(rInt int, location, zoneName, zoneResourceGroup string, httpsEnabled bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_cdn_profile" "test" {
+  name                = "acctestcdnprof%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard_Verizon"
+}
+
+resource "azurerm_cdn_endpoint" "test" {
+  name                = "acctestcdnend%d"
+  profile_name        = "${azurerm_cdn_profile.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  origin {
+    name       = "acceptanceTestCdnOrigin1"
+    host_name  = "www.example.com"
+    https_port = 443
+    http_port  = 80
+  }
+}
+
+resource "azurerm_dns_cname_record" "test" {
+  name                = "acctest%d"
+  zone_name           = "%s"
+  resource_group_name = "%s"
+  ttl                 = 300
+  record              = "${azurerm_cdn_endpoint.test.host_name}"
+}
+
+resource "azurerm_cdn_endpoint_custom_domain" "test" {
+  name                      = "acctestcustomdomain%d"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  profile_name              = "${azurerm_cdn_profile.test.name}"
+  endpoint_name             = "${azurerm_cdn_endpoint.test.name}"
+  host_name                 = "${azurerm_dns_cname_record.test.name}.%s"
+  cdn_managed_https_enabled = %t
+}
+`, rInt, location, rInt, rInt, rInt, zoneName, zoneResourceGroup, rInt, zoneName, httpsEnabled)
+}
@@ -17,7 +17,7 @@ func resourceArmCdnProfile() *schema.Resource {
 		Update: resourceArmCdnProfileUpdate,
 		Delete: resourceArmCdnProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("profiles"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -58,13 +58,15 @@ func resourceArmCdnProfileCreate(d *schema.ResourceData, meta interface{}) error
 
 	cdnProfile := cdn.Profile{
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandResourceTags(d, tags, meta),
 		Sku: &cdn.Sku{
 			Name: cdn.SkuName(sku),
 		},
 	}
 
-	_, error := cdnProfilesClient.Create(resGroup, name, cdnProfile, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, error := cdnProfilesClient.Create(resGroup, name, cdnProfile, cancel)
 	err := <-error
 	if err != nil {
 		return err
@@ -110,7 +112,7 @@ func resourceArmCdnProfileRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("sku", string(resp.Sku.Name))
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -118,7 +120,7 @@ func resourceArmCdnProfileRead(d *schema.ResourceData, meta interface{}) error {
 func resourceArmCdnProfileUpdate(d *schema.ResourceData, meta interface{}) error {
 	cdnProfilesClient := meta.(*ArmClient).cdnProfilesClient
 
-	if !d.HasChange("tags") {
+	if !d.HasChange("tags") && !d.HasChange("default_tags_applied") {
 		return nil
 	}
 
@@ -127,10 +129,12 @@ func resourceArmCdnProfileUpdate(d *schema.ResourceData, meta interface{}) error
 	newTags := d.Get("tags").(map[string]interface{})
 
 	props := cdn.ProfileUpdateParameters{
-		Tags: expandTags(newTags),
+		Tags: expandResourceTags(d, newTags, meta),
 	}
 
-	_, error := cdnProfilesClient.Update(resGroup, name, props, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, error := cdnProfilesClient.Update(resGroup, name, props, cancel)
 	err := <-error
 	if err != nil {
 		return fmt.Errorf("Error issuing Azure ARM update request to update CDN Profile %q: %s", name, err)
@@ -149,7 +153,9 @@ func resourceArmCdnProfileDelete(d *schema.ResourceData, meta interface{}) error
 	resGroup := id.ResourceGroup
 	name := id.Path["profiles"]
 
-	_, error := cdnProfilesClient.Delete(resGroup, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	_, error := cdnProfilesClient.Delete(resGroup, name, cancel)
 	err = <-error
 	// TODO: check the status code
 
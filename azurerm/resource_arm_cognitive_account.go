@@ -0,0 +1,275 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/cognitiveservices"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmCognitiveAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmCognitiveAccountCreate,
+		Read:   resourceArmCognitiveAccountRead,
+		Update: resourceArmCognitiveAccountUpdate,
+		Delete: resourceArmCognitiveAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("accounts"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"kind": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(cognitiveservices.Academic),
+					string(cognitiveservices.BingAutosuggest),
+					"Bing.CustomSearch",
+					string(cognitiveservices.BingSearch),
+					string(cognitiveservices.BingSpeech),
+					string(cognitiveservices.BingSpellCheck),
+					string(cognitiveservices.ComputerVision),
+					string(cognitiveservices.ContentModerator),
+					string(cognitiveservices.CustomSpeech),
+					"CustomVision.Prediction",
+					"CustomVision.Training",
+					string(cognitiveservices.Emotion),
+					string(cognitiveservices.Face),
+					string(cognitiveservices.LUIS),
+					"QnAMaker",
+					string(cognitiveservices.Recommendations),
+					string(cognitiveservices.SpeakerRecognition),
+					string(cognitiveservices.Speech),
+					"SpeechServices",
+					string(cognitiveservices.SpeechTranslation),
+					string(cognitiveservices.TextAnalytics),
+					string(cognitiveservices.TextTranslation),
+					string(cognitiveservices.WebLM),
+				}, false),
+			},
+
+			"sku": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cognitiveservices.F0),
+								string(cognitiveservices.P0),
+								string(cognitiveservices.P1),
+								string(cognitiveservices.P2),
+								string(cognitiveservices.S0),
+								string(cognitiveservices.S1),
+								string(cognitiveservices.S2),
+								string(cognitiveservices.S3),
+								string(cognitiveservices.S4),
+								string(cognitiveservices.S5),
+								string(cognitiveservices.S6),
+							}, false),
+						},
+
+						"tier": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cognitiveservices.Free),
+								string(cognitiveservices.Standard),
+								string(cognitiveservices.Premium),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_access_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceArmCognitiveAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cognitiveAccountsClient
+
+	name := d.Get("name").(string)
+	location := d.Get("location").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	kind := d.Get("kind").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	log.Printf("[INFO] preparing arguments for Cognitive Services Account creation %q (Resource Group %q)", name, resourceGroup)
+
+	properties := cognitiveservices.AccountCreateParameters{
+		Kind:       cognitiveservices.Kind(kind),
+		Location:   utils.String(location),
+		Sku:        expandCognitiveAccountSku(d),
+		Properties: &map[string]interface{}{},
+		Tags:       expandResourceTags(d, tags, meta),
+	}
+
+	if _, err := client.Create(resourceGroup, name, properties); err != nil {
+		return fmt.Errorf("Error creating Cognitive Services Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.GetProperties(resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Cognitive Services Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Cognitive Services Account %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmCognitiveAccountRead(d, meta)
+}
+
+func resourceArmCognitiveAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cognitiveAccountsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["accounts"]
+	tags := d.Get("tags").(map[string]interface{})
+
+	properties := cognitiveservices.AccountUpdateParameters{
+		Sku:  expandCognitiveAccountSku(d),
+		Tags: expandResourceTags(d, tags, meta),
+	}
+
+	if _, err := client.Update(resourceGroup, name, properties); err != nil {
+		return fmt.Errorf("Error updating Cognitive Services Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return resourceArmCognitiveAccountRead(d, meta)
+}
+
+func resourceArmCognitiveAccountRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cognitiveAccountsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["accounts"]
+
+	resp, err := client.GetProperties(resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Cognitive Services Account %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Cognitive Services Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("kind", resp.Kind)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if err := d.Set("sku", flattenCognitiveAccountSku(resp.Sku)); err != nil {
+		return fmt.Errorf("Error setting `sku`: %+v", err)
+	}
+
+	if props := resp.AccountProperties; props != nil {
+		d.Set("endpoint", props.Endpoint)
+	}
+
+	keys, err := client.ListKeys(resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Keys for Cognitive Services Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("primary_access_key", keys.Key1)
+	d.Set("secondary_access_key", keys.Key2)
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmCognitiveAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cognitiveAccountsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["accounts"]
+
+	resp, err := client.Delete(resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Cognitive Services Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandCognitiveAccountSku(d *schema.ResourceData) *cognitiveservices.Sku {
+	skus := d.Get("sku").([]interface{})
+	sku := skus[0].(map[string]interface{})
+
+	return &cognitiveservices.Sku{
+		Name: cognitiveservices.SkuName(sku["name"].(string)),
+		Tier: cognitiveservices.SkuTier(sku["tier"].(string)),
+	}
+}
+
+func flattenCognitiveAccountSku(input *cognitiveservices.Sku) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name": string(input.Name),
+			"tier": string(input.Tier),
+		},
+	}
+}
@@ -15,6 +15,9 @@ func resourceArmContainerGroup() *schema.Resource {
 		Create: resourceArmContainerGroupCreate,
 		Read:   resourceArmContainerGroupRead,
 		Delete: resourceArmContainerGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("containerGroups"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -188,7 +191,7 @@ func resourceArmContainerGroupCreate(d *schema.ResourceData, meta interface{}) e
 	containerGroup := containerinstance.ContainerGroup{
 		Name:     &name,
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandResourceTags(d, tags, meta),
 		ContainerGroupProperties: &containerinstance.ContainerGroupProperties{
 			Containers: containers,
 			IPAddress: &containerinstance.IPAddress{
@@ -244,17 +247,22 @@ func resourceArmContainerGroupRead(d *schema.ResourceData, meta interface{}) err
 
 	d.Set("name", name)
 	d.Set("resource_group_name", resGroup)
-	d.Set("location", azureRMNormalizeLocation(*resp.Location))
-	flattenAndSetTags(d, resp.Tags)
-
-	d.Set("os_type", string(resp.OsType))
-	if address := resp.IPAddress; address != nil {
-		d.Set("ip_address_type", address.Type)
-		d.Set("ip_address", address.IP)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
 	}
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	if props := resp.ContainerGroupProperties; props != nil {
-		containerConfigs := flattenContainerGroupContainers(d, resp.Containers, props.IPAddress.Ports, props.Volumes)
+		d.Set("os_type", string(props.OsType))
+
+		var containerGroupPorts *[]containerinstance.Port
+		if address := props.IPAddress; address != nil {
+			d.Set("ip_address_type", address.Type)
+			d.Set("ip_address", address.IP)
+			containerGroupPorts = address.Ports
+		}
+
+		containerConfigs := flattenContainerGroupContainers(d, resp.Containers, containerGroupPorts, props.Volumes)
 		err = d.Set("container", containerConfigs)
 		if err != nil {
 			return fmt.Errorf("Error setting `container`: %+v", err)
@@ -291,27 +299,40 @@ func resourceArmContainerGroupDelete(d *schema.ResourceData, meta interface{}) e
 
 func flattenContainerGroupContainers(d *schema.ResourceData, containers *[]containerinstance.Container, containerGroupPorts *[]containerinstance.Port, containerGroupVolumes *[]containerinstance.Volume) []interface{} {
 
-	containerConfigs := make([]interface{}, 0, len(*containers))
+	containerConfigs := make([]interface{}, 0)
+	if containers == nil {
+		return containerConfigs
+	}
+
 	for _, container := range *containers {
 		containerConfig := make(map[string]interface{})
-		containerConfig["name"] = *container.Name
-		containerConfig["image"] = *container.Image
+		if name := container.Name; name != nil {
+			containerConfig["name"] = *name
+		}
+
+		if image := container.Image; image != nil {
+			containerConfig["image"] = *image
+		}
 
 		if resources := container.Resources; resources != nil {
 			if resourceRequests := resources.Requests; resourceRequests != nil {
-				containerConfig["cpu"] = *resourceRequests.CPU
-				containerConfig["memory"] = *resourceRequests.MemoryInGB
+				if cpu := resourceRequests.CPU; cpu != nil {
+					containerConfig["cpu"] = *cpu
+				}
+				if memory := resourceRequests.MemoryInGB; memory != nil {
+					containerConfig["memory"] = *memory
+				}
 			}
 		}
 
-		if len(*container.Ports) > 0 {
+		if container.Ports != nil && len(*container.Ports) > 0 && (*container.Ports)[0].Port != nil {
 			containerPort := *(*container.Ports)[0].Port
 			containerConfig["port"] = containerPort
 			// protocol isn't returned in container config, have to search in container group ports
 			protocol := ""
 			if containerGroupPorts != nil {
 				for _, cgPort := range *containerGroupPorts {
-					if *cgPort.Port == containerPort {
+					if cgPort.Port != nil && *cgPort.Port == containerPort {
 						protocol = string(cgPort.Protocol)
 					}
 				}
@@ -338,7 +359,7 @@ func flattenContainerGroupContainers(d *schema.ResourceData, containers *[]conta
 			for _, containerConfigRaw := range containersConfigRaw {
 				data := containerConfigRaw.(map[string]interface{})
 				nameRaw := data["name"].(string)
-				if nameRaw == *container.Name {
+				if container.Name != nil && nameRaw == *container.Name {
 					// found container config for current container
 					// extract volume mounts from config
 					if v, ok := data["volume"]; ok {
@@ -360,10 +381,11 @@ func flattenContainerEnvironmentVariables(input *[]containerinstance.Environment
 	output := make(map[string]interface{})
 
 	for _, envVar := range *input {
-		k := *envVar.Name
-		v := *envVar.Value
+		if envVar.Name == nil || envVar.Value == nil {
+			continue
+		}
 
-		output[k] = v
+		output[*envVar.Name] = *envVar.Value
 	}
 
 	return output
@@ -373,9 +395,15 @@ func flattenContainerVolumes(volumeMounts *[]containerinstance.VolumeMount, cont
 	volumeConfigs := make([]interface{}, 0)
 
 	for _, vm := range *volumeMounts {
+		if vm.Name == nil {
+			continue
+		}
+
 		volumeConfig := make(map[string]interface{})
 		volumeConfig["name"] = *vm.Name
-		volumeConfig["mount_path"] = *vm.MountPath
+		if mountPath := vm.MountPath; mountPath != nil {
+			volumeConfig["mount_path"] = *mountPath
+		}
 		if vm.ReadOnly != nil {
 			volumeConfig["read_only"] = *vm.ReadOnly
 		}
@@ -383,10 +411,14 @@ func flattenContainerVolumes(volumeMounts *[]containerinstance.VolumeMount, cont
 		// find corresponding volume in container group volumes
 		// and use the data
 		for _, cgv := range *containerGroupVolumes {
-			if *cgv.Name == *vm.Name {
-				if cgv.AzureFile != nil {
-					volumeConfig["share_name"] = *(*cgv.AzureFile).ShareName
-					volumeConfig["storage_account_name"] = *(*cgv.AzureFile).StorageAccountName
+			if cgv.Name != nil && *cgv.Name == *vm.Name {
+				if file := cgv.AzureFile; file != nil {
+					if shareName := file.ShareName; shareName != nil {
+						volumeConfig["share_name"] = *shareName
+					}
+					if accountName := file.StorageAccountName; accountName != nil {
+						volumeConfig["storage_account_name"] = *accountName
+					}
 					// skip storage_account_key, is always nil
 				}
 			}
@@ -394,12 +426,14 @@ func flattenContainerVolumes(volumeMounts *[]containerinstance.VolumeMount, cont
 
 		// find corresponding volume in config
 		// and use the data
-		for _, cvr := range *containerVolumesConfig {
-			cv := cvr.(map[string]interface{})
-			rawName := cv["name"].(string)
-			if *vm.Name == rawName {
-				storageAccountKey := cv["storage_account_key"].(string)
-				volumeConfig["storage_account_key"] = storageAccountKey
+		if containerVolumesConfig != nil {
+			for _, cvr := range *containerVolumesConfig {
+				cv := cvr.(map[string]interface{})
+				rawName := cv["name"].(string)
+				if *vm.Name == rawName {
+					storageAccountKey := cv["storage_account_key"].(string)
+					volumeConfig["storage_account_key"] = storageAccountKey
+				}
 			}
 		}
 
@@ -6,6 +6,7 @@ import (
 	"regexp"
 
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/arm/containerregistry"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,7 +21,7 @@ func resourceArmContainerRegistry() *schema.Resource {
 		Update: resourceArmContainerRegistryUpdate,
 		Delete: resourceArmContainerRegistryDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("registries"),
 		},
 		MigrateState:  resourceAzureRMContainerRegistryMigrateState,
 		SchemaVersion: 2,
@@ -94,8 +95,18 @@ func resourceArmContainerRegistry() *schema.Resource {
 			},
 
 			"admin_password": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"georeplication_locations": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: azureRMHashLocation,
 			},
 
 			"tags": tagsSchema(),
@@ -123,7 +134,7 @@ func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}
 		RegistryProperties: &containerregistry.RegistryProperties{
 			AdminUserEnabled: utils.Bool(adminUserEnabled),
 		},
-		Tags: expandTags(tags),
+		Tags: expandResourceTags(d, tags, meta),
 	}
 
 	if v, ok := d.GetOk("storage_account_id"); ok {
@@ -140,7 +151,14 @@ func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	_, createErr := client.Create(resourceGroup, name, parameters, make(<-chan struct{}))
+	georeplicationLocations := d.Get("georeplication_locations").(*schema.Set)
+	if err := validateContainerRegistryGeoReplicationLocations(sku, location, georeplicationLocations); err != nil {
+		return err
+	}
+
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.Create(resourceGroup, name, parameters, cancel)
 	err := <-createErr
 	if err != nil {
 		return err
@@ -157,6 +175,10 @@ func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}
 
 	d.SetId(*read.ID)
 
+	if err := createContainerRegistryReplications(resourceGroup, name, georeplicationLocations.List(), meta, createUpdateTimeout(d)); err != nil {
+		return err
+	}
+
 	return resourceArmContainerRegistryRead(d, meta)
 }
 
@@ -175,7 +197,7 @@ func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}
 		RegistryPropertiesUpdateParameters: &containerregistry.RegistryPropertiesUpdateParameters{
 			AdminUserEnabled: utils.Bool(adminUserEnabled),
 		},
-		Tags: expandTags(tags),
+		Tags: expandResourceTags(d, tags, meta),
 	}
 
 	if v, ok := d.GetOk("storage_account_id"); ok {
@@ -192,12 +214,34 @@ func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	_, updateErr := client.Update(resourceGroup, name, parameters, make(chan struct{}))
+	location := d.Get("location").(string)
+	georeplicationLocations := d.Get("georeplication_locations").(*schema.Set)
+	if err := validateContainerRegistryGeoReplicationLocations(sku, location, georeplicationLocations); err != nil {
+		return err
+	}
+
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, updateErr := client.Update(resourceGroup, name, parameters, cancel)
 	err := <-updateErr
 	if err != nil {
 		return err
 	}
 
+	if d.HasChange("georeplication_locations") {
+		o, n := d.GetChange("georeplication_locations")
+		existing := o.(*schema.Set)
+		expected := n.(*schema.Set)
+
+		if err := deleteContainerRegistryReplications(resourceGroup, name, location, existing.Difference(expected).List(), meta, createUpdateTimeout(d)); err != nil {
+			return err
+		}
+
+		if err := createContainerRegistryReplications(resourceGroup, name, expected.Difference(existing).List(), meta, createUpdateTimeout(d)); err != nil {
+			return err
+		}
+	}
+
 	read, err := client.Get(resourceGroup, name)
 	if err != nil {
 		return err
@@ -234,12 +278,28 @@ func resourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{})
 
 	d.Set("name", resp.Name)
 	d.Set("resource_group_name", resourceGroup)
-	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
 	d.Set("admin_enabled", resp.AdminUserEnabled)
 	d.Set("login_server", resp.LoginServer)
 
 	if sku := resp.Sku; sku != nil {
 		d.Set("sku", string(sku.Tier))
+
+		// replications are only supported on Premium registries
+		if sku.Tier == containerregistry.SkuTierPremium && resp.Location != nil {
+			locations, err := listContainerRegistryReplicationLocations(resourceGroup, name, *resp.Location, meta)
+			if err != nil {
+				return err
+			}
+
+			if err := d.Set("georeplication_locations", locations); err != nil {
+				return fmt.Errorf("Error setting `georeplication_locations`: %+v", err)
+			}
+		} else {
+			d.Set("georeplication_locations", []interface{}{})
+		}
 	}
 
 	if account := resp.StorageAccount; account != nil {
@@ -262,7 +322,7 @@ func resourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{})
 		d.Set("admin_password", "")
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -277,7 +337,9 @@ func resourceArmContainerRegistryDelete(d *schema.ResourceData, meta interface{}
 	resourceGroup := id.ResourceGroup
 	name := id.Path["registries"]
 
-	deleteResp, deleteErr := client.Delete(resourceGroup, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := client.Delete(resourceGroup, name, cancel)
 	resp := <-deleteResp
 	err = <-deleteErr
 
@@ -290,6 +352,111 @@ func resourceArmContainerRegistryDelete(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+// A replication is named after the location it's in. Premium registries always have a replication in the
+// home location, which is created and deleted alongside the registry - so it can't be managed by these functions.
+func validateContainerRegistryGeoReplicationLocations(sku, homeLocation string, locations *schema.Set) error {
+	if locations.Len() == 0 {
+		return nil
+	}
+
+	if strings.ToLower(sku) != strings.ToLower(string(containerregistry.Premium)) {
+		return fmt.Errorf("`georeplication_locations` can only be specified for a Premium Sku.")
+	}
+
+	for _, v := range locations.List() {
+		if azureRMNormalizeLocation(v) == azureRMNormalizeLocation(homeLocation) {
+			return fmt.Errorf("`georeplication_locations` can't contain the location of the Container Registry (%q), since a Replication is always created there.", homeLocation)
+		}
+	}
+
+	return nil
+}
+
+func createContainerRegistryReplications(resourceGroup, registryName string, locations []interface{}, meta interface{}, timeout time.Duration) error {
+	client := meta.(*ArmClient).containerRegistryReplicationsClient
+
+	for _, v := range locations {
+		location := azureRMNormalizeLocation(v)
+		replication := containerregistry.Replication{
+			Location: utils.String(location),
+		}
+
+		log.Printf("[DEBUG] Creating Replication %q for Container Registry %q (Resource Group %q)", location, registryName, resourceGroup)
+		cancel, release := cancelAfter(meta, timeout)
+		_, createErr := client.Create(resourceGroup, registryName, location, replication, cancel)
+		err := <-createErr
+		release()
+		if err != nil {
+			return fmt.Errorf("Error creating Replication %q for Container Registry %q (Resource Group %q): %+v", location, registryName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func deleteContainerRegistryReplications(resourceGroup, registryName, homeLocation string, locations []interface{}, meta interface{}, timeout time.Duration) error {
+	client := meta.(*ArmClient).containerRegistryReplicationsClient
+
+	for _, v := range locations {
+		location := azureRMNormalizeLocation(v)
+		// the Replication in the home location is deleted alongside the registry
+		if location == azureRMNormalizeLocation(homeLocation) {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting Replication %q for Container Registry %q (Resource Group %q)", location, registryName, resourceGroup)
+		cancel, release := cancelAfter(meta, timeout)
+		deleteResp, deleteErr := client.Delete(resourceGroup, registryName, location, cancel)
+		resp := <-deleteResp
+		err := <-deleteErr
+		release()
+		if err != nil && !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Replication %q for Container Registry %q (Resource Group %q): %+v", location, registryName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func listContainerRegistryReplicationLocations(resourceGroup, registryName, homeLocation string, meta interface{}) ([]interface{}, error) {
+	client := meta.(*ArmClient).containerRegistryReplicationsClient
+
+	locations := make([]interface{}, 0)
+
+	resp, err := client.List(resourceGroup, registryName)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Replications for Container Registry %q (Resource Group %q): %+v", registryName, resourceGroup, err)
+	}
+
+	for {
+		if values := resp.Value; values != nil {
+			for _, replication := range *values {
+				if replication.Location == nil {
+					continue
+				}
+
+				location := azureRMNormalizeLocation(*replication.Location)
+				if location == azureRMNormalizeLocation(homeLocation) {
+					continue
+				}
+
+				locations = append(locations, location)
+			}
+		}
+
+		if resp.NextLink == nil || *resp.NextLink == "" {
+			break
+		}
+
+		resp, err = client.ListNextResults(resp)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing Replications for Container Registry %q (Resource Group %q): %+v", registryName, resourceGroup, err)
+		}
+	}
+
+	return locations, nil
+}
+
 func validateAzureRMContainerRegistryName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if !regexp.MustCompile(`^[a-zA-Z0-9]+$`).MatchString(value) {
@@ -3,10 +3,12 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -67,6 +69,53 @@ func TestAccAzureRMContainerRegistryName_validation(t *testing.T) {
 	}
 }
 
+func TestValidateContainerRegistryGeoReplicationLocations(t *testing.T) {
+	cases := []struct {
+		Sku         string
+		Locations   []interface{}
+		ExpectError bool
+	}{
+		{
+			Sku:         "Basic",
+			Locations:   []interface{}{},
+			ExpectError: false,
+		},
+		{
+			Sku:         "Standard",
+			Locations:   []interface{}{"northeurope"},
+			ExpectError: true,
+		},
+		{
+			Sku:         "Premium",
+			Locations:   []interface{}{"northeurope"},
+			ExpectError: false,
+		},
+		{
+			Sku:         "Premium",
+			Locations:   []interface{}{"northeurope", "westeurope"},
+			ExpectError: true,
+		},
+		{
+			Sku:         "Premium",
+			Locations:   []interface{}{"West Europe"},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		locations := schema.NewSet(azureRMHashLocation, tc.Locations)
+		err := validateContainerRegistryGeoReplicationLocations(tc.Sku, "westeurope", locations)
+
+		if tc.ExpectError && err == nil {
+			t.Fatalf("Expected an error for Sku %q with Locations %+v but didn't get one", tc.Sku, tc.Locations)
+		}
+
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("Expected no error for Sku %q with Locations %+v but got: %+v", tc.Sku, tc.Locations, err)
+		}
+	}
+}
+
 func TestAccAzureRMContainerRegistry_basicClassic(t *testing.T) {
 	ri := acctest.RandInt()
 	rs := acctest.RandString(4)
@@ -144,6 +193,52 @@ func TestAccAzureRMContainerRegistry_basicPremium(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMContainerRegistry_geoReplication(t *testing.T) {
+	resourceName := "azurerm_container_registry.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	altLocation := testAltLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMContainerRegistryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMContainerRegistry_geoReplication(ri, location, altLocation),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerRegistryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "georeplication_locations.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMContainerRegistry_basicManaged(ri, location, "Premium"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerRegistryExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "georeplication_locations.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMContainerRegistry_geoReplicationHomeLocation(t *testing.T) {
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMContainerRegistryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMContainerRegistry_geoReplication(ri, location, location),
+				ExpectError: regexp.MustCompile("`georeplication_locations` can't contain the location of the Container Registry"),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMContainerRegistry_complete(t *testing.T) {
 	ri := acctest.RandInt()
 	rs := acctest.RandString(4)
@@ -261,6 +356,23 @@ resource "azurerm_container_registry" "test" {
 `, rInt, location, rInt, sku)
 }
 
+func testAccAzureRMContainerRegistry_geoReplication(rInt int, location string, altLocation string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "testAccRg-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                     = "testacccr%d"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  sku                      = "Premium"
+  georeplication_locations = ["%s"]
+}
+`, rInt, location, rInt, altLocation)
+}
+
 func testAccAzureRMContainerRegistry_basicUnmanaged(rInt int, rStr string, location string, sku string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -23,6 +23,9 @@ func resourceArmContainerService() *schema.Resource {
 		Read:   resourceArmContainerServiceRead,
 		Update: resourceArmContainerServiceCreate,
 		Delete: resourceArmContainerServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("containerServices"),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -213,7 +216,7 @@ func resourceArmContainerServiceCreate(d *schema.ResourceData, meta interface{})
 			AgentPoolProfiles:  &agentProfiles,
 			DiagnosticsProfile: &diagnosticsProfile,
 		},
-		Tags: expandTags(tags),
+		Tags: expandResourceTags(d, tags, meta),
 	}
 
 	servicePrincipalProfile := expandAzureRmContainerServiceServicePrincipal(d)
@@ -221,7 +224,9 @@ func resourceArmContainerServiceCreate(d *schema.ResourceData, meta interface{})
 		parameters.ServicePrincipalProfile = servicePrincipalProfile
 	}
 
-	_, error := containerServiceClient.CreateOrUpdate(resGroup, name, parameters, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, error := containerServiceClient.CreateOrUpdate(resGroup, name, parameters, cancel)
 	err := <-error
 	if err != nil {
 		return err
@@ -241,7 +246,7 @@ func resourceArmContainerServiceCreate(d *schema.ResourceData, meta interface{})
 		Pending:    []string{"Updating", "Creating"},
 		Target:     []string{"Succeeded"},
 		Refresh:    containerServiceStateRefreshFunc(client, resGroup, name),
-		Timeout:    30 * time.Minute,
+		Timeout:    createUpdateTimeout(d),
 		MinTimeout: 15 * time.Second,
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
@@ -298,7 +303,7 @@ func resourceArmContainerServiceRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("diagnostics_profile", diagnosticProfile)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -314,7 +319,9 @@ func resourceArmContainerServiceDelete(d *schema.ResourceData, meta interface{})
 	resGroup := id.ResourceGroup
 	name := id.Path["containerServices"]
 
-	delResp, error := containerServiceClient.Delete(resGroup, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	delResp, error := containerServiceClient.Delete(resGroup, name, cancel)
 	resp := <-delResp
 	err = <-error
 	if err != nil {
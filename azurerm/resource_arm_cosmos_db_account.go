@@ -20,7 +20,7 @@ func resourceArmCosmosDBAccount() *schema.Resource {
 		Update: resourceArmCosmosDBAccountCreateUpdate,
 		Delete: resourceArmCosmosDBAccountDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("databaseAccounts"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -65,6 +65,12 @@ func resourceArmCosmosDBAccount() *schema.Resource {
 				Optional: true,
 			},
 
+			"enable_automatic_failover": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"consistency_policy": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -127,24 +133,52 @@ func resourceArmCosmosDBAccount() *schema.Resource {
 				Set: resourceAzureRMCosmosDBAccountFailoverPolicyHash,
 			},
 
-			"primary_master_key": {
+			"endpoint": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
-			"secondary_master_key": {
-				Type:     schema.TypeString,
+			"read_endpoints": {
+				Type:     schema.TypeList,
 				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
-			"primary_readonly_master_key": {
-				Type:     schema.TypeString,
+			"write_endpoints": {
+				Type:     schema.TypeList,
 				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"primary_master_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_master_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_readonly_master_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 
 			"secondary_readonly_master_key": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"connection_strings": {
+				Type:      schema.TypeList,
+				Computed:  true,
+				Sensitive: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
 			},
 
 			"tags": tagsSchema(),
@@ -162,6 +196,7 @@ func resourceArmCosmosDBAccountCreateUpdate(d *schema.ResourceData, meta interfa
 	kind := d.Get("kind").(string)
 	offerType := d.Get("offer_type").(string)
 	ipRangeFilter := d.Get("ip_range_filter").(string)
+	enableAutomaticFailover := d.Get("enable_automatic_failover").(bool)
 
 	consistencyPolicy := expandAzureRmCosmosDBAccountConsistencyPolicy(d)
 	failoverPolicies, err := expandAzureRmCosmosDBAccountFailoverPolicies(name, d)
@@ -178,11 +213,14 @@ func resourceArmCosmosDBAccountCreateUpdate(d *schema.ResourceData, meta interfa
 			Locations:                &failoverPolicies,
 			DatabaseAccountOfferType: utils.String(offerType),
 			IPRangeFilter:            utils.String(ipRangeFilter),
+			EnableAutomaticFailover:  utils.Bool(enableAutomaticFailover),
 		},
-		Tags: expandTags(tags),
+		Tags: expandResourceTags(d, tags, meta),
 	}
 
-	_, error := client.CreateOrUpdate(resGroup, name, parameters, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, error := client.CreateOrUpdate(resGroup, name, parameters, cancel)
 	err = <-error
 	if err != nil {
 		return err
@@ -222,13 +260,27 @@ func resourceArmCosmosDBAccountRead(d *schema.ResourceData, meta interface{}) er
 	}
 
 	d.Set("name", resp.Name)
-	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
 	d.Set("resource_group_name", resGroup)
 	d.Set("kind", string(resp.Kind))
-	d.Set("offer_type", string(resp.DatabaseAccountOfferType))
-	d.Set("ip_range_filter", resp.IPRangeFilter)
-	flattenAndSetAzureRmCosmosDBAccountConsistencyPolicy(d, resp.ConsistencyPolicy)
-	flattenAndSetAzureRmCosmosDBAccountFailoverPolicy(d, resp.FailoverPolicies)
+
+	if props := resp.DatabaseAccountProperties; props != nil {
+		d.Set("offer_type", string(props.DatabaseAccountOfferType))
+		d.Set("ip_range_filter", props.IPRangeFilter)
+		d.Set("enable_automatic_failover", props.EnableAutomaticFailover)
+		d.Set("endpoint", props.DocumentEndpoint)
+		flattenAndSetAzureRmCosmosDBAccountConsistencyPolicy(d, props.ConsistencyPolicy)
+		flattenAndSetAzureRmCosmosDBAccountFailoverPolicy(d, props.FailoverPolicies)
+
+		if err := d.Set("read_endpoints", flattenAzureRmCosmosDBAccountEndpoints(props.ReadLocations)); err != nil {
+			return fmt.Errorf("Error setting `read_endpoints`: %+v", err)
+		}
+		if err := d.Set("write_endpoints", flattenAzureRmCosmosDBAccountEndpoints(props.WriteLocations)); err != nil {
+			return fmt.Errorf("Error setting `write_endpoints`: %+v", err)
+		}
+	}
 
 	keys, err := client.ListKeys(resGroup, name)
 	if err != nil {
@@ -246,7 +298,16 @@ func resourceArmCosmosDBAccountRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("secondary_readonly_master_key", readonlyKeys.SecondaryReadonlyMasterKey)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	connectionStrings, err := client.ListConnectionStrings(resGroup, name)
+	if err != nil {
+		log.Printf("[ERROR] Unable to List connection strings for CosmosDB Account %s: %s", name, err)
+	} else {
+		if err := d.Set("connection_strings", flattenAzureRmCosmosDBAccountConnectionStrings(connectionStrings.ConnectionStrings)); err != nil {
+			return fmt.Errorf("Error setting `connection_strings`: %+v", err)
+		}
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -261,7 +322,9 @@ func resourceArmCosmosDBAccountDelete(d *schema.ResourceData, meta interface{})
 	resGroup := id.ResourceGroup
 	name := id.Path["databaseAccounts"]
 
-	deleteResp, error := client.Delete(resGroup, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, error := client.Delete(resGroup, name, cancel)
 	resp := <-deleteResp
 	err = <-error
 
@@ -353,11 +416,19 @@ func flattenAndSetAzureRmCosmosDBAccountConsistencyPolicy(d *schema.ResourceData
 		F: resourceAzureRMCosmosDBAccountConsistencyPolicyHash,
 	}
 
-	result := map[string]interface{}{}
-	result["consistency_level"] = string(policy.DefaultConsistencyLevel)
-	result["max_interval_in_seconds"] = int(*policy.MaxIntervalInSeconds)
-	result["max_staleness_prefix"] = int(*policy.MaxStalenessPrefix)
-	results.Add(result)
+	if policy != nil {
+		result := map[string]interface{}{}
+		result["consistency_level"] = string(policy.DefaultConsistencyLevel)
+		result["max_interval_in_seconds"] = 0
+		if policy.MaxIntervalInSeconds != nil {
+			result["max_interval_in_seconds"] = int(*policy.MaxIntervalInSeconds)
+		}
+		result["max_staleness_prefix"] = 0
+		if policy.MaxStalenessPrefix != nil {
+			result["max_staleness_prefix"] = int(*policy.MaxStalenessPrefix)
+		}
+		results.Add(result)
+	}
 
 	d.Set("consistency_policy", &results)
 }
@@ -367,19 +438,58 @@ func flattenAndSetAzureRmCosmosDBAccountFailoverPolicy(d *schema.ResourceData, l
 		F: resourceAzureRMCosmosDBAccountFailoverPolicyHash,
 	}
 
-	for _, i := range *list {
-		result := map[string]interface{}{
-			"id":       *i.ID,
-			"location": azureRMNormalizeLocation(*i.LocationName),
-			"priority": int(*i.FailoverPriority),
+	if list != nil {
+		for _, i := range *list {
+			result := map[string]interface{}{
+				"id":       "",
+				"location": "",
+				"priority": 0,
+			}
+			if i.ID != nil {
+				result["id"] = *i.ID
+			}
+			if i.LocationName != nil {
+				result["location"] = azureRMNormalizeLocation(*i.LocationName)
+			}
+			if i.FailoverPriority != nil {
+				result["priority"] = int(*i.FailoverPriority)
+			}
+
+			results.Add(result)
 		}
-
-		results.Add(result)
 	}
 
 	d.Set("failover_policy", &results)
 }
 
+func flattenAzureRmCosmosDBAccountEndpoints(input *[]cosmosdb.Location) []interface{} {
+	endpoints := make([]interface{}, 0)
+
+	if input != nil {
+		for _, location := range *input {
+			if location.DocumentEndpoint != nil {
+				endpoints = append(endpoints, *location.DocumentEndpoint)
+			}
+		}
+	}
+
+	return endpoints
+}
+
+func flattenAzureRmCosmosDBAccountConnectionStrings(input *[]cosmosdb.DatabaseAccountConnectionString) []interface{} {
+	connectionStrings := make([]interface{}, 0)
+
+	if input != nil {
+		for _, connectionString := range *input {
+			if connectionString.ConnectionString != nil {
+				connectionStrings = append(connectionStrings, *connectionString.ConnectionString)
+			}
+		}
+	}
+
+	return connectionStrings
+}
+
 func resourceAzureRMCosmosDBAccountConsistencyPolicyHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
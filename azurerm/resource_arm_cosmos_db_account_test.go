@@ -75,6 +75,12 @@ func TestAccAzureRMCosmosDBAccount_boundedStaleness(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMCosmosDBAccountExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "kind", "GlobalDocumentDB"),
+					resource.TestCheckResourceAttr(resourceName, "enable_automatic_failover", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "read_endpoints.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "write_endpoints.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_master_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_readonly_master_key"),
 				),
 			},
 		},
@@ -180,7 +186,7 @@ func TestAccAzureRMCosmosDBAccount_strong(t *testing.T) {
 }
 
 func TestAccAzureRMCosmosDBAccount_geoReplicated(t *testing.T) {
-
+	resourceName := "azurerm_cosmosdb_account.test"
 	ri := acctest.RandInt()
 	config := testAccAzureRMCosmosDBAccount_geoReplicated(ri, testLocation(), testAltLocation())
 
@@ -192,7 +198,39 @@ func TestAccAzureRMCosmosDBAccount_geoReplicated(t *testing.T) {
 			{
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
-					testCheckAzureRMCosmosDBAccountExists("azurerm_cosmosdb_account.test"),
+					testCheckAzureRMCosmosDBAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "failover_policy.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "read_endpoints.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "write_endpoints.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDBAccount_automaticFailover(t *testing.T) {
+	resourceName := "azurerm_cosmosdb_account.test"
+	ri := acctest.RandInt()
+	preConfig := testAccAzureRMCosmosDBAccount_geoReplicated(ri, testLocation(), testAltLocation())
+	postConfig := testAccAzureRMCosmosDBAccount_automaticFailover(ri, testLocation(), testAltLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable_automatic_failover", "false"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable_automatic_failover", "true"),
 				),
 			},
 		},
@@ -437,3 +475,36 @@ resource "azurerm_cosmosdb_account" "test" {
 }
 `, rInt, location, rInt, altLocation)
 }
+
+func testAccAzureRMCosmosDBAccount_automaticFailover(rInt int, location string, altLocation string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+  name                      = "acctest-%d"
+  location                  = "${azurerm_resource_group.test.location}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  offer_type                = "Standard"
+  enable_automatic_failover = true
+
+  consistency_policy {
+    consistency_level       = "BoundedStaleness"
+    max_interval_in_seconds = 10
+    max_staleness_prefix    = 200
+  }
+
+  failover_policy {
+    location = "${azurerm_resource_group.test.location}"
+    priority = 0
+  }
+
+  failover_policy {
+    location = "%s"
+    priority = 1
+  }
+}
+`, rInt, location, rInt, altLocation)
+}
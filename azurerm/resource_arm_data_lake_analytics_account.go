@@ -0,0 +1,262 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/datalake-analytics/account"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDataLakeAnalyticsAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataLakeAnalyticsAccountCreate,
+		Read:   resourceArmDataLakeAnalyticsAccountRead,
+		Update: resourceArmDataLakeAnalyticsAccountUpdate,
+		Delete: resourceArmDataLakeAnalyticsAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("accounts"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDataLakeAccountName,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"tier": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(account.Consumption),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(account.Consumption),
+					string(account.Commitment100AUHours),
+					string(account.Commitment500AUHours),
+					string(account.Commitment1000AUHours),
+					string(account.Commitment5000AUHours),
+					string(account.Commitment10000AUHours),
+					string(account.Commitment50000AUHours),
+					string(account.Commitment100000AUHours),
+					string(account.Commitment500000AUHours),
+				}, true),
+			},
+
+			"default_store_account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDataLakeAccountName,
+			},
+
+			"additional_store_account_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateDataLakeAccountName,
+				},
+				Set: schema.HashString,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDataLakeAnalyticsAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataLakeAnalyticsAccountClient
+
+	name := d.Get("name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	resourceGroup := d.Get("resource_group_name").(string)
+	storeAccountName := d.Get("default_store_account_name").(string)
+	tier := d.Get("tier").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Data Lake Analytics Account creation %q (Resource Group %q)", name, resourceGroup)
+
+	storeAccounts := []account.DataLakeStoreAccountInfo{
+		{
+			Name: utils.String(storeAccountName),
+		},
+	}
+	for _, v := range d.Get("additional_store_account_names").(*schema.Set).List() {
+		storeAccounts = append(storeAccounts, account.DataLakeStoreAccountInfo{
+			Name: utils.String(v.(string)),
+		})
+	}
+
+	dataLakeAnalyticsAccount := account.DataLakeAnalyticsAccount{
+		Location: utils.String(location),
+		Tags:     expandResourceTags(d, tags, meta),
+		DataLakeAnalyticsAccountProperties: &account.DataLakeAnalyticsAccountProperties{
+			NewTier:                     account.TierType(tier),
+			DefaultDataLakeStoreAccount: utils.String(storeAccountName),
+			DataLakeStoreAccounts:       &storeAccounts,
+		},
+	}
+
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.Create(resourceGroup, name, dataLakeAnalyticsAccount, cancel)
+	err := <-createErr
+	if err != nil {
+		return fmt.Errorf("Error creating Data Lake Analytics Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Data Lake Analytics Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Data Lake Analytics Account %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDataLakeAnalyticsAccountRead(d, meta)
+}
+
+func resourceArmDataLakeAnalyticsAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataLakeAnalyticsAccountClient
+	storesClient := meta.(*ArmClient).dataLakeAnalyticsStoresClient
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	tier := d.Get("tier").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	props := &account.DataLakeAnalyticsAccountUpdateParameters{
+		Tags: expandResourceTags(d, tags, meta),
+		UpdateDataLakeAnalyticsAccountProperties: &account.UpdateDataLakeAnalyticsAccountProperties{
+			NewTier: account.TierType(tier),
+		},
+	}
+
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, updateErr := client.Update(resourceGroup, name, props, cancel)
+	err := <-updateErr
+	if err != nil {
+		return fmt.Errorf("Error updating Data Lake Analytics Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if d.HasChange("additional_store_account_names") {
+		o, n := d.GetChange("additional_store_account_names")
+		oldStores := o.(*schema.Set)
+		newStores := n.(*schema.Set)
+
+		for _, v := range oldStores.Difference(newStores).List() {
+			storeAccountName := v.(string)
+			log.Printf("[DEBUG] Removing Data Lake Store %q from Data Lake Analytics Account %q (Resource Group %q)", storeAccountName, name, resourceGroup)
+			resp, err := storesClient.Delete(resourceGroup, name, storeAccountName)
+			if err != nil {
+				if !utils.ResponseWasNotFound(resp) {
+					return fmt.Errorf("Error removing Data Lake Store %q from Data Lake Analytics Account %q (Resource Group %q): %+v", storeAccountName, name, resourceGroup, err)
+				}
+			}
+		}
+
+		for _, v := range newStores.Difference(oldStores).List() {
+			storeAccountName := v.(string)
+			log.Printf("[DEBUG] Adding Data Lake Store %q to Data Lake Analytics Account %q (Resource Group %q)", storeAccountName, name, resourceGroup)
+			if _, err := storesClient.Add(resourceGroup, name, storeAccountName, &account.AddDataLakeStoreParameters{}); err != nil {
+				return fmt.Errorf("Error adding Data Lake Store %q to Data Lake Analytics Account %q (Resource Group %q): %+v", storeAccountName, name, resourceGroup, err)
+			}
+		}
+	}
+
+	return resourceArmDataLakeAnalyticsAccountRead(d, meta)
+}
+
+func resourceArmDataLakeAnalyticsAccountRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataLakeAnalyticsAccountClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["accounts"]
+
+	resp, err := client.Get(resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[WARN] Data Lake Analytics Account %q was not found (Resource Group %q)", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Data Lake Analytics Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.DataLakeAnalyticsAccountProperties; props != nil {
+		d.Set("tier", string(props.CurrentTier))
+
+		defaultStoreAccountName := ""
+		if v := props.DefaultDataLakeStoreAccount; v != nil {
+			defaultStoreAccountName = *v
+		}
+		d.Set("default_store_account_name", defaultStoreAccountName)
+
+		additionalStores := make([]interface{}, 0)
+		if stores := props.DataLakeStoreAccounts; stores != nil {
+			for _, store := range *stores {
+				if store.Name == nil || strings.EqualFold(*store.Name, defaultStoreAccountName) {
+					continue
+				}
+
+				additionalStores = append(additionalStores, *store.Name)
+			}
+		}
+		if err := d.Set("additional_store_account_names", schema.NewSet(schema.HashString, additionalStores)); err != nil {
+			return fmt.Errorf("Error setting `additional_store_account_names`: %+v", err)
+		}
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmDataLakeAnalyticsAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataLakeAnalyticsAccountClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["accounts"]
+
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := client.Delete(resourceGroup, name, cancel)
+	resp := <-deleteResp
+	err = <-deleteErr
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Data Lake Analytics Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
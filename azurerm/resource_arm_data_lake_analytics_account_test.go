@@ -0,0 +1,188 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMDataLakeAnalyticsAccount_basic(t *testing.T) {
+	resourceName := "azurerm_data_lake_analytics_account.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	config := testAccAzureRMDataLakeAnalyticsAccount_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataLakeAnalyticsAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataLakeAnalyticsAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tier", "Consumption"),
+					resource.TestCheckResourceAttr(resourceName, "additional_store_account_names.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDataLakeAnalyticsAccount_update(t *testing.T) {
+	resourceName := "azurerm_data_lake_analytics_account.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataLakeAnalyticsAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDataLakeAnalyticsAccount_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataLakeAnalyticsAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tier", "Consumption"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMDataLakeAnalyticsAccount_updated(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataLakeAnalyticsAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tier", "Commitment_100AUHours"),
+					resource.TestCheckResourceAttr(resourceName, "additional_store_account_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Test"),
+				),
+			},
+			{
+				Config: testAccAzureRMDataLakeAnalyticsAccount_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataLakeAnalyticsAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "additional_store_account_names.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDataLakeAnalyticsAccountExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		accountName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Data Lake Analytics Account: %s", accountName)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).dataLakeAnalyticsAccountClient
+		resp, err := conn.Get(resourceGroup, accountName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on dataLakeAnalyticsAccountClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Data Lake Analytics Account %q (resource group: %q) does not exist", accountName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDataLakeAnalyticsAccountDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).dataLakeAnalyticsAccountClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_data_lake_analytics_account" {
+			continue
+		}
+
+		accountName := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(resourceGroup, accountName)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Data Lake Analytics Account still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMDataLakeAnalyticsAccount_basic(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_lake_store" "test" {
+  name                = "unlikely23exp2rdls%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_data_lake_store" "second" {
+  name                = "unlikely23exp2rdls%s2"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_data_lake_analytics_account" "test" {
+  name                       = "unlikely23exp2rdla%s"
+  resource_group_name        = "${azurerm_resource_group.test.name}"
+  location                   = "${azurerm_resource_group.test.location}"
+  default_store_account_name = "${azurerm_data_lake_store.test.name}"
+}
+`, rInt, location, rString, rString, rString)
+}
+
+func testAccAzureRMDataLakeAnalyticsAccount_updated(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_lake_store" "test" {
+  name                = "unlikely23exp2rdls%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_data_lake_store" "second" {
+  name                = "unlikely23exp2rdls%s2"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_data_lake_analytics_account" "test" {
+  name                           = "unlikely23exp2rdla%s"
+  resource_group_name            = "${azurerm_resource_group.test.name}"
+  location                       = "${azurerm_resource_group.test.location}"
+  tier                           = "Commitment_100AUHours"
+  default_store_account_name     = "${azurerm_data_lake_store.test.name}"
+  additional_store_account_names = ["${azurerm_data_lake_store.second.name}"]
+
+  tags {
+    environment = "Test"
+  }
+}
+`, rInt, location, rString, rString, rString)
+}
@@ -0,0 +1,142 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/datalake-analytics/account"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDataLakeAnalyticsFirewallRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataLakeAnalyticsFirewallRuleCreateUpdate,
+		Read:   resourceArmDataLakeAnalyticsFirewallRuleRead,
+		Update: resourceArmDataLakeAnalyticsFirewallRuleCreateUpdate,
+		Delete: resourceArmDataLakeAnalyticsFirewallRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("accounts", "firewallRules"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDataLakeFirewallRuleName,
+			},
+
+			"account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDataLakeAccountName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"start_ip_address": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"end_ip_address": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceArmDataLakeAnalyticsFirewallRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataLakeAnalyticsFirewallClient
+
+	name := d.Get("name").(string)
+	accountName := d.Get("account_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	startIPAddress := d.Get("start_ip_address").(string)
+	endIPAddress := d.Get("end_ip_address").(string)
+
+	log.Printf("[INFO] preparing arguments for Data Lake Analytics Firewall Rule creation %q (Account %q / Resource Group %q)", name, accountName, resourceGroup)
+
+	parameters := account.FirewallRule{
+		FirewallRuleProperties: &account.FirewallRuleProperties{
+			StartIPAddress: utils.String(startIPAddress),
+			EndIPAddress:   utils.String(endIPAddress),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(resourceGroup, accountName, name, parameters); err != nil {
+		return fmt.Errorf("Error creating Data Lake Analytics Firewall Rule %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	read, err := client.Get(resourceGroup, accountName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Data Lake Analytics Firewall Rule %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Data Lake Analytics Firewall Rule %q (Account %q / Resource Group %q) ID", name, accountName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDataLakeAnalyticsFirewallRuleRead(d, meta)
+}
+
+func resourceArmDataLakeAnalyticsFirewallRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataLakeAnalyticsFirewallClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["accounts"]
+	name := id.Path["firewallRules"]
+
+	resp, err := client.Get(resourceGroup, accountName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[WARN] Data Lake Analytics Firewall Rule %q was not found (Account %q / Resource Group %q)", name, accountName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Data Lake Analytics Firewall Rule %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("account_name", accountName)
+
+	if props := resp.FirewallRuleProperties; props != nil {
+		d.Set("start_ip_address", props.StartIPAddress)
+		d.Set("end_ip_address", props.EndIPAddress)
+	}
+
+	return nil
+}
+
+func resourceArmDataLakeAnalyticsFirewallRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataLakeAnalyticsFirewallClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["accounts"]
+	name := id.Path["firewallRules"]
+
+	resp, err := client.Delete(resourceGroup, accountName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Data Lake Analytics Firewall Rule %q (Account %q / Resource Group %q): %+v", name, accountName, resourceGroup, err)
+	}
+
+	return nil
+}
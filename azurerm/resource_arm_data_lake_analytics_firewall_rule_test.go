@@ -0,0 +1,126 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMDataLakeAnalyticsFirewallRule_basic(t *testing.T) {
+	resourceName := "azurerm_data_lake_analytics_firewall_rule.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataLakeAnalyticsFirewallRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDataLakeAnalyticsFirewallRule_basic(ri, rs, location, "1.1.1.1", "2.2.2.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataLakeAnalyticsFirewallRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "start_ip_address", "1.1.1.1"),
+					resource.TestCheckResourceAttr(resourceName, "end_ip_address", "2.2.2.2"),
+				),
+			},
+			{
+				Config: testAccAzureRMDataLakeAnalyticsFirewallRule_basic(ri, rs, location, "2.2.2.2", "3.3.3.3"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataLakeAnalyticsFirewallRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "start_ip_address", "2.2.2.2"),
+					resource.TestCheckResourceAttr(resourceName, "end_ip_address", "3.3.3.3"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDataLakeAnalyticsFirewallRuleExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		firewallRuleName := rs.Primary.Attributes["name"]
+		accountName := rs.Primary.Attributes["account_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Data Lake Analytics Firewall Rule: %s", firewallRuleName)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).dataLakeAnalyticsFirewallClient
+		resp, err := conn.Get(resourceGroup, accountName, firewallRuleName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on dataLakeAnalyticsFirewallClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Data Lake Analytics Firewall Rule %q (Account %q / Resource Group: %q) does not exist", firewallRuleName, accountName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDataLakeAnalyticsFirewallRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).dataLakeAnalyticsFirewallClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_data_lake_analytics_firewall_rule" {
+			continue
+		}
+
+		firewallRuleName := rs.Primary.Attributes["name"]
+		accountName := rs.Primary.Attributes["account_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(resourceGroup, accountName, firewallRuleName)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Data Lake Analytics Firewall Rule still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMDataLakeAnalyticsFirewallRule_basic(rInt int, rString, location, startIP, endIP string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_lake_store" "test" {
+  name                = "unlikely23exp2rdls%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_data_lake_analytics_account" "test" {
+  name                       = "unlikely23exp2rdla%s"
+  resource_group_name        = "${azurerm_resource_group.test.name}"
+  location                   = "${azurerm_resource_group.test.location}"
+  default_store_account_name = "${azurerm_data_lake_store.test.name}"
+}
+
+resource "azurerm_data_lake_analytics_firewall_rule" "test" {
+  name                = "acctest%s"
+  account_name        = "${azurerm_data_lake_analytics_account.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  start_ip_address    = "%s"
+  end_ip_address      = "%s"
+}
+`, rInt, location, rString, rString, rString, startIP, endIP)
+}
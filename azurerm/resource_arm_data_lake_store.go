@@ -0,0 +1,263 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/datalake-store/account"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDataLakeStore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataLakeStoreCreate,
+		Read:   resourceArmDataLakeStoreRead,
+		Update: resourceArmDataLakeStoreUpdate,
+		Delete: resourceArmDataLakeStoreDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("accounts"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDataLakeAccountName,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"tier": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(account.Consumption),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(account.Consumption),
+					string(account.Commitment1TB),
+					string(account.Commitment10TB),
+					string(account.Commitment100TB),
+					string(account.Commitment500TB),
+					string(account.Commitment1PB),
+					string(account.Commitment5PB),
+				}, true),
+			},
+
+			"encryption_state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(account.Enabled),
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(account.Enabled),
+					string(account.Disabled),
+				}, true),
+			},
+
+			"encryption_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(account.ServiceManaged),
+				}, true),
+			},
+
+			"firewall_state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(account.FirewallStateEnabled),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(account.FirewallStateEnabled),
+					string(account.FirewallStateDisabled),
+				}, true),
+			},
+
+			"firewall_allow_azure_ips": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(account.FirewallAllowAzureIpsStateEnabled),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(account.FirewallAllowAzureIpsStateEnabled),
+					string(account.FirewallAllowAzureIpsStateDisabled),
+				}, true),
+			},
+
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDataLakeStoreCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataLakeStoreAccountClient
+
+	name := d.Get("name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	resourceGroup := d.Get("resource_group_name").(string)
+	tier := d.Get("tier").(string)
+	encryptionState := account.EncryptionState(d.Get("encryption_state").(string))
+	encryptionType := account.EncryptionConfigType(d.Get("encryption_type").(string))
+	firewallState := account.FirewallState(d.Get("firewall_state").(string))
+	firewallAllowAzureIPs := account.FirewallAllowAzureIpsState(d.Get("firewall_allow_azure_ips").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	log.Printf("[INFO] preparing arguments for Data Lake Store creation %q (Resource Group %q)", name, resourceGroup)
+
+	dataLakeStore := account.DataLakeStoreAccount{
+		Location: utils.String(location),
+		Tags:     expandResourceTags(d, tags, meta),
+		DataLakeStoreAccountProperties: &account.DataLakeStoreAccountProperties{
+			NewTier:               account.TierType(tier),
+			FirewallState:         firewallState,
+			FirewallAllowAzureIps: firewallAllowAzureIPs,
+			EncryptionState:       encryptionState,
+		},
+	}
+
+	if encryptionState == account.Enabled {
+		if encryptionType == "" {
+			encryptionType = account.ServiceManaged
+		}
+
+		dataLakeStore.DataLakeStoreAccountProperties.EncryptionConfig = &account.EncryptionConfig{
+			Type: encryptionType,
+		}
+	}
+
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.Create(resourceGroup, name, dataLakeStore, cancel)
+	err := <-createErr
+	if err != nil {
+		return fmt.Errorf("Error creating Data Lake Store %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Data Lake Store %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Data Lake Store %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDataLakeStoreRead(d, meta)
+}
+
+func resourceArmDataLakeStoreUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataLakeStoreAccountClient
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	tier := d.Get("tier").(string)
+	firewallState := account.FirewallState(d.Get("firewall_state").(string))
+	firewallAllowAzureIPs := account.FirewallAllowAzureIpsState(d.Get("firewall_allow_azure_ips").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	props := account.DataLakeStoreAccountUpdateParameters{
+		Tags: expandResourceTags(d, tags, meta),
+		UpdateDataLakeStoreAccountProperties: &account.UpdateDataLakeStoreAccountProperties{
+			NewTier:               account.TierType(tier),
+			FirewallState:         firewallState,
+			FirewallAllowAzureIps: firewallAllowAzureIPs,
+		},
+	}
+
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, updateErr := client.Update(resourceGroup, name, props, cancel)
+	err := <-updateErr
+	if err != nil {
+		return fmt.Errorf("Error updating Data Lake Store %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return resourceArmDataLakeStoreRead(d, meta)
+}
+
+func resourceArmDataLakeStoreRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataLakeStoreAccountClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["accounts"]
+
+	resp, err := client.Get(resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[WARN] Data Lake Store %q was not found (Resource Group %q)", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Data Lake Store %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.DataLakeStoreAccountProperties; props != nil {
+		d.Set("tier", string(props.CurrentTier))
+
+		d.Set("encryption_state", string(props.EncryptionState))
+		d.Set("firewall_state", string(props.FirewallState))
+		d.Set("firewall_allow_azure_ips", string(props.FirewallAllowAzureIps))
+
+		if config := props.EncryptionConfig; config != nil {
+			d.Set("encryption_type", string(config.Type))
+		}
+
+		d.Set("endpoint", props.Endpoint)
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmDataLakeStoreDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataLakeStoreAccountClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["accounts"]
+
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := client.Delete(resourceGroup, name, cancel)
+	resp := <-deleteResp
+	err = <-deleteErr
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Data Lake Store %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
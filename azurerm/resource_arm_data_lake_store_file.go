@@ -0,0 +1,175 @@
+package azurerm
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/datalake-store/filesystem"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the contents of the file are uploaded to Data Lake Store in chunks of this size
+const dataLakeStoreFileChunkSize = 4 * 1024 * 1024
+
+func resourceArmDataLakeStoreFile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataLakeStoreFileCreate,
+		Read:   resourceArmDataLakeStoreFileRead,
+		Delete: resourceArmDataLakeStoreFileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDataLakeAccountName,
+			},
+
+			"remote_file_path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDataLakeStoreRemoteFilePath,
+			},
+
+			"local_file_path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceArmDataLakeStoreFileCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataLakeStoreFilesClient
+
+	log.Printf("[INFO] preparing arguments for Data Lake Store File creation.")
+
+	accountName := d.Get("account_name").(string)
+	remoteFilePath := d.Get("remote_file_path").(string)
+	localFilePath := d.Get("local_file_path").(string)
+
+	file, err := os.Open(localFilePath)
+	if err != nil {
+		return fmt.Errorf("Error opening file %q: %+v", localFilePath, err)
+	}
+	defer file.Close()
+
+	// NOTE: the contents aren't passed to Create since the SDK serializes the stream as JSON,
+	// instead we create an empty file and then Append the contents to it in chunks
+	if _, err := client.Create(accountName, remoteFilePath, "true", "CREATE", nil, utils.Bool(false), "", nil, nil); err != nil {
+		return fmt.Errorf("Error creating Data Lake Store File %q (Account %q): %+v", remoteFilePath, accountName, err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("Error retrieving the size of file %q: %+v", localFilePath, err)
+	}
+	size := info.Size()
+
+	buffer := make([]byte, dataLakeStoreFileChunkSize)
+	var offset int64
+	for offset < size {
+		n, err := io.ReadFull(file, buffer)
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return fmt.Errorf("Error reading file %q: %+v", localFilePath, err)
+		}
+
+		syncFlag := filesystem.DATA
+		if offset+int64(n) >= size {
+			syncFlag = filesystem.CLOSE
+		}
+
+		chunk := ioutil.NopCloser(bytes.NewReader(buffer[:n]))
+		if _, err := client.Append(accountName, remoteFilePath, chunk, "true", "APPEND", utils.Int64(offset), syncFlag, nil, nil); err != nil {
+			return fmt.Errorf("Error appending to Data Lake Store File %q (Account %q): %+v", remoteFilePath, accountName, err)
+		}
+
+		offset += int64(n)
+	}
+
+	d.SetId(fmt.Sprintf("%s.%s%s", accountName, client.AdlsFileSystemDNSSuffix, remoteFilePath))
+	return resourceArmDataLakeStoreFileRead(d, meta)
+}
+
+func resourceArmDataLakeStoreFileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataLakeStoreFilesClient
+
+	id, err := parseDataLakeStoreFileId(d.Id(), client.AdlsFileSystemDNSSuffix)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetFileStatus(id.accountName, id.filePath, "GETFILESTATUS", nil)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[WARN] Data Lake Store File %q was not found (Account %q)", id.filePath, id.accountName)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Azure Data Lake Store File %q (Account %q): %+v", id.filePath, id.accountName, err)
+	}
+
+	d.Set("account_name", id.accountName)
+	d.Set("remote_file_path", id.filePath)
+
+	return nil
+}
+
+func resourceArmDataLakeStoreFileDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataLakeStoreFilesClient
+
+	id, err := parseDataLakeStoreFileId(d.Id(), client.AdlsFileSystemDNSSuffix)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(id.accountName, id.filePath, "DELETE", utils.Bool(false))
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+		return fmt.Errorf("Error issuing delete request for Data Lake Store File %q (Account %q): %+v", id.filePath, id.accountName, err)
+	}
+
+	return nil
+}
+
+type dataLakeStoreFileId struct {
+	accountName string
+	filePath    string
+}
+
+// parseDataLakeStoreFileId parses an ID in the format `{accountName}.{dnsSuffix}/{filePath}`
+func parseDataLakeStoreFileId(input string, suffix string) (*dataLakeStoreFileId, error) {
+	uri, err := url.Parse(fmt.Sprintf("https://%s", input))
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as URI: %+v", input, err)
+	}
+
+	replacement := fmt.Sprintf(".%s", suffix)
+	accountName := strings.Replace(uri.Host, replacement, "", 1)
+	if accountName == "" || accountName == uri.Host {
+		return nil, fmt.Errorf("Error parsing Data Lake Store File ID %q: expected the host to end in %q", input, replacement)
+	}
+
+	if uri.Path == "" || uri.Path == "/" {
+		return nil, fmt.Errorf("Error parsing Data Lake Store File ID %q: no file path was found", input)
+	}
+
+	return &dataLakeStoreFileId{
+		accountName: accountName,
+		filePath:    uri.Path,
+	}, nil
+}
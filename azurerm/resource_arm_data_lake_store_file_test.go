@@ -0,0 +1,175 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestParseDataLakeStoreFileId(t *testing.T) {
+	testData := []struct {
+		Name            string
+		Input           string
+		ExpectedAccount string
+		ExpectedPath    string
+		ExpectError     bool
+	}{
+		{
+			Name:        "Empty",
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Name:        "No File Path",
+			Input:       "example.azuredatalakestore.net",
+			ExpectError: true,
+		},
+		{
+			Name:        "Root Path",
+			Input:       "example.azuredatalakestore.net/",
+			ExpectError: true,
+		},
+		{
+			Name:        "Different DNS Suffix",
+			Input:       "example.blob.core.windows.net/test.txt",
+			ExpectError: true,
+		},
+		{
+			Name:            "File in the Root",
+			Input:           "example.azuredatalakestore.net/test.txt",
+			ExpectedAccount: "example",
+			ExpectedPath:    "/test.txt",
+		},
+		{
+			Name:            "Nested File",
+			Input:           "example.azuredatalakestore.net/folder/nested/test.txt",
+			ExpectedAccount: "example",
+			ExpectedPath:    "/folder/nested/test.txt",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := parseDataLakeStoreFileId(v.Input, "azuredatalakestore.net")
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.ExpectError {
+			t.Fatalf("Expected an error but got a value for %q", v.Input)
+		}
+
+		if actual.accountName != v.ExpectedAccount {
+			t.Fatalf("Expected Account Name to be %q but got %q", v.ExpectedAccount, actual.accountName)
+		}
+
+		if actual.filePath != v.ExpectedPath {
+			t.Fatalf("Expected File Path to be %q but got %q", v.ExpectedPath, actual.filePath)
+		}
+	}
+}
+
+func TestAccAzureRMDataLakeStoreFile_basic(t *testing.T) {
+	resourceName := "azurerm_data_lake_store_file.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	config := testAccAzureRMDataLakeStoreFile_basic(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDataLakeStoreFileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDataLakeStoreFileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "remote_file_path", "/test/data_lake_store_file.txt"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDataLakeStoreFileExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		remoteFilePath := rs.Primary.Attributes["remote_file_path"]
+		accountName, hasAccountName := rs.Primary.Attributes["account_name"]
+		if !hasAccountName {
+			return fmt.Errorf("Bad: no account_name found in state for Data Lake Store File: %s", remoteFilePath)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).dataLakeStoreFilesClient
+		resp, err := conn.GetFileStatus(accountName, remoteFilePath, "GETFILESTATUS", nil)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Data Lake Store File %q (Account %q) does not exist", remoteFilePath, accountName)
+			}
+			return fmt.Errorf("Bad: Get on dataLakeStoreFilesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDataLakeStoreFileDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).dataLakeStoreFilesClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_data_lake_store_file" {
+			continue
+		}
+
+		remoteFilePath := rs.Primary.Attributes["remote_file_path"]
+		accountName := rs.Primary.Attributes["account_name"]
+
+		resp, err := conn.GetFileStatus(accountName, remoteFilePath, "GETFILESTATUS", nil)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Data Lake Store File still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDataLakeStoreFile_basic(rInt int, rString, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_lake_store" "test" {
+  name                = "unlikely23exp2rdls%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_data_lake_store_file" "test" {
+  account_name     = "${azurerm_data_lake_store.test.name}"
+  remote_file_path = "/test/data_lake_store_file.txt"
+  local_file_path  = "./testdata/data_lake_store_file.txt"
+}
+`, rInt, location, rString)
+}
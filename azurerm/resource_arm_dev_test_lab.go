@@ -0,0 +1,189 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/devtestlabs"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDevTestLab() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDevTestLabCreateUpdate,
+		Read:   resourceArmDevTestLabRead,
+		Update: resourceArmDevTestLabCreateUpdate,
+		Delete: resourceArmDevTestLabDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("labs"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDevTestResourceName,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"storage_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(devtestlabs.Premium),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(devtestlabs.Standard),
+					string(devtestlabs.Premium),
+				}, false),
+			},
+
+			"tags": tagsSchema(),
+
+			"artifacts_storage_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"default_storage_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"default_premium_storage_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"key_vault_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"premium_data_disk_storage_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"unique_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmDevTestLabCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestLabsClient
+
+	log.Printf("[INFO] preparing arguments for DevTest Lab creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	storageType := d.Get("storage_type").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := devtestlabs.Lab{
+		Location: utils.String(location),
+		Tags:     expandResourceTags(d, tags, meta),
+		LabProperties: &devtestlabs.LabProperties{
+			LabStorageType: devtestlabs.StorageType(storageType),
+		},
+	}
+
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.CreateOrUpdate(resourceGroup, name, parameters, cancel)
+	err := <-createErr
+	if err != nil {
+		return fmt.Errorf("Error creating/updating DevTest Lab %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving DevTest Lab %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read DevTest Lab %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDevTestLabRead(d, meta)
+}
+
+func resourceArmDevTestLabRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestLabsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["labs"]
+
+	resp, err := client.Get(resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] DevTest Lab %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on DevTest Lab %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.LabProperties; props != nil {
+		d.Set("storage_type", string(props.LabStorageType))
+
+		d.Set("artifacts_storage_account_id", props.ArtifactsStorageAccount)
+		d.Set("default_storage_account_id", props.DefaultStorageAccount)
+		d.Set("default_premium_storage_account_id", props.DefaultPremiumStorageAccount)
+		d.Set("key_vault_id", props.VaultName)
+		d.Set("premium_data_disk_storage_account_id", props.PremiumDataDiskStorageAccount)
+		d.Set("unique_identifier", props.UniqueIdentifier)
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmDevTestLabDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestLabsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["labs"]
+
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := client.Delete(resourceGroup, name, cancel)
+	resp := <-deleteResp
+	err = <-deleteErr
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting DevTest Lab %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestValidateDevTestResourceName(t *testing.T) {
+	validNames := []string{
+		"lab",
+		"acctestdtl1",
+		"Dev-Test_Lab",
+	}
+	for _, v := range validNames {
+		_, errors := validateDevTestResourceName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid DevTest Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"dev test",
+		"lab.1",
+		"lab!",
+	}
+	for _, v := range invalidNames {
+		_, errors := validateDevTestResourceName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid DevTest Name", v)
+		}
+	}
+}
+
+func TestAccAzureRMDevTestLab_basic(t *testing.T) {
+	resourceName := "azurerm_dev_test_lab.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDevTestLab_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestLabDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestLabExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "Premium"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevTestLab_complete(t *testing.T) {
+	resourceName := "azurerm_dev_test_lab.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDevTestLab_complete(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestLabDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestLabExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "Standard"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Hello", "World"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDevTestLabExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		labName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for DevTest Lab: %q", labName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).devTestLabsClient
+		resp, err := client.Get(resourceGroup, labName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: DevTest Lab %q (Resource Group %q) does not exist", labName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on devTestLabsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDevTestLabDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).devTestLabsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dev_test_lab" {
+			continue
+		}
+
+		labName := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(resourceGroup, labName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("DevTest Lab still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDevTestLab_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dev_test_lab" "test" {
+  name                = "acctestdtl%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMDevTestLab_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dev_test_lab" "test" {
+  name                = "acctestdtl%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  storage_type        = "Standard"
+
+  tags {
+    "Hello" = "World"
+  }
+}
+`, rInt, location, rInt)
+}
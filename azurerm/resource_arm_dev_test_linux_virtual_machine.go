@@ -0,0 +1,285 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/devtestlabs"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDevTestLinuxVirtualMachine() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDevTestLinuxVirtualMachineCreateUpdate,
+		Read:   resourceArmDevTestLinuxVirtualMachineRead,
+		Update: resourceArmDevTestLinuxVirtualMachineCreateUpdate,
+		Delete: resourceArmDevTestLinuxVirtualMachineDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("labs", "virtualmachines"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDevTestResourceName,
+			},
+
+			"lab_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDevTestResourceName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"size": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"username": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
+			"ssh_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"lab_virtual_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"lab_subnet_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"storage_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(devtestlabs.Standard),
+					string(devtestlabs.Premium),
+				}, false),
+			},
+
+			"gallery_image_reference": devTestGalleryImageReferenceSchema(),
+
+			"allow_claim": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"disallow_public_ip_address": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"inbound_nat_rule": devTestVirtualMachineInboundNatRuleSchema(),
+
+			"notes": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": tagsSchema(),
+
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"unique_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmDevTestLinuxVirtualMachineCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestVirtualMachinesClient
+
+	log.Printf("[INFO] preparing arguments for DevTest Linux Virtual Machine creation.")
+
+	name := d.Get("name").(string)
+	labName := d.Get("lab_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	username := d.Get("username").(string)
+	password := d.Get("password").(string)
+	sshKey := d.Get("ssh_key").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	if password == "" && sshKey == "" {
+		return fmt.Errorf("Either `password` or `ssh_key` must be specified for DevTest Linux Virtual Machine %q", name)
+	}
+
+	galleryImageReference := expandDevTestGalleryImageReference(d.Get("gallery_image_reference").([]interface{}), "Linux")
+	natRules := expandDevTestVirtualMachineInboundNatRules(d.Get("inbound_nat_rule").(*schema.Set).List())
+
+	parameters := devtestlabs.LabVirtualMachine{
+		Location: utils.String(location),
+		Tags:     expandResourceTags(d, tags, meta),
+		LabVirtualMachineProperties: &devtestlabs.LabVirtualMachineProperties{
+			AllowClaim:                 utils.Bool(d.Get("allow_claim").(bool)),
+			DisallowPublicIPAddress:    utils.Bool(d.Get("disallow_public_ip_address").(bool)),
+			GalleryImageReference:      galleryImageReference,
+			IsAuthenticationWithSSHKey: utils.Bool(sshKey != ""),
+			LabSubnetName:              utils.String(d.Get("lab_subnet_name").(string)),
+			LabVirtualNetworkID:        utils.String(d.Get("lab_virtual_network_id").(string)),
+			Notes:                      utils.String(d.Get("notes").(string)),
+			OsType:                     utils.String("Linux"),
+			Size:                       utils.String(d.Get("size").(string)),
+			StorageType:                utils.String(d.Get("storage_type").(string)),
+			UserName:                   utils.String(username),
+		},
+	}
+
+	if password != "" {
+		parameters.LabVirtualMachineProperties.Password = utils.String(password)
+	}
+
+	if sshKey != "" {
+		parameters.LabVirtualMachineProperties.SSHKey = utils.String(sshKey)
+	}
+
+	if len(natRules) > 0 {
+		parameters.LabVirtualMachineProperties.NetworkInterface = &devtestlabs.NetworkInterfaceProperties{
+			SharedPublicIPAddressConfiguration: &devtestlabs.SharedPublicIPAddressConfiguration{
+				InboundNatRules: &natRules,
+			},
+		}
+	}
+
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.CreateOrUpdate(resourceGroup, labName, name, parameters, cancel)
+	err := <-createErr
+	if err != nil {
+		return fmt.Errorf("Error creating/updating DevTest Linux Virtual Machine %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	read, err := client.Get(resourceGroup, labName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving DevTest Linux Virtual Machine %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read DevTest Linux Virtual Machine %q (Lab %q / Resource Group %q) ID", name, labName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDevTestLinuxVirtualMachineRead(d, meta)
+}
+
+func resourceArmDevTestLinuxVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestVirtualMachinesClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	labName := id.Path["labs"]
+	name := id.Path["virtualmachines"]
+
+	resp, err := client.Get(resourceGroup, labName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] DevTest Linux Virtual Machine %q was not found in Lab %q (Resource Group %q) - removing from state!", name, labName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on DevTest Linux Virtual Machine %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("lab_name", labName)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.LabVirtualMachineProperties; props != nil {
+		d.Set("allow_claim", props.AllowClaim)
+		d.Set("disallow_public_ip_address", props.DisallowPublicIPAddress)
+		d.Set("lab_subnet_name", props.LabSubnetName)
+		d.Set("lab_virtual_network_id", props.LabVirtualNetworkID)
+		d.Set("notes", props.Notes)
+		d.Set("size", props.Size)
+		d.Set("storage_type", props.StorageType)
+		d.Set("username", props.UserName)
+
+		if err := d.Set("gallery_image_reference", flattenDevTestGalleryImageReference(props.GalleryImageReference)); err != nil {
+			return fmt.Errorf("Error setting `gallery_image_reference`: %+v", err)
+		}
+
+		if err := d.Set("inbound_nat_rule", flattenDevTestVirtualMachineInboundNatRules(props.NetworkInterface)); err != nil {
+			return fmt.Errorf("Error setting `inbound_nat_rule`: %+v", err)
+		}
+
+		// the `password` and `ssh_key` fields aren't returned from the API, so we use the values from the state
+
+		d.Set("fqdn", props.Fqdn)
+		d.Set("unique_identifier", props.UniqueIdentifier)
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmDevTestLinuxVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestVirtualMachinesClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	labName := id.Path["labs"]
+	name := id.Path["virtualmachines"]
+
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := client.Delete(resourceGroup, labName, name, cancel)
+	resp := <-deleteResp
+	err = <-deleteErr
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting DevTest Linux Virtual Machine %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/devtestlabs"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDevTestPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDevTestPolicyCreateUpdate,
+		Read:   resourceArmDevTestPolicyRead,
+		Update: resourceArmDevTestPolicyCreateUpdate,
+		Delete: resourceArmDevTestPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("labs", "policysets", "policies"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(devtestlabs.PolicyFactNameGalleryImage),
+					string(devtestlabs.PolicyFactNameLabPremiumVMCount),
+					string(devtestlabs.PolicyFactNameLabTargetCost),
+					string(devtestlabs.PolicyFactNameLabVMCount),
+					string(devtestlabs.PolicyFactNameLabVMSize),
+					string(devtestlabs.PolicyFactNameUserOwnedLabPremiumVMCount),
+					string(devtestlabs.PolicyFactNameUserOwnedLabVMCount),
+					string(devtestlabs.PolicyFactNameUserOwnedLabVMCountInSubnet),
+				}, false),
+			},
+
+			"policy_set_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "default",
+			},
+
+			"lab_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDevTestResourceName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"threshold": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"fact_data": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDevTestPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestPoliciesClient
+
+	log.Printf("[INFO] preparing arguments for DevTest Policy creation.")
+
+	name := d.Get("name").(string)
+	policySetName := d.Get("policy_set_name").(string)
+	labName := d.Get("lab_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	// the Gallery Image and VM Size policies restrict the allowed values, all others limit a count/cost
+	evaluatorType := devtestlabs.MaxValuePolicy
+	if name == string(devtestlabs.PolicyFactNameGalleryImage) || name == string(devtestlabs.PolicyFactNameLabVMSize) {
+		evaluatorType = devtestlabs.AllowedValuesPolicy
+	}
+
+	parameters := devtestlabs.Policy{
+		Tags: expandResourceTags(d, tags, meta),
+		PolicyProperties: &devtestlabs.PolicyProperties{
+			FactName:      devtestlabs.PolicyFactName(name),
+			FactData:      utils.String(d.Get("fact_data").(string)),
+			Description:   utils.String(d.Get("description").(string)),
+			EvaluatorType: evaluatorType,
+			Threshold:     utils.String(d.Get("threshold").(string)),
+			Status:        devtestlabs.PolicyStatusEnabled,
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(resourceGroup, labName, policySetName, name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating DevTest Policy %q (Policy Set %q / Lab %q / Resource Group %q): %+v", name, policySetName, labName, resourceGroup, err)
+	}
+
+	read, err := client.Get(resourceGroup, labName, policySetName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving DevTest Policy %q (Policy Set %q / Lab %q / Resource Group %q): %+v", name, policySetName, labName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read DevTest Policy %q (Policy Set %q / Lab %q / Resource Group %q) ID", name, policySetName, labName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDevTestPolicyRead(d, meta)
+}
+
+func resourceArmDevTestPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestPoliciesClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	labName := id.Path["labs"]
+	policySetName := id.Path["policysets"]
+	name := id.Path["policies"]
+
+	resp, err := client.Get(resourceGroup, labName, policySetName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] DevTest Policy %q was not found in Policy Set %q (Lab %q / Resource Group %q) - removing from state!", name, policySetName, labName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on DevTest Policy %q (Policy Set %q / Lab %q / Resource Group %q): %+v", name, policySetName, labName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("policy_set_name", policySetName)
+	d.Set("lab_name", labName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.PolicyProperties; props != nil {
+		d.Set("description", props.Description)
+		d.Set("fact_data", props.FactData)
+		d.Set("threshold", props.Threshold)
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmDevTestPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestPoliciesClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	labName := id.Path["labs"]
+	policySetName := id.Path["policysets"]
+	name := id.Path["policies"]
+
+	resp, err := client.Delete(resourceGroup, labName, policySetName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting DevTest Policy %q (Policy Set %q / Lab %q / Resource Group %q): %+v", name, policySetName, labName, resourceGroup, err)
+	}
+
+	return nil
+}
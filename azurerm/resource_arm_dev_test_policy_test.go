@@ -0,0 +1,150 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMDevTestPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_dev_test_policy.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDevTestPolicy_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "999"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevTestPolicy_complete(t *testing.T) {
+	resourceName := "azurerm_dev_test_policy.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDevTestPolicy_complete(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "100"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Aloha this is the max number of VM's'"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Acceptance", "Test"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDevTestPolicyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		policyName := rs.Primary.Attributes["name"]
+		policySetName := rs.Primary.Attributes["policy_set_name"]
+		labName := rs.Primary.Attributes["lab_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for DevTest Policy: %q", policyName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).devTestPoliciesClient
+		resp, err := client.Get(resourceGroup, labName, policySetName, policyName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: DevTest Policy %q (Policy Set %q / Lab %q / Resource Group %q) does not exist", policyName, policySetName, labName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on devTestPoliciesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDevTestPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).devTestPoliciesClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dev_test_policy" {
+			continue
+		}
+
+		policyName := rs.Primary.Attributes["name"]
+		policySetName := rs.Primary.Attributes["policy_set_name"]
+		labName := rs.Primary.Attributes["lab_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(resourceGroup, labName, policySetName, policyName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("DevTest Policy still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDevTestPolicy_basic(rInt int, location string) string {
+	template := testAccAzureRMDevTestLab_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_policy" "test" {
+  name                = "LabVmCount"
+  policy_set_name     = "default"
+  lab_name            = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  threshold           = "999"
+}
+`, template)
+}
+
+func testAccAzureRMDevTestPolicy_complete(rInt int, location string) string {
+	template := testAccAzureRMDevTestLab_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_policy" "test" {
+  name                = "LabVmCount"
+  policy_set_name     = "default"
+  lab_name            = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  threshold           = "100"
+  description         = "Aloha this is the max number of VM's'"
+
+  tags {
+    "Acceptance" = "Test"
+  }
+}
+`, template)
+}
@@ -0,0 +1,403 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/arm/devtestlabs"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Lab-wide Schedules have fixed names, which determine the Task that they run
+var devTestScheduleTaskTypes = map[string]string{
+	"LabVmsShutdown": "LabVmsShutdownTask",
+	"LabVmAutoStart": "LabVmsStartupTask",
+}
+
+func resourceArmDevTestSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDevTestScheduleCreateUpdate,
+		Read:   resourceArmDevTestScheduleRead,
+		Update: resourceArmDevTestScheduleCreateUpdate,
+		Delete: resourceArmDevTestScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("labs", "schedules"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"LabVmsShutdown",
+					"LabVmAutoStart",
+				}, false),
+			},
+
+			"lab_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDevTestResourceName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(devtestlabs.EnableStatusEnabled),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(devtestlabs.EnableStatusEnabled),
+					string(devtestlabs.EnableStatusDisabled),
+				}, false),
+			},
+
+			"time_zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"daily_recurrence": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"time": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateDevTestScheduleTime,
+						},
+					},
+				},
+			},
+
+			"weekly_recurrence": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"time": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateDevTestScheduleTime,
+						},
+
+						"week_days": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"Monday",
+									"Tuesday",
+									"Wednesday",
+									"Thursday",
+									"Friday",
+									"Saturday",
+									"Sunday",
+								}, false),
+							},
+						},
+					},
+				},
+			},
+
+			"hourly_recurrence": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"minute": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+					},
+				},
+			},
+
+			"notification_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(devtestlabs.NotificationStatusDisabled),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(devtestlabs.NotificationStatusEnabled),
+								string(devtestlabs.NotificationStatusDisabled),
+							}, false),
+						},
+
+						"time_in_minutes": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+
+						"webhook_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"task_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDevTestScheduleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestSchedulesClient
+
+	log.Printf("[INFO] preparing arguments for DevTest Schedule creation.")
+
+	name := d.Get("name").(string)
+	labName := d.Get("lab_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	properties := devtestlabs.ScheduleProperties{
+		Status:               devtestlabs.EnableStatus(d.Get("status").(string)),
+		TaskType:             utils.String(devTestScheduleTaskTypes[name]),
+		TimeZoneID:           utils.String(d.Get("time_zone_id").(string)),
+		NotificationSettings: expandDevTestScheduleNotificationSettings(d.Get("notification_settings").([]interface{})),
+	}
+
+	if v := d.Get("daily_recurrence").([]interface{}); len(v) > 0 {
+		block := v[0].(map[string]interface{})
+		properties.DailyRecurrence = &devtestlabs.DayDetails{
+			Time: utils.String(block["time"].(string)),
+		}
+	}
+
+	if v := d.Get("weekly_recurrence").([]interface{}); len(v) > 0 {
+		block := v[0].(map[string]interface{})
+		weekDays := make([]string, 0)
+		for _, day := range block["week_days"].(*schema.Set).List() {
+			weekDays = append(weekDays, day.(string))
+		}
+
+		properties.WeeklyRecurrence = &devtestlabs.WeekDetails{
+			Time:     utils.String(block["time"].(string)),
+			Weekdays: &weekDays,
+		}
+	}
+
+	if v := d.Get("hourly_recurrence").([]interface{}); len(v) > 0 {
+		block := v[0].(map[string]interface{})
+		properties.HourlyRecurrence = &devtestlabs.HourDetails{
+			Minute: utils.Int32(int32(block["minute"].(int))),
+		}
+	}
+
+	if properties.DailyRecurrence == nil && properties.WeeklyRecurrence == nil && properties.HourlyRecurrence == nil {
+		return fmt.Errorf("One of `daily_recurrence`, `weekly_recurrence` or `hourly_recurrence` must be specified for DevTest Schedule %q", name)
+	}
+
+	parameters := devtestlabs.Schedule{
+		Location:           utils.String(location),
+		Tags:               expandResourceTags(d, tags, meta),
+		ScheduleProperties: &properties,
+	}
+
+	if _, err := client.CreateOrUpdate(resourceGroup, labName, name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating DevTest Schedule %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	read, err := client.Get(resourceGroup, labName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving DevTest Schedule %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read DevTest Schedule %q (Lab %q / Resource Group %q) ID", name, labName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDevTestScheduleRead(d, meta)
+}
+
+func resourceArmDevTestScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestSchedulesClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	labName := id.Path["labs"]
+	name := id.Path["schedules"]
+
+	resp, err := client.Get(resourceGroup, labName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] DevTest Schedule %q was not found in Lab %q (Resource Group %q) - removing from state!", name, labName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on DevTest Schedule %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("lab_name", labName)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.ScheduleProperties; props != nil {
+		d.Set("status", string(props.Status))
+		d.Set("task_type", props.TaskType)
+		d.Set("time_zone_id", props.TimeZoneID)
+
+		daily := make([]interface{}, 0)
+		if recurrence := props.DailyRecurrence; recurrence != nil {
+			block := map[string]interface{}{}
+			if recurrence.Time != nil {
+				block["time"] = *recurrence.Time
+			}
+			daily = append(daily, block)
+		}
+		if err := d.Set("daily_recurrence", daily); err != nil {
+			return fmt.Errorf("Error setting `daily_recurrence`: %+v", err)
+		}
+
+		weekly := make([]interface{}, 0)
+		if recurrence := props.WeeklyRecurrence; recurrence != nil {
+			block := map[string]interface{}{}
+			if recurrence.Time != nil {
+				block["time"] = *recurrence.Time
+			}
+			weekDays := schema.NewSet(schema.HashString, []interface{}{})
+			if recurrence.Weekdays != nil {
+				for _, day := range *recurrence.Weekdays {
+					weekDays.Add(day)
+				}
+			}
+			block["week_days"] = weekDays
+			weekly = append(weekly, block)
+		}
+		if err := d.Set("weekly_recurrence", weekly); err != nil {
+			return fmt.Errorf("Error setting `weekly_recurrence`: %+v", err)
+		}
+
+		hourly := make([]interface{}, 0)
+		if recurrence := props.HourlyRecurrence; recurrence != nil {
+			block := map[string]interface{}{}
+			if recurrence.Minute != nil {
+				block["minute"] = int(*recurrence.Minute)
+			}
+			hourly = append(hourly, block)
+		}
+		if err := d.Set("hourly_recurrence", hourly); err != nil {
+			return fmt.Errorf("Error setting `hourly_recurrence`: %+v", err)
+		}
+
+		if err := d.Set("notification_settings", flattenDevTestScheduleNotificationSettings(props.NotificationSettings)); err != nil {
+			return fmt.Errorf("Error setting `notification_settings`: %+v", err)
+		}
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmDevTestScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestSchedulesClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	labName := id.Path["labs"]
+	name := id.Path["schedules"]
+
+	resp, err := client.Delete(resourceGroup, labName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting DevTest Schedule %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandDevTestScheduleNotificationSettings(input []interface{}) *devtestlabs.NotificationSettings {
+	if len(input) == 0 || input[0] == nil {
+		return &devtestlabs.NotificationSettings{
+			Status: devtestlabs.NotificationStatusDisabled,
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	settings := devtestlabs.NotificationSettings{
+		Status: devtestlabs.NotificationStatus(v["status"].(string)),
+	}
+
+	if timeInMinutes := v["time_in_minutes"].(int); timeInMinutes > 0 {
+		settings.TimeInMinutes = utils.Int32(int32(timeInMinutes))
+	}
+
+	if webhookUrl := v["webhook_url"].(string); webhookUrl != "" {
+		settings.WebhookURL = utils.String(webhookUrl)
+	}
+
+	return &settings
+}
+
+func flattenDevTestScheduleNotificationSettings(input *devtestlabs.NotificationSettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := map[string]interface{}{
+		"status": string(input.Status),
+	}
+
+	if input.TimeInMinutes != nil {
+		output["time_in_minutes"] = int(*input.TimeInMinutes)
+	}
+
+	if input.WebhookURL != nil {
+		output["webhook_url"] = *input.WebhookURL
+	}
+
+	return []interface{}{output}
+}
+
+func validateDevTestScheduleTime(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^([01][0-9]|2[0-3])[0-5][0-9]$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must be a time in the format `HHMM`: %q", k, value))
+	}
+
+	return
+}
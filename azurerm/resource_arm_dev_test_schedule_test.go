@@ -0,0 +1,243 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestValidateDevTestScheduleTime(t *testing.T) {
+	validTimes := []string{
+		"0000",
+		"0930",
+		"2359",
+	}
+	for _, v := range validTimes {
+		_, errors := validateDevTestScheduleTime(v, "time")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid DevTest Schedule Time: %q", v, errors)
+		}
+	}
+
+	invalidTimes := []string{
+		"",
+		"930",
+		"09:30",
+		"2400",
+		"1260",
+	}
+	for _, v := range invalidTimes {
+		_, errors := validateDevTestScheduleTime(v, "time")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid DevTest Schedule Time", v)
+		}
+	}
+}
+
+func TestAccAzureRMDevTestSchedule_autoShutdownBasic(t *testing.T) {
+	resourceName := "azurerm_dev_test_schedule.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDevTestSchedule_autoShutdownBasic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "task_type", "LabVmsShutdownTask"),
+					resource.TestCheckResourceAttr(resourceName, "daily_recurrence.0.time", "0100"),
+					resource.TestCheckResourceAttr(resourceName, "notification_settings.0.status", "Disabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevTestSchedule_autoStartupBasic(t *testing.T) {
+	resourceName := "azurerm_dev_test_schedule.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDevTestSchedule_autoStartupBasic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "task_type", "LabVmsStartupTask"),
+					resource.TestCheckResourceAttr(resourceName, "weekly_recurrence.0.time", "1100"),
+					resource.TestCheckResourceAttr(resourceName, "weekly_recurrence.0.week_days.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevTestSchedule_update(t *testing.T) {
+	resourceName := "azurerm_dev_test_schedule.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDevTestSchedule_autoShutdownBasic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "daily_recurrence.0.time", "0100"),
+				),
+			},
+			{
+				Config: testAccAzureRMDevTestSchedule_autoShutdownComplete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", "Disabled"),
+					resource.TestCheckResourceAttr(resourceName, "daily_recurrence.0.time", "2300"),
+					resource.TestCheckResourceAttr(resourceName, "notification_settings.0.status", "Enabled"),
+					resource.TestCheckResourceAttr(resourceName, "notification_settings.0.time_in_minutes", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDevTestScheduleExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		scheduleName := rs.Primary.Attributes["name"]
+		labName := rs.Primary.Attributes["lab_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for DevTest Schedule: %q", scheduleName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).devTestSchedulesClient
+		resp, err := client.Get(resourceGroup, labName, scheduleName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: DevTest Schedule %q (Lab %q / Resource Group %q) does not exist", scheduleName, labName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on devTestSchedulesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDevTestScheduleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).devTestSchedulesClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dev_test_schedule" {
+			continue
+		}
+
+		scheduleName := rs.Primary.Attributes["name"]
+		labName := rs.Primary.Attributes["lab_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(resourceGroup, labName, scheduleName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("DevTest Schedule still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDevTestSchedule_autoShutdownBasic(rInt int, location string) string {
+	template := testAccAzureRMDevTestLab_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_schedule" "test" {
+  name                = "LabVmsShutdown"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  lab_name            = "${azurerm_dev_test_lab.test.name}"
+  time_zone_id        = "India Standard Time"
+
+  daily_recurrence {
+    time = "0100"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMDevTestSchedule_autoShutdownComplete(rInt int, location string) string {
+	template := testAccAzureRMDevTestLab_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_schedule" "test" {
+  name                = "LabVmsShutdown"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  lab_name            = "${azurerm_dev_test_lab.test.name}"
+  time_zone_id        = "India Standard Time"
+  status              = "Disabled"
+
+  daily_recurrence {
+    time = "2300"
+  }
+
+  notification_settings {
+    status          = "Enabled"
+    time_in_minutes = 30
+  }
+}
+`, template)
+}
+
+func testAccAzureRMDevTestSchedule_autoStartupBasic(rInt int, location string) string {
+	template := testAccAzureRMDevTestLab_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_schedule" "test" {
+  name                = "LabVmAutoStart"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  lab_name            = "${azurerm_dev_test_lab.test.name}"
+  time_zone_id        = "India Standard Time"
+
+  weekly_recurrence {
+    time      = "1100"
+    week_days = ["Monday", "Tuesday"]
+  }
+
+  tags {
+    "environment" = "Production"
+  }
+}
+`, template)
+}
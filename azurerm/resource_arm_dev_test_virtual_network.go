@@ -0,0 +1,236 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/devtestlabs"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDevTestVirtualNetwork() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDevTestVirtualNetworkCreateUpdate,
+		Read:   resourceArmDevTestVirtualNetworkRead,
+		Update: resourceArmDevTestVirtualNetworkCreateUpdate,
+		Delete: resourceArmDevTestVirtualNetworkDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("labs", "virtualnetworks"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDevTestResourceName,
+			},
+
+			"lab_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateDevTestResourceName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"subnet": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"use_in_virtual_machine_creation": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      string(devtestlabs.Allow),
+							ValidateFunc: validateDevTestUsagePermissionType(),
+						},
+
+						"use_public_ip_address": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      string(devtestlabs.Allow),
+							ValidateFunc: validateDevTestUsagePermissionType(),
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+
+			"unique_identifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmDevTestVirtualNetworkCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestVirtualNetworksClient
+	subscriptionId := meta.(*ArmClient).subscriptionId
+
+	log.Printf("[INFO] preparing arguments for DevTest Virtual Network creation.")
+
+	name := d.Get("name").(string)
+	labName := d.Get("lab_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	description := d.Get("description").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	subnets := expandDevTestVirtualNetworkSubnets(d.Get("subnet").([]interface{}), subscriptionId, resourceGroup, name)
+
+	parameters := devtestlabs.VirtualNetwork{
+		Tags: expandResourceTags(d, tags, meta),
+		VirtualNetworkProperties: &devtestlabs.VirtualNetworkProperties{
+			Description:     utils.String(description),
+			SubnetOverrides: subnets,
+		},
+	}
+
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.CreateOrUpdate(resourceGroup, labName, name, parameters, cancel)
+	err := <-createErr
+	if err != nil {
+		return fmt.Errorf("Error creating/updating DevTest Virtual Network %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	read, err := client.Get(resourceGroup, labName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving DevTest Virtual Network %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read DevTest Virtual Network %q (Lab %q / Resource Group %q) ID", name, labName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDevTestVirtualNetworkRead(d, meta)
+}
+
+func resourceArmDevTestVirtualNetworkRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestVirtualNetworksClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	labName := id.Path["labs"]
+	name := id.Path["virtualnetworks"]
+
+	resp, err := client.Get(resourceGroup, labName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] DevTest Virtual Network %q was not found in Lab %q (Resource Group %q) - removing from state!", name, labName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on DevTest Virtual Network %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("lab_name", labName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.VirtualNetworkProperties; props != nil {
+		d.Set("description", props.Description)
+
+		if err := d.Set("subnet", flattenDevTestVirtualNetworkSubnets(props.SubnetOverrides)); err != nil {
+			return fmt.Errorf("Error setting `subnet`: %+v", err)
+		}
+
+		d.Set("unique_identifier", props.UniqueIdentifier)
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmDevTestVirtualNetworkDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).devTestVirtualNetworksClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	labName := id.Path["labs"]
+	name := id.Path["virtualnetworks"]
+
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := client.Delete(resourceGroup, labName, name, cancel)
+	resp := <-deleteResp
+	err = <-deleteErr
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting DevTest Virtual Network %q (Lab %q / Resource Group %q): %+v", name, labName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandDevTestVirtualNetworkSubnets(input []interface{}, subscriptionId, resourceGroup, virtualNetworkName string) *[]devtestlabs.SubnetOverride {
+	// the Virtual Network and Subnet are created by DevTest Labs, using the naming convention from the Portal
+	subnetName := fmt.Sprintf("%sSubnet", virtualNetworkName)
+	subnetId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/virtualNetworks/%s/subnets/%s", subscriptionId, resourceGroup, virtualNetworkName, subnetName)
+
+	useInVirtualMachineCreation := string(devtestlabs.Allow)
+	usePublicIPAddress := string(devtestlabs.Allow)
+	if len(input) > 0 && input[0] != nil {
+		v := input[0].(map[string]interface{})
+		useInVirtualMachineCreation = v["use_in_virtual_machine_creation"].(string)
+		usePublicIPAddress = v["use_public_ip_address"].(string)
+	}
+
+	return &[]devtestlabs.SubnetOverride{
+		{
+			ResourceID:                   utils.String(subnetId),
+			LabSubnetName:                utils.String(subnetName),
+			UseInVMCreationPermission:    devtestlabs.UsagePermissionType(useInVirtualMachineCreation),
+			UsePublicIPAddressPermission: devtestlabs.UsagePermissionType(usePublicIPAddress),
+		},
+	}
+}
+
+func flattenDevTestVirtualNetworkSubnets(input *[]devtestlabs.SubnetOverride) []interface{} {
+	outputs := make([]interface{}, 0)
+	if input == nil {
+		return outputs
+	}
+
+	for _, v := range *input {
+		output := make(map[string]interface{}, 0)
+		if v.LabSubnetName != nil {
+			output["name"] = *v.LabSubnetName
+		}
+		output["use_in_virtual_machine_creation"] = string(v.UseInVMCreationPermission)
+		output["use_public_ip_address"] = string(v.UsePublicIPAddressPermission)
+
+		outputs = append(outputs, output)
+	}
+
+	return outputs
+}
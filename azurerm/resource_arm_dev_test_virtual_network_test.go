@@ -0,0 +1,147 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMDevTestVirtualNetwork_basic(t *testing.T) {
+	resourceName := "azurerm_dev_test_virtual_network.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDevTestVirtualNetwork_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestVirtualNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestVirtualNetworkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subnet.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.0.use_public_ip_address", "Allow"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.0.use_in_virtual_machine_creation", "Allow"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevTestVirtualNetwork_subnet(t *testing.T) {
+	resourceName := "azurerm_dev_test_virtual_network.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDevTestVirtualNetwork_subnets(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestVirtualNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestVirtualNetworkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Managed by Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.0.use_public_ip_address", "Deny"),
+					resource.TestCheckResourceAttr(resourceName, "subnet.0.use_in_virtual_machine_creation", "Allow"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDevTestVirtualNetworkExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		virtualNetworkName := rs.Primary.Attributes["name"]
+		labName := rs.Primary.Attributes["lab_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for DevTest Virtual Network: %q", virtualNetworkName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).devTestVirtualNetworksClient
+		resp, err := client.Get(resourceGroup, labName, virtualNetworkName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: DevTest Virtual Network %q (Lab %q / Resource Group %q) does not exist", virtualNetworkName, labName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on devTestVirtualNetworksClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDevTestVirtualNetworkDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).devTestVirtualNetworksClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dev_test_virtual_network" {
+			continue
+		}
+
+		virtualNetworkName := rs.Primary.Attributes["name"]
+		labName := rs.Primary.Attributes["lab_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(resourceGroup, labName, virtualNetworkName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("DevTest Virtual Network still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDevTestVirtualNetwork_basic(rInt int, location string) string {
+	template := testAccAzureRMDevTestLab_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_virtual_network" "test" {
+  name                = "acctestdtvn%d"
+  lab_name            = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMDevTestVirtualNetwork_subnets(rInt int, location string) string {
+	template := testAccAzureRMDevTestLab_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_virtual_network" "test" {
+  name                = "acctestdtvn%d"
+  lab_name            = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  description         = "Managed by Terraform"
+
+  subnet {
+    use_public_ip_address           = "Deny"
+    use_in_virtual_machine_creation = "Allow"
+  }
+}
+`, template, rInt)
+}
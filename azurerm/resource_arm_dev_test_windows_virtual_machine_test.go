@@ -0,0 +1,182 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMDevTestWindowsVirtualMachine_basic(t *testing.T) {
+	resourceName := "azurerm_dev_test_windows_virtual_machine.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDevTestWindowsVirtualMachine_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestWindowsVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestWindowsVirtualMachineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "gallery_image_reference.0.publisher", "MicrosoftWindowsServer"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDevTestWindowsVirtualMachine_inboundNatRules(t *testing.T) {
+	resourceName := "azurerm_dev_test_windows_virtual_machine.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMDevTestWindowsVirtualMachine_inboundNatRules(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDevTestWindowsVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDevTestWindowsVirtualMachineExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "disallow_public_ip_address", "true"),
+					resource.TestCheckResourceAttr(resourceName, "inbound_nat_rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Acceptance", "Test"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDevTestWindowsVirtualMachineExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		virtualMachineName := rs.Primary.Attributes["name"]
+		labName := rs.Primary.Attributes["lab_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for DevTest Windows Virtual Machine: %q", virtualMachineName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).devTestVirtualMachinesClient
+		resp, err := client.Get(resourceGroup, labName, virtualMachineName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: DevTest Windows Virtual Machine %q (Lab %q / Resource Group %q) does not exist", virtualMachineName, labName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on devTestVirtualMachinesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDevTestWindowsVirtualMachineDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).devTestVirtualMachinesClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dev_test_windows_virtual_machine" {
+			continue
+		}
+
+		virtualMachineName := rs.Primary.Attributes["name"]
+		labName := rs.Primary.Attributes["lab_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(resourceGroup, labName, virtualMachineName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("DevTest Windows Virtual Machine still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMDevTestWindowsVirtualMachine_basic(rInt int, location string) string {
+	template := testAccAzureRMDevTestVirtualNetwork_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_windows_virtual_machine" "test" {
+  name                   = "acctestvm%d"
+  lab_name               = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  location               = "${azurerm_resource_group.test.location}"
+  size                   = "Standard_F2"
+  username               = "acct5stU5er"
+  password               = "H@Sh1CoR3!"
+  lab_virtual_network_id = "${azurerm_dev_test_virtual_network.test.id}"
+  lab_subnet_name        = "${azurerm_dev_test_virtual_network.test.subnet.0.name}"
+  storage_type           = "Standard"
+
+  gallery_image_reference {
+    offer     = "WindowsServer"
+    publisher = "MicrosoftWindowsServer"
+    sku       = "2012-Datacenter"
+    version   = "latest"
+  }
+}
+`, template, rInt%1000000)
+}
+
+func testAccAzureRMDevTestWindowsVirtualMachine_inboundNatRules(rInt int, location string) string {
+	template := testAccAzureRMDevTestVirtualNetwork_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_test_windows_virtual_machine" "test" {
+  name                       = "acctestvm%d"
+  lab_name                   = "${azurerm_dev_test_lab.test.name}"
+  resource_group_name        = "${azurerm_resource_group.test.name}"
+  location                   = "${azurerm_resource_group.test.location}"
+  size                       = "Standard_F2"
+  username                   = "acct5stU5er"
+  password                   = "H@Sh1CoR3!"
+  lab_virtual_network_id     = "${azurerm_dev_test_virtual_network.test.id}"
+  lab_subnet_name            = "${azurerm_dev_test_virtual_network.test.subnet.0.name}"
+  storage_type               = "Standard"
+  disallow_public_ip_address = true
+
+  gallery_image_reference {
+    offer     = "WindowsServer"
+    publisher = "MicrosoftWindowsServer"
+    sku       = "2012-Datacenter"
+    version   = "latest"
+  }
+
+  inbound_nat_rule {
+    protocol     = "Tcp"
+    backend_port = 5985
+  }
+
+  inbound_nat_rule {
+    protocol     = "Tcp"
+    backend_port = 3389
+  }
+
+  tags {
+    "Acceptance" = "Test"
+  }
+}
+`, template, rInt%1000000)
+}
@@ -16,7 +16,7 @@ func resourceArmDnsARecord() *schema.Resource {
 		Update: resourceArmDnsARecordCreateOrUpdate,
 		Delete: resourceArmDnsARecordDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("dnszones", "A"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,7 +67,7 @@ func resourceArmDnsARecordCreateOrUpdate(d *schema.ResourceData, meta interface{
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata: expandTags(tags),
+			Metadata: expandResourceTags(d, tags, meta),
 			TTL:      &ttl,
 			ARecords: &records,
 		},
@@ -118,7 +118,7 @@ func resourceArmDnsARecordRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("records", flattenAzureRmDnsARecords(resp.ARecords)); err != nil {
 		return err
 	}
-	flattenAndSetTags(d, resp.Metadata)
+	flattenAndSetResourceTags(d, resp.Metadata, meta)
 
 	return nil
 }
@@ -16,7 +16,7 @@ func resourceArmDnsAAAARecord() *schema.Resource {
 		Update: resourceArmDnsAaaaRecordCreateOrUpdate,
 		Delete: resourceArmDnsAaaaRecordDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("dnszones", "AAAA"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,7 +67,7 @@ func resourceArmDnsAaaaRecordCreateOrUpdate(d *schema.ResourceData, meta interfa
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:    expandTags(tags),
+			Metadata:    expandResourceTags(d, tags, meta),
 			TTL:         &ttl,
 			AaaaRecords: &records,
 		},
@@ -118,7 +118,7 @@ func resourceArmDnsAaaaRecordRead(d *schema.ResourceData, meta interface{}) erro
 	if err := d.Set("records", flattenAzureRmDnsAaaaRecords(resp.AaaaRecords)); err != nil {
 		return err
 	}
-	flattenAndSetTags(d, resp.Metadata)
+	flattenAndSetResourceTags(d, resp.Metadata, meta)
 
 	return nil
 }
@@ -19,7 +19,7 @@ func resourceArmDnsMxRecord() *schema.Resource {
 		Update: resourceArmDnsMxRecordCreateOrUpdate,
 		Delete: resourceArmDnsMxRecordDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("dnszones", "MX"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -82,7 +82,7 @@ func resourceArmDnsMxRecordCreateOrUpdate(d *schema.ResourceData, meta interface
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:  expandTags(tags),
+			Metadata:  expandResourceTags(d, tags, meta),
 			TTL:       &ttl,
 			MxRecords: &records,
 		},
@@ -133,7 +133,7 @@ func resourceArmDnsMxRecordRead(d *schema.ResourceData, meta interface{}) error
 	if err := d.Set("record", flattenAzureRmDnsMxRecords(resp.MxRecords)); err != nil {
 		return err
 	}
-	flattenAndSetTags(d, resp.Metadata)
+	flattenAndSetResourceTags(d, resp.Metadata, meta)
 
 	return nil
 }
@@ -16,7 +16,7 @@ func resourceArmDnsNsRecord() *schema.Resource {
 		Update: resourceArmDnsNsRecordCreateOrUpdate,
 		Delete: resourceArmDnsNsRecordDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("dnszones", "NS"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -72,7 +72,7 @@ func resourceArmDnsNsRecordCreateOrUpdate(d *schema.ResourceData, meta interface
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:  expandTags(tags),
+			Metadata:  expandResourceTags(d, tags, meta),
 			TTL:       &ttl,
 			NsRecords: &records,
 		},
@@ -124,7 +124,7 @@ func resourceArmDnsNsRecordRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	flattenAndSetTags(d, resp.Metadata)
+	flattenAndSetResourceTags(d, resp.Metadata, meta)
 
 	return nil
 }
@@ -16,7 +16,7 @@ func resourceArmDnsPtrRecord() *schema.Resource {
 		Update: resourceArmDnsPtrRecordCreateOrUpdate,
 		Delete: resourceArmDnsPtrRecordDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("dnszones", "PTR"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -66,7 +66,7 @@ func resourceArmDnsPtrRecordCreateOrUpdate(d *schema.ResourceData, meta interfac
 
 	parameters := dns.RecordSet{
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:   expandTags(tags),
+			Metadata:   expandResourceTags(d, tags, meta),
 			TTL:        &ttl,
 			PtrRecords: &records,
 		},
@@ -120,7 +120,7 @@ func resourceArmDnsPtrRecordRead(d *schema.ResourceData, meta interface{}) error
 	if err := d.Set("records", flattenAzureRmDnsPtrRecords(resp.PtrRecords)); err != nil {
 		return err
 	}
-	flattenAndSetTags(d, resp.Metadata)
+	flattenAndSetResourceTags(d, resp.Metadata, meta)
 
 	return nil
 }
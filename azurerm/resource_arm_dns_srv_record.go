@@ -18,7 +18,7 @@ func resourceArmDnsSrvRecord() *schema.Resource {
 		Update: resourceArmDnsSrvRecordCreateOrUpdate,
 		Delete: resourceArmDnsSrvRecordDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("dnszones", "SRV"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -91,7 +91,7 @@ func resourceArmDnsSrvRecordCreateOrUpdate(d *schema.ResourceData, meta interfac
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:   expandTags(tags),
+			Metadata:   expandResourceTags(d, tags, meta),
 			TTL:        &ttl,
 			SrvRecords: &records,
 		},
@@ -142,7 +142,7 @@ func resourceArmDnsSrvRecordRead(d *schema.ResourceData, meta interface{}) error
 	if err := d.Set("record", flattenAzureRmDnsSrvRecords(resp.SrvRecords)); err != nil {
 		return err
 	}
-	flattenAndSetTags(d, resp.Metadata)
+	flattenAndSetResourceTags(d, resp.Metadata, meta)
 
 	return nil
 }
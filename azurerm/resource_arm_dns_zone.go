@@ -15,7 +15,7 @@ func resourceArmDnsZone() *schema.Resource {
 		Update: resourceArmDnsZoneCreate,
 		Delete: resourceArmDnsZoneDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("dnszones"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -60,7 +60,7 @@ func resourceArmDnsZoneCreate(d *schema.ResourceData, meta interface{}) error {
 
 	parameters := dns.Zone{
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandResourceTags(d, tags, meta),
 	}
 
 	etag := ""
@@ -112,7 +112,7 @@ func resourceArmDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -129,7 +129,9 @@ func resourceArmDnsZoneDelete(d *schema.ResourceData, meta interface{}) error {
 	name := id.Path["dnszones"]
 
 	etag := ""
-	_, error := client.Delete(resGroup, name, etag, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	_, error := client.Delete(resGroup, name, etag, cancel)
 	err = <-error
 
 	if err != nil {
@@ -0,0 +1,401 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/eventgrid"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmEventGridEventSubscription() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmEventGridEventSubscriptionCreateUpdate,
+		Read:   resourceArmEventGridEventSubscriptionRead,
+		Update: resourceArmEventGridEventSubscriptionCreateUpdate,
+		Delete: resourceArmEventGridEventSubscriptionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"scope": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"webhook_endpoint": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"eventhub_endpoint"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"base_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"eventhub_endpoint": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"webhook_endpoint"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"eventhub_id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+						},
+					},
+				},
+			},
+
+			"included_event_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"subject_filter": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subject_begins_with": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"subject_ends_with": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"case_sensitive": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"labels": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"topic_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmEventGridEventSubscriptionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).eventGridEventSubscriptionsClient
+
+	name := d.Get("name").(string)
+	scope := d.Get("scope").(string)
+
+	destination := expandEventGridEventSubscriptionDestination(d)
+	if destination == nil {
+		return fmt.Errorf("One of `webhook_endpoint` or `eventhub_endpoint` must be specified for EventGrid Event Subscription %q (Scope %q)", name, scope)
+	}
+
+	properties := eventgrid.EventSubscriptionProperties{
+		Destination: destination,
+		Filter:      expandEventGridEventSubscriptionFilter(d),
+		Labels:      expandEventGridEventSubscriptionLabels(d),
+	}
+
+	eventSubscription := eventgrid.EventSubscription{
+		EventSubscriptionProperties: &properties,
+	}
+
+	log.Printf("[INFO] preparing arguments for AzureRM EventGrid Event Subscription creation with Properties: %+v.", eventSubscription)
+
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.Create(scope, name, eventSubscription, cancel)
+	err := <-createErr
+	if err != nil {
+		return fmt.Errorf("Error creating/updating EventGrid Event Subscription %q (Scope %q): %+v", name, scope, err)
+	}
+
+	read, err := client.Get(scope, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving EventGrid Event Subscription %q (Scope %q): %+v", name, scope, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read EventGrid Event Subscription %q (Scope %q) ID", name, scope)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmEventGridEventSubscriptionRead(d, meta)
+}
+
+func resourceArmEventGridEventSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).eventGridEventSubscriptionsClient
+
+	scope, name, err := parseEventGridEventSubscriptionId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(scope, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[WARN] EventGrid Event Subscription %q was not found (Scope %q) - removing from state", name, scope)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving EventGrid Event Subscription %q (Scope %q): %+v", name, scope, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("scope", scope)
+
+	if props := resp.EventSubscriptionProperties; props != nil {
+		d.Set("topic_name", props.Topic)
+
+		webhookEndpoints := make([]interface{}, 0)
+		eventhubEndpoints := make([]interface{}, 0)
+
+		if destination := props.Destination; destination != nil {
+			if webhook, ok := destination.AsWebHookEventSubscriptionDestination(); ok {
+				// the full URL isn't returned by the API, since it may contain secrets
+				fullURL, err := client.GetFullURL(scope, name)
+				if err != nil {
+					return fmt.Errorf("Error retrieving the Full URL for EventGrid Event Subscription %q (Scope %q): %+v", name, scope, err)
+				}
+
+				webhookEndpoints = flattenEventGridEventSubscriptionWebhookEndpoint(webhook, fullURL.EndpointURL)
+			}
+
+			if eventhub, ok := destination.AsEventHubEventSubscriptionDestination(); ok {
+				eventhubEndpoints = flattenEventGridEventSubscriptionEventHubEndpoint(eventhub)
+			}
+		}
+
+		if err := d.Set("webhook_endpoint", webhookEndpoints); err != nil {
+			return fmt.Errorf("Error setting `webhook_endpoint`: %+v", err)
+		}
+		if err := d.Set("eventhub_endpoint", eventhubEndpoints); err != nil {
+			return fmt.Errorf("Error setting `eventhub_endpoint`: %+v", err)
+		}
+
+		includedEventTypes, subjectFilter := flattenEventGridEventSubscriptionFilter(props.Filter)
+		if err := d.Set("included_event_types", includedEventTypes); err != nil {
+			return fmt.Errorf("Error setting `included_event_types`: %+v", err)
+		}
+		if err := d.Set("subject_filter", subjectFilter); err != nil {
+			return fmt.Errorf("Error setting `subject_filter`: %+v", err)
+		}
+
+		labels := make([]interface{}, 0)
+		if props.Labels != nil {
+			for _, label := range *props.Labels {
+				labels = append(labels, label)
+			}
+		}
+		if err := d.Set("labels", labels); err != nil {
+			return fmt.Errorf("Error setting `labels`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmEventGridEventSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).eventGridEventSubscriptionsClient
+
+	scope, name, err := parseEventGridEventSubscriptionId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := client.Delete(scope, name, cancel)
+	resp := <-deleteResp
+	err = <-deleteErr
+
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting EventGrid Event Subscription %q (Scope %q): %+v", name, scope, err)
+	}
+
+	return nil
+}
+
+// parseEventGridEventSubscriptionId splits the ID of an Event Subscription into the Scope and the Name -
+// since Event Subscriptions can be created at any scope, the ID can't be parsed using `parseAzureResourceID`
+func parseEventGridEventSubscriptionId(input string) (string, string, error) {
+	segment := "/providers/Microsoft.EventGrid/eventSubscriptions/"
+	index := strings.LastIndex(strings.ToLower(input), strings.ToLower(segment))
+	if index == -1 {
+		return "", "", fmt.Errorf("Error parsing EventGrid Event Subscription ID %q: expected it to contain %q", input, segment)
+	}
+
+	scope := input[:index]
+	name := strings.TrimSuffix(input[index+len(segment):], "/")
+	if scope == "" || name == "" || strings.Contains(name, "/") {
+		return "", "", fmt.Errorf("Error parsing EventGrid Event Subscription ID %q: expected it to be in the format `{scope}%s{name}`", input, segment)
+	}
+
+	return scope, name, nil
+}
+
+func expandEventGridEventSubscriptionDestination(d *schema.ResourceData) eventgrid.EventSubscriptionDestination {
+	if v, ok := d.GetOk("webhook_endpoint"); ok {
+		endpoints := v.([]interface{})
+		endpoint := endpoints[0].(map[string]interface{})
+
+		return eventgrid.WebHookEventSubscriptionDestination{
+			EndpointType: eventgrid.EndpointTypeWebHook,
+			WebHookEventSubscriptionDestinationProperties: &eventgrid.WebHookEventSubscriptionDestinationProperties{
+				EndpointURL: utils.String(endpoint["url"].(string)),
+			},
+		}
+	}
+
+	if v, ok := d.GetOk("eventhub_endpoint"); ok {
+		endpoints := v.([]interface{})
+		endpoint := endpoints[0].(map[string]interface{})
+
+		return eventgrid.EventHubEventSubscriptionDestination{
+			EndpointType: eventgrid.EndpointTypeEventHub,
+			EventHubEventSubscriptionDestinationProperties: &eventgrid.EventHubEventSubscriptionDestinationProperties{
+				ResourceID: utils.String(endpoint["eventhub_id"].(string)),
+			},
+		}
+	}
+
+	return nil
+}
+
+func expandEventGridEventSubscriptionFilter(d *schema.ResourceData) *eventgrid.EventSubscriptionFilter {
+	filter := eventgrid.EventSubscriptionFilter{}
+
+	if v, ok := d.GetOk("included_event_types"); ok {
+		includedEventTypes := make([]string, 0)
+		for _, eventType := range v.([]interface{}) {
+			includedEventTypes = append(includedEventTypes, eventType.(string))
+		}
+		filter.IncludedEventTypes = &includedEventTypes
+	}
+
+	if v, ok := d.GetOk("subject_filter"); ok {
+		subjectFilters := v.([]interface{})
+		if len(subjectFilters) > 0 && subjectFilters[0] != nil {
+			subjectFilter := subjectFilters[0].(map[string]interface{})
+			filter.SubjectBeginsWith = utils.String(subjectFilter["subject_begins_with"].(string))
+			filter.SubjectEndsWith = utils.String(subjectFilter["subject_ends_with"].(string))
+			filter.IsSubjectCaseSensitive = utils.Bool(subjectFilter["case_sensitive"].(bool))
+		}
+	}
+
+	return &filter
+}
+
+func expandEventGridEventSubscriptionLabels(d *schema.ResourceData) *[]string {
+	labels := make([]string, 0)
+
+	for _, label := range d.Get("labels").([]interface{}) {
+		labels = append(labels, label.(string))
+	}
+
+	return &labels
+}
+
+func flattenEventGridEventSubscriptionWebhookEndpoint(input *eventgrid.WebHookEventSubscriptionDestination, fullURL *string) []interface{} {
+	result := make(map[string]interface{}, 0)
+
+	if fullURL != nil {
+		result["url"] = *fullURL
+	}
+
+	if props := input.WebHookEventSubscriptionDestinationProperties; props != nil {
+		if props.EndpointBaseURL != nil {
+			result["base_url"] = *props.EndpointBaseURL
+		}
+	}
+
+	return []interface{}{result}
+}
+
+func flattenEventGridEventSubscriptionEventHubEndpoint(input *eventgrid.EventHubEventSubscriptionDestination) []interface{} {
+	result := make(map[string]interface{}, 0)
+
+	if props := input.EventHubEventSubscriptionDestinationProperties; props != nil {
+		if props.ResourceID != nil {
+			result["eventhub_id"] = *props.ResourceID
+		}
+	}
+
+	return []interface{}{result}
+}
+
+func flattenEventGridEventSubscriptionFilter(input *eventgrid.EventSubscriptionFilter) ([]interface{}, []interface{}) {
+	includedEventTypes := make([]interface{}, 0)
+	subjectFilters := make([]interface{}, 0)
+
+	if input == nil {
+		return includedEventTypes, subjectFilters
+	}
+
+	if input.IncludedEventTypes != nil {
+		for _, eventType := range *input.IncludedEventTypes {
+			includedEventTypes = append(includedEventTypes, eventType)
+		}
+	}
+
+	subjectFilter := make(map[string]interface{}, 0)
+	if input.SubjectBeginsWith != nil && *input.SubjectBeginsWith != "" {
+		subjectFilter["subject_begins_with"] = *input.SubjectBeginsWith
+	}
+	if input.SubjectEndsWith != nil && *input.SubjectEndsWith != "" {
+		subjectFilter["subject_ends_with"] = *input.SubjectEndsWith
+	}
+	if input.IsSubjectCaseSensitive != nil && *input.IsSubjectCaseSensitive {
+		subjectFilter["case_sensitive"] = *input.IsSubjectCaseSensitive
+	}
+	if len(subjectFilter) > 0 {
+		subjectFilters = append(subjectFilters, subjectFilter)
+	}
+
+	return includedEventTypes, subjectFilters
+}
@@ -0,0 +1,261 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestParseEventGridEventSubscriptionId(t *testing.T) {
+	testData := []struct {
+		Input         string
+		ExpectedScope string
+		ExpectedName  string
+		ExpectError   bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			ExpectError: true,
+		},
+		{
+			Input:       "/providers/Microsoft.EventGrid/eventSubscriptions/subscription1",
+			ExpectError: true,
+		},
+		{
+			Input:       "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.EventGrid/eventSubscriptions/",
+			ExpectError: true,
+		},
+		{
+			Input:         "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.EventGrid/eventSubscriptions/subscription1",
+			ExpectedScope: "/subscriptions/00000000-0000-0000-0000-000000000000",
+			ExpectedName:  "subscription1",
+		},
+		{
+			Input:         "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.EventGrid/topics/topic1/providers/Microsoft.EventGrid/eventSubscriptions/subscription1",
+			ExpectedScope: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.EventGrid/topics/topic1",
+			ExpectedName:  "subscription1",
+		},
+		{
+			Input:         "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.EventGrid/eventsubscriptions/subscription1",
+			ExpectedScope: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			ExpectedName:  "subscription1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		scope, name, err := parseEventGridEventSubscriptionId(v.Input)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.ExpectError {
+			t.Fatalf("Expected an error but didn't get one for %q", v.Input)
+		}
+
+		if scope != v.ExpectedScope {
+			t.Fatalf("Expected Scope to be %q but got %q", v.ExpectedScope, scope)
+		}
+
+		if name != v.ExpectedName {
+			t.Fatalf("Expected Name to be %q but got %q", v.ExpectedName, name)
+		}
+	}
+}
+
+func TestAccAzureRMEventGridEventSubscription_eventHub(t *testing.T) {
+	resourceName := "azurerm_eventgrid_event_subscription.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMEventGridEventSubscription_eventHub(ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMEventGridEventSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventGridEventSubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "eventhub_endpoint.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "webhook_endpoint.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "topic_name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMEventGridEventSubscription_filter(t *testing.T) {
+	resourceName := "azurerm_eventgrid_event_subscription.test"
+	ri := acctest.RandInt()
+	preConfig := testAccAzureRMEventGridEventSubscription_eventHub(ri)
+	postConfig := testAccAzureRMEventGridEventSubscription_filter(ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMEventGridEventSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventGridEventSubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subject_filter.#", "0"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventGridEventSubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "included_event_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "included_event_types.0", "example.event"),
+					resource.TestCheckResourceAttr(resourceName, "subject_filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "subject_filter.0.subject_begins_with", "orders/"),
+					resource.TestCheckResourceAttr(resourceName, "subject_filter.0.subject_ends_with", ".json"),
+					resource.TestCheckResourceAttr(resourceName, "subject_filter.0.case_sensitive", "true"),
+					resource.TestCheckResourceAttr(resourceName, "labels.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMEventGridEventSubscriptionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).eventGridEventSubscriptionsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_eventgrid_event_subscription" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		scope := rs.Primary.Attributes["scope"]
+
+		resp, err := client.Get(scope, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("EventGrid Event Subscription still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMEventGridEventSubscriptionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		scope, hasScope := rs.Primary.Attributes["scope"]
+		if !hasScope {
+			return fmt.Errorf("Bad: no scope found in state for EventGrid Event Subscription: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).eventGridEventSubscriptionsClient
+		resp, err := client.Get(scope, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: EventGrid Event Subscription %q (scope: %s) does not exist", name, scope)
+			}
+
+			return fmt.Errorf("Bad: Get on eventGridEventSubscriptionsClient: %s", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMEventGridEventSubscription_template(rInt int) string {
+	// currently only supported in "West Central US" & "West US 2"
+	location := "westus2"
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_eventgrid_topic" "test" {
+  name                = "acctesteg-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub" "test" {
+  name                = "acctesteventhub-%d"
+  namespace_name      = "${azurerm_eventhub_namespace.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  partition_count     = 2
+  message_retention   = 1
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMEventGridEventSubscription_eventHub(rInt int) string {
+	template := testAccAzureRMEventGridEventSubscription_template(rInt)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_event_subscription" "test" {
+  name  = "acctestegsub-%d"
+  scope = "${azurerm_eventgrid_topic.test.id}"
+
+  eventhub_endpoint {
+    eventhub_id = "${azurerm_eventhub.test.id}"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMEventGridEventSubscription_filter(rInt int) string {
+	template := testAccAzureRMEventGridEventSubscription_template(rInt)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_event_subscription" "test" {
+  name                 = "acctestegsub-%d"
+  scope                = "${azurerm_eventgrid_topic.test.id}"
+  included_event_types = ["example.event"]
+  labels               = ["test", "test2"]
+
+  eventhub_endpoint {
+    eventhub_id = "${azurerm_eventhub.test.id}"
+  }
+
+  subject_filter {
+    subject_begins_with = "orders/"
+    subject_ends_with   = ".json"
+    case_sensitive      = true
+  }
+}
+`, template, rInt)
+}
@@ -16,7 +16,7 @@ func resourceArmEventGridTopic() *schema.Resource {
 		Update: resourceArmEventGridTopicCreateUpdate,
 		Delete: resourceArmEventGridTopicDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("topics"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -63,12 +63,14 @@ func resourceArmEventGridTopicCreateUpdate(d *schema.ResourceData, meta interfac
 	properties := eventgrid.Topic{
 		Location:        &location,
 		TopicProperties: &eventgrid.TopicProperties{},
-		Tags:            expandTags(tags),
+		Tags:            expandResourceTags(d, tags, meta),
 	}
 
 	log.Printf("[INFO] preparing arguments for AzureRM EventGrid Topic creation with Properties: %+v.", properties)
 
-	_, createErr := client.CreateOrUpdate(resourceGroup, name, properties, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.CreateOrUpdate(resourceGroup, name, properties, cancel)
 	err := <-createErr
 	if err != nil {
 		return err
@@ -124,7 +126,7 @@ func resourceArmEventGridTopicRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("primary_access_key", keys.Key1)
 	d.Set("secondary_access_key", keys.Key2)
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -139,7 +141,9 @@ func resourceArmEventGridTopicDelete(d *schema.ResourceData, meta interface{}) e
 	resGroup := id.ResourceGroup
 	name := id.Path["topics"]
 
-	deleteResp, deleteErr := client.Delete(resGroup, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := client.Delete(resGroup, name, cancel)
 	resp := <-deleteResp
 	err = <-deleteErr
 
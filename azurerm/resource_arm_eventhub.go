@@ -17,7 +17,7 @@ func resourceArmEventHub() *schema.Resource {
 		Update: resourceArmEventHubCreate,
 		Delete: resourceArmEventHubDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("namespaces", "eventhubs"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -17,7 +17,7 @@ func resourceArmEventHubAuthorizationRule() *schema.Resource {
 		Update: resourceArmEventHubAuthorizationRuleCreateUpdate,
 		Delete: resourceArmEventHubAuthorizationRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("namespaces", "eventhubs", "authorizationRules"),
 		},
 
 		Schema: map[string]*schema.Schema{
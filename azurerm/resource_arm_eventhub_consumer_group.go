@@ -16,7 +16,7 @@ func resourceArmEventHubConsumerGroup() *schema.Resource {
 		Update: resourceArmEventHubConsumerGroupCreateUpdate,
 		Delete: resourceArmEventHubConsumerGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("namespaces", "eventhubs", "consumergroups"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -23,7 +23,7 @@ func resourceArmEventHubNamespace() *schema.Resource {
 		Update: resourceArmEventHubNamespaceCreate,
 		Delete: resourceArmEventHubNamespaceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("namespaces"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -95,10 +95,12 @@ func resourceArmEventHubNamespaceCreate(d *schema.ResourceData, meta interface{}
 			Tier:     eventhub.SkuTier(sku),
 			Capacity: &capacity,
 		},
-		Tags: expandTags(tags),
+		Tags: expandResourceTags(d, tags, meta),
 	}
 
-	_, error := namespaceClient.CreateOrUpdate(resGroup, name, parameters, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, error := namespaceClient.CreateOrUpdate(resGroup, name, parameters, cancel)
 	err := <-error
 	if err != nil {
 		return err
@@ -153,7 +155,7 @@ func resourceArmEventHubNamespaceRead(d *schema.ResourceData, meta interface{})
 		d.Set("default_secondary_key", keys.SecondaryKey)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -168,7 +170,9 @@ func resourceArmEventHubNamespaceDelete(d *schema.ResourceData, meta interface{}
 	resGroup := id.ResourceGroup
 	name := id.Path["namespaces"]
 
-	deleteResp, error := namespaceClient.Delete(resGroup, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, error := namespaceClient.Delete(resGroup, name, cancel)
 	resp := <-deleteResp
 	err = <-error
 
@@ -21,7 +21,7 @@ func resourceArmExpressRouteCircuit() *schema.Resource {
 		Update: resourceArmExpressRouteCircuitCreateOrUpdate,
 		Delete: resourceArmExpressRouteCircuitDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("expressRouteCircuits"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -121,7 +121,7 @@ func resourceArmExpressRouteCircuitCreateOrUpdate(d *schema.ResourceData, meta i
 	sku := expandExpressRouteCircuitSku(d)
 	allowRdfeOps := d.Get("allow_classic_operations").(bool)
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandResourceTags(d, tags, meta)
 
 	erc := network.ExpressRouteCircuit{
 		Name:     &name,
@@ -138,7 +138,9 @@ func resourceArmExpressRouteCircuitCreateOrUpdate(d *schema.ResourceData, meta i
 		Tags: expandedTags,
 	}
 
-	_, error := ercClient.CreateOrUpdate(resGroup, name, erc, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, error := ercClient.CreateOrUpdate(resGroup, name, erc, cancel)
 	err := <-error
 	if err != nil {
 		return errwrap.Wrapf("Error Creating/Updating ExpressRouteCircuit {{err}}", err)
@@ -187,7 +189,7 @@ func resourceArmExpressRouteCircuitRead(d *schema.ResourceData, meta interface{}
 	d.Set("service_key", erc.ServiceKey)
 	d.Set("allow_classic_operations", erc.AllowClassicOperations)
 
-	flattenAndSetTags(d, erc.Tags)
+	flattenAndSetResourceTags(d, erc.Tags, meta)
 
 	return nil
 }
@@ -200,7 +202,9 @@ func resourceArmExpressRouteCircuitDelete(d *schema.ResourceData, meta interface
 		return errwrap.Wrapf("Error Parsing Azure Resource ID {{err}}", err)
 	}
 
-	_, error := ercClient.Delete(resGroup, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	_, error := ercClient.Delete(resGroup, name, cancel)
 	err = <-error
 	return err
 }
@@ -0,0 +1,178 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/hdinsight"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmHDInsightInteractiveQueryCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmHDInsightInteractiveQueryClusterCreate,
+		Read:   resourceArmHDInsightInteractiveQueryClusterRead,
+		Update: hdInsightClusterUpdate("Interactive Query", resourceArmHDInsightInteractiveQueryClusterRead),
+		Delete: hdInsightClusterDelete("Interactive Query"),
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("clusters"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": hdInsightNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"cluster_version": hdInsightClusterVersionSchema(),
+
+			"tier": hdInsightTierSchema(),
+
+			"component_version": hdInsightComponentVersionSchema("interactive_hive"),
+
+			"gateway": hdInsightGatewaySchema(),
+
+			"metastores": hdInsightMetastoresSchema(),
+
+			"storage_account": hdInsightStorageAccountsSchema(),
+
+			"roles": hdInsightRolesSchema(),
+
+			"tags": tagsSchema(),
+
+			"https_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ssh_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmHDInsightInteractiveQueryClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).hdinsightClustersClient
+	storageEndpointSuffix := meta.(*ArmClient).environment.StorageEndpointSuffix
+	log.Printf("[INFO] preparing arguments for AzureRM HDInsight Interactive Query Cluster creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	clusterVersion := d.Get("cluster_version").(string)
+	tier := hdinsight.Tier(d.Get("tier").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	componentVersions := expandHDInsightComponentVersion("interactive_hive", "InteractiveHive", d.Get("component_version").([]interface{}))
+	configurations := expandHDInsightConfigurations(d.Get("gateway").([]interface{}), d.Get("metastores").([]interface{}))
+	storageAccounts := expandHDInsightStorageAccounts(d.Get("storage_account").([]interface{}), storageEndpointSuffix)
+	roles := expandHDInsightRoles(d.Get("roles").([]interface{}))
+
+	params := hdinsight.ClusterCreateParametersExtended{
+		Location: utils.String(location),
+		Properties: &hdinsight.ClusterCreateProperties{
+			Tier:           tier,
+			OsType:         hdinsight.Linux,
+			ClusterVersion: utils.String(clusterVersion),
+			ClusterDefinition: &hdinsight.ClusterDefinition{
+				Kind:             utils.String("INTERACTIVEHIVE"),
+				ComponentVersion: componentVersions,
+				Configurations:   configurations,
+			},
+			StorageProfile: &hdinsight.StorageProfile{
+				Storageaccounts: storageAccounts,
+			},
+			ComputeProfile: &hdinsight.ComputeProfile{
+				Roles: roles,
+			},
+		},
+		Tags: expandResourceTags(d, tags, meta),
+	}
+
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, errChan := client.Create(resourceGroup, name, params, cancel)
+	if err := <-errChan; err != nil {
+		return fmt.Errorf("Error creating HDInsight Interactive Query Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving HDInsight Interactive Query Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Error reading ID for HDInsight Interactive Query Cluster %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmHDInsightInteractiveQueryClusterRead(d, meta)
+}
+
+func resourceArmHDInsightInteractiveQueryClusterRead(d *schema.ResourceData, meta interface{}) error {
+	clustersClient := meta.(*ArmClient).hdinsightClustersClient
+	configurationsClient := meta.(*ArmClient).hdinsightConfigurationsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	name := id.Path["clusters"]
+
+	resp, err := clustersClient.Get(resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] HDInsight Interactive Query Cluster %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving HDInsight Interactive Query Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	gateway, err := configurationsClient.Get(resourceGroup, name, hdinsight.Gateway)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Gateway Configuration for HDInsight Interactive Query Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	// storage accounts and metastores can't be retrieved from the API
+	if props := resp.Properties; props != nil {
+		d.Set("cluster_version", props.ClusterVersion)
+		d.Set("tier", string(props.Tier))
+
+		if def := props.ClusterDefinition; def != nil {
+			if err := d.Set("component_version", flattenHDInsightComponentVersion("interactive_hive", "InteractiveHive", def.ComponentVersion)); err != nil {
+				return fmt.Errorf("Error setting `component_version`: %+v", err)
+			}
+		}
+
+		if err := d.Set("gateway", flattenHDInsightGateway(gateway, d)); err != nil {
+			return fmt.Errorf("Error setting `gateway`: %+v", err)
+		}
+
+		if err := d.Set("roles", flattenHDInsightRoles(d, props.ComputeProfile)); err != nil {
+			return fmt.Errorf("Error setting `roles`: %+v", err)
+		}
+
+		httpEndpoint, sshEndpoint := flattenHDInsightConnectivityEndpoints(props.ConnectivityEndpoints)
+		d.Set("https_endpoint", httpEndpoint)
+		d.Set("ssh_endpoint", sshEndpoint)
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
@@ -17,7 +17,7 @@ func resourceArmImage() *schema.Resource {
 		Update: resourceArmImageCreateUpdate,
 		Delete: resourceArmImageDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("images"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -156,7 +156,7 @@ func resourceArmImageCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandResourceTags(d, tags, meta)
 	properties := compute.ImageProperties{}
 
 	osDisk, err := expandAzureRmImageOsDisk(d)
@@ -206,7 +206,9 @@ func resourceArmImageCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 		ImageProperties: &properties,
 	}
 
-	_, imageErr := imageClient.CreateOrUpdate(resGroup, name, createImage, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, imageErr := imageClient.CreateOrUpdate(resGroup, name, createImage, cancel)
 	err = <-imageErr
 	if err != nil {
 		return err
@@ -265,7 +267,7 @@ func resourceArmImageRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -280,7 +282,9 @@ func resourceArmImageDelete(d *schema.ResourceData, meta interface{}) error {
 	resGroup := id.ResourceGroup
 	name := id.Path["images"]
 
-	_, deleteErr := imageClient.Delete(resGroup, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	_, deleteErr := imageClient.Delete(resGroup, name, cancel)
 	err = <-deleteErr
 	if err != nil {
 		return err
@@ -0,0 +1,645 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/iothub"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmIotHub() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmIotHubCreateAndUpdate,
+		Read:   resourceArmIotHubRead,
+		Update: resourceArmIotHubCreateAndUpdate,
+		Delete: resourceArmIotHubDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("IotHubs"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"sku": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(iothub.F1),
+								string(iothub.S1),
+								string(iothub.S2),
+								string(iothub.S3),
+							}, true),
+						},
+
+						"tier": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(iothub.Free),
+								string(iothub.Standard),
+							}, true),
+						},
+
+						"capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"endpoint": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								"AzureIotHub.StorageContainer",
+								"AzureIotHub.ServiceBusQueue",
+								"AzureIotHub.ServiceBusTopic",
+								"AzureIotHub.EventHub",
+							}, true),
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"connection_string": {
+							Type:             schema.TypeString,
+							Required:         true,
+							Sensitive:        true,
+							DiffSuppressFunc: iotHubConnectionStringDiffSuppressFunc,
+						},
+
+						"batch_frequency_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(60, 720),
+						},
+
+						"max_chunk_size_in_bytes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(10485760, 524288000),
+						},
+
+						"container_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"encoding": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+						},
+
+						"file_name_format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"route": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"source": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(iothub.DeviceJobLifecycleEvents),
+								string(iothub.DeviceLifecycleEvents),
+								string(iothub.DeviceMessages),
+								string(iothub.TwinChangeEvents),
+							}, true),
+						},
+
+						"condition": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"endpoint_names": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"event_hub_events_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"event_hub_events_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"event_hub_operations_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"event_hub_operations_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"shared_access_policy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"primary_key": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+
+						"secondary_key": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+
+						"permissions": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmIotHubCreateAndUpdate(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	client := armClient.iothubResourceClient
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	if d.IsNewResource() {
+		available, err := client.CheckNameAvailability(iothub.OperationInputs{
+			Name: utils.String(name),
+		})
+		if err != nil {
+			return fmt.Errorf("Error checking if IoTHub name %q is available: %+v", name, err)
+		}
+
+		if available.NameAvailable != nil && !*available.NameAvailable {
+			return fmt.Errorf("The name %q for the IoTHub is not available: %s", name, utils.NormalizeNilableString(available.Message))
+		}
+	}
+
+	routingProperties := iothub.RoutingProperties{
+		Endpoints: expandIoTHubEndpoints(d, armClient.subscriptionId),
+		Routes:    expandIoTHubRoutes(d),
+	}
+
+	properties := iothub.Description{
+		Name:           utils.String(name),
+		Location:       utils.String(location),
+		Subscriptionid: utils.String(armClient.subscriptionId),
+		Resourcegroup:  utils.String(resourceGroup),
+		Sku:            expandIoTHubSku(d),
+		Properties: &iothub.Properties{
+			Routing: &routingProperties,
+		},
+		Tags: expandResourceTags(d, tags, meta),
+	}
+
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.CreateOrUpdate(resourceGroup, name, properties, cancel)
+	err := <-createErr
+	if err != nil {
+		return fmt.Errorf("Error creating/updating IoTHub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving IoTHub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read IoTHub %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmIotHubRead(d, meta)
+}
+
+func resourceArmIotHubRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).iothubResourceClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["IotHubs"]
+
+	resp, err := client.Get(resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] IoTHub %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving IoTHub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	keys, err := client.ListKeys(resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error listing Shared Access Policies for IoTHub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+	d.Set("type", resp.Type)
+
+	if err := d.Set("sku", flattenIoTHubSku(resp.Sku)); err != nil {
+		return fmt.Errorf("Error setting `sku`: %+v", err)
+	}
+
+	if props := resp.Properties; props != nil {
+		d.Set("hostname", props.HostName)
+
+		if endpoints := props.EventHubEndpoints; endpoints != nil {
+			for key, endpoint := range *endpoints {
+				if endpoint == nil {
+					continue
+				}
+
+				switch strings.ToLower(key) {
+				case "events":
+					d.Set("event_hub_events_endpoint", endpoint.Endpoint)
+					d.Set("event_hub_events_path", endpoint.Path)
+				case "operationsmonitoringevents":
+					d.Set("event_hub_operations_endpoint", endpoint.Endpoint)
+					d.Set("event_hub_operations_path", endpoint.Path)
+				}
+			}
+		}
+
+		endpoints, routes := flattenIoTHubRouting(props.Routing)
+		if err := d.Set("endpoint", endpoints); err != nil {
+			return fmt.Errorf("Error setting `endpoint`: %+v", err)
+		}
+		if err := d.Set("route", routes); err != nil {
+			return fmt.Errorf("Error setting `route`: %+v", err)
+		}
+	}
+
+	if err := d.Set("shared_access_policy", flattenIoTHubSharedAccessPolicies(keys.Value)); err != nil {
+		return fmt.Errorf("Error setting `shared_access_policy`: %+v", err)
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmIotHubDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).iothubResourceClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["IotHubs"]
+
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := client.Delete(resourceGroup, name, cancel)
+	resp := <-deleteResp
+	err = <-deleteErr
+
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting IoTHub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// iotHubConnectionStringDiffSuppressFunc ignores the difference between the Connection String specified
+// in the configuration and the one returned from the API, where the secret is masked
+func iotHubConnectionStringDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	secretKeyRegex := regexp.MustCompile("(SharedAccessKey|AccountKey)=[^;]+")
+	maskedNew := secretKeyRegex.ReplaceAllString(new, "$1=****")
+
+	return old == maskedNew
+}
+
+func expandIoTHubSku(d *schema.ResourceData) *iothub.SkuInfo {
+	skuList := d.Get("sku").([]interface{})
+	skuMap := skuList[0].(map[string]interface{})
+
+	return &iothub.SkuInfo{
+		Name:     iothub.Sku(skuMap["name"].(string)),
+		Tier:     iothub.SkuTier(skuMap["tier"].(string)),
+		Capacity: utils.Int64(int64(skuMap["capacity"].(int))),
+	}
+}
+
+func expandIoTHubEndpoints(d *schema.ResourceData, subscriptionId string) *iothub.RoutingEndpoints {
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	serviceBusQueueEndpoints := make([]iothub.RoutingServiceBusQueueEndpointProperties, 0)
+	serviceBusTopicEndpoints := make([]iothub.RoutingServiceBusTopicEndpointProperties, 0)
+	eventHubEndpoints := make([]iothub.RoutingEventHubProperties, 0)
+	storageContainerEndpoints := make([]iothub.RoutingStorageContainerProperties, 0)
+
+	for _, v := range d.Get("endpoint").([]interface{}) {
+		endpoint := v.(map[string]interface{})
+
+		endpointType := strings.ToLower(endpoint["type"].(string))
+		name := endpoint["name"].(string)
+		connectionString := endpoint["connection_string"].(string)
+
+		switch endpointType {
+		case "azureiothub.storagecontainer":
+			storageContainer := iothub.RoutingStorageContainerProperties{
+				Name:             utils.String(name),
+				ConnectionString: utils.String(connectionString),
+				SubscriptionID:   utils.String(subscriptionId),
+				ResourceGroup:    utils.String(resourceGroup),
+				ContainerName:    utils.String(endpoint["container_name"].(string)),
+			}
+
+			// the API applies a default for these when they're omitted
+			if batchFrequency := endpoint["batch_frequency_in_seconds"].(int); batchFrequency != 0 {
+				storageContainer.BatchFrequencyInSeconds = utils.Int32(int32(batchFrequency))
+			}
+			if maxChunkSize := endpoint["max_chunk_size_in_bytes"].(int); maxChunkSize != 0 {
+				storageContainer.MaxChunkSizeInBytes = utils.Int32(int32(maxChunkSize))
+			}
+			if fileNameFormat := endpoint["file_name_format"].(string); fileNameFormat != "" {
+				storageContainer.FileNameFormat = utils.String(fileNameFormat)
+			}
+			if encoding := endpoint["encoding"].(string); encoding != "" {
+				storageContainer.Encoding = utils.String(encoding)
+			}
+
+			storageContainerEndpoints = append(storageContainerEndpoints, storageContainer)
+
+		case "azureiothub.servicebusqueue":
+			serviceBusQueueEndpoints = append(serviceBusQueueEndpoints, iothub.RoutingServiceBusQueueEndpointProperties{
+				Name:             utils.String(name),
+				ConnectionString: utils.String(connectionString),
+				SubscriptionID:   utils.String(subscriptionId),
+				ResourceGroup:    utils.String(resourceGroup),
+			})
+
+		case "azureiothub.servicebustopic":
+			serviceBusTopicEndpoints = append(serviceBusTopicEndpoints, iothub.RoutingServiceBusTopicEndpointProperties{
+				Name:             utils.String(name),
+				ConnectionString: utils.String(connectionString),
+				SubscriptionID:   utils.String(subscriptionId),
+				ResourceGroup:    utils.String(resourceGroup),
+			})
+
+		case "azureiothub.eventhub":
+			eventHubEndpoints = append(eventHubEndpoints, iothub.RoutingEventHubProperties{
+				Name:             utils.String(name),
+				ConnectionString: utils.String(connectionString),
+				SubscriptionID:   utils.String(subscriptionId),
+				ResourceGroup:    utils.String(resourceGroup),
+			})
+		}
+	}
+
+	return &iothub.RoutingEndpoints{
+		ServiceBusQueues:  &serviceBusQueueEndpoints,
+		ServiceBusTopics:  &serviceBusTopicEndpoints,
+		EventHubs:         &eventHubEndpoints,
+		StorageContainers: &storageContainerEndpoints,
+	}
+}
+
+func expandIoTHubRoutes(d *schema.ResourceData) *[]iothub.RouteProperties {
+	routes := make([]iothub.RouteProperties, 0)
+
+	for _, v := range d.Get("route").([]interface{}) {
+		route := v.(map[string]interface{})
+
+		endpointNames := make([]string, 0)
+		for _, endpointName := range route["endpoint_names"].([]interface{}) {
+			endpointNames = append(endpointNames, endpointName.(string))
+		}
+
+		routes = append(routes, iothub.RouteProperties{
+			Name:          utils.String(route["name"].(string)),
+			Source:        iothub.RoutingSource(route["source"].(string)),
+			Condition:     utils.String(route["condition"].(string)),
+			EndpointNames: &endpointNames,
+			IsEnabled:     utils.Bool(route["enabled"].(bool)),
+		})
+	}
+
+	return &routes
+}
+
+func flattenIoTHubSku(input *iothub.SkuInfo) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{}, 0)
+	output["name"] = string(input.Name)
+	output["tier"] = string(input.Tier)
+	if capacity := input.Capacity; capacity != nil {
+		output["capacity"] = int(*capacity)
+	}
+
+	return []interface{}{output}
+}
+
+func flattenIoTHubRouting(input *iothub.RoutingProperties) ([]interface{}, []interface{}) {
+	endpoints := make([]interface{}, 0)
+	routes := make([]interface{}, 0)
+
+	if input == nil {
+		return endpoints, routes
+	}
+
+	if input.Endpoints != nil {
+		if containers := input.Endpoints.StorageContainers; containers != nil {
+			for _, container := range *containers {
+				output := map[string]interface{}{
+					"type":              "AzureIotHub.StorageContainer",
+					"name":              utils.NormalizeNilableString(container.Name),
+					"connection_string": utils.NormalizeNilableString(container.ConnectionString),
+					"container_name":    utils.NormalizeNilableString(container.ContainerName),
+					"file_name_format":  utils.NormalizeNilableString(container.FileNameFormat),
+					"encoding":          utils.NormalizeNilableString(container.Encoding),
+				}
+				if container.BatchFrequencyInSeconds != nil {
+					output["batch_frequency_in_seconds"] = int(*container.BatchFrequencyInSeconds)
+				}
+				if container.MaxChunkSizeInBytes != nil {
+					output["max_chunk_size_in_bytes"] = int(*container.MaxChunkSizeInBytes)
+				}
+
+				endpoints = append(endpoints, output)
+			}
+		}
+
+		if queues := input.Endpoints.ServiceBusQueues; queues != nil {
+			for _, queue := range *queues {
+				endpoints = append(endpoints, map[string]interface{}{
+					"type":              "AzureIotHub.ServiceBusQueue",
+					"name":              utils.NormalizeNilableString(queue.Name),
+					"connection_string": utils.NormalizeNilableString(queue.ConnectionString),
+				})
+			}
+		}
+
+		if topics := input.Endpoints.ServiceBusTopics; topics != nil {
+			for _, topic := range *topics {
+				endpoints = append(endpoints, map[string]interface{}{
+					"type":              "AzureIotHub.ServiceBusTopic",
+					"name":              utils.NormalizeNilableString(topic.Name),
+					"connection_string": utils.NormalizeNilableString(topic.ConnectionString),
+				})
+			}
+		}
+
+		if eventHubs := input.Endpoints.EventHubs; eventHubs != nil {
+			for _, eventHub := range *eventHubs {
+				endpoints = append(endpoints, map[string]interface{}{
+					"type":              "AzureIotHub.EventHub",
+					"name":              utils.NormalizeNilableString(eventHub.Name),
+					"connection_string": utils.NormalizeNilableString(eventHub.ConnectionString),
+				})
+			}
+		}
+	}
+
+	if input.Routes != nil {
+		for _, route := range *input.Routes {
+			output := map[string]interface{}{
+				"name":      utils.NormalizeNilableString(route.Name),
+				"source":    string(route.Source),
+				"condition": utils.NormalizeNilableString(route.Condition),
+			}
+
+			endpointNames := make([]interface{}, 0)
+			if route.EndpointNames != nil {
+				for _, endpointName := range *route.EndpointNames {
+					endpointNames = append(endpointNames, endpointName)
+				}
+			}
+			output["endpoint_names"] = endpointNames
+
+			if route.IsEnabled != nil {
+				output["enabled"] = *route.IsEnabled
+			}
+
+			routes = append(routes, output)
+		}
+	}
+
+	return endpoints, routes
+}
+
+func flattenIoTHubSharedAccessPolicies(input *[]iothub.SharedAccessSignatureAuthorizationRule) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input == nil {
+		return results
+	}
+
+	for _, policy := range *input {
+		results = append(results, map[string]interface{}{
+			"key_name":      utils.NormalizeNilableString(policy.KeyName),
+			"primary_key":   utils.NormalizeNilableString(policy.PrimaryKey),
+			"secondary_key": utils.NormalizeNilableString(policy.SecondaryKey),
+			"permissions":   string(policy.Rights),
+		})
+	}
+
+	return results
+}
@@ -23,6 +23,8 @@ import (
 // https://github.com/Azure/azure-rest-api-specs/blob/master/arm-keyvault/2015-06-01/swagger/keyvault.json#L239
 var armKeyVaultSkuFamily = "A"
 
+var keyVaultResourceName = "azurerm_key_vault"
+
 func resourceArmKeyVault() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmKeyVaultCreate,
@@ -30,7 +32,7 @@ func resourceArmKeyVault() *schema.Resource {
 		Update: resourceArmKeyVaultCreate,
 		Delete: resourceArmKeyVaultDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("vaults"),
 		},
 		MigrateState:  resourceAzureRMKeyVaultMigrateState,
 		SchemaVersion: 1,
@@ -78,7 +80,7 @@ func resourceArmKeyVault() *schema.Resource {
 			"access_policy": {
 				Type:     schema.TypeList,
 				Optional: true,
-				MinItems: 1,
+				Computed: true,
 				MaxItems: 16,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -97,72 +99,9 @@ func resourceArmKeyVault() *schema.Resource {
 							Optional:     true,
 							ValidateFunc: validateUUID,
 						},
-						"certificate_permissions": {
-							Type:     schema.TypeList,
-							Optional: true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-								ValidateFunc: validation.StringInSlice([]string{
-									string(keyvault.Create),
-									string(keyvault.Delete),
-									string(keyvault.Deleteissuers),
-									string(keyvault.Get),
-									string(keyvault.Getissuers),
-									string(keyvault.Import),
-									string(keyvault.List),
-									string(keyvault.Listissuers),
-									string(keyvault.Managecontacts),
-									string(keyvault.Manageissuers),
-									string(keyvault.Setissuers),
-									string(keyvault.Update),
-								}, true),
-								DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
-							},
-						},
-						"key_permissions": {
-							Type:     schema.TypeList,
-							Required: true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-								ValidateFunc: validation.StringInSlice([]string{
-									string(keyvault.KeyPermissionsBackup),
-									string(keyvault.KeyPermissionsCreate),
-									string(keyvault.KeyPermissionsDecrypt),
-									string(keyvault.KeyPermissionsDelete),
-									string(keyvault.KeyPermissionsEncrypt),
-									string(keyvault.KeyPermissionsGet),
-									string(keyvault.KeyPermissionsImport),
-									string(keyvault.KeyPermissionsList),
-									string(keyvault.KeyPermissionsPurge),
-									string(keyvault.KeyPermissionsRecover),
-									string(keyvault.KeyPermissionsRestore),
-									string(keyvault.KeyPermissionsSign),
-									string(keyvault.KeyPermissionsUnwrapKey),
-									string(keyvault.KeyPermissionsUpdate),
-									string(keyvault.KeyPermissionsVerify),
-									string(keyvault.KeyPermissionsWrapKey),
-								}, true),
-								DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
-							},
-						},
-						"secret_permissions": {
-							Type:     schema.TypeList,
-							Required: true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-								ValidateFunc: validation.StringInSlice([]string{
-									string(keyvault.SecretPermissionsBackup),
-									string(keyvault.SecretPermissionsDelete),
-									string(keyvault.SecretPermissionsGet),
-									string(keyvault.SecretPermissionsList),
-									string(keyvault.SecretPermissionsPurge),
-									string(keyvault.SecretPermissionsRecover),
-									string(keyvault.SecretPermissionsRestore),
-									string(keyvault.SecretPermissionsSet),
-								}, true),
-								DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
-							},
-						},
+						"certificate_permissions": keyVaultCertificatePermissionsSchema(),
+						"key_permissions":         keyVaultKeyPermissionsSchema(),
+						"secret_permissions":      keyVaultSecretPermissionsSchema(),
 					},
 				},
 			},
@@ -200,17 +139,34 @@ func resourceArmKeyVaultCreate(d *schema.ResourceData, meta interface{}) error {
 	enabledForTemplateDeployment := d.Get("enabled_for_template_deployment").(bool)
 	tags := d.Get("tags").(map[string]interface{})
 
+	// Access Policies can also be managed by the `azurerm_key_vault_access_policy` resource
+	azureRMLockByName(name, keyVaultResourceName)
+	defer azureRMUnlockByName(name, keyVaultResourceName)
+
+	accessPolicies := expandKeyVaultAccessPolicies(d)
+	if !d.IsNewResource() && !d.HasChange("access_policy") {
+		// use the current policies so we don't overwrite any added outside of this resource since it was last read
+		existing, err := client.Get(resGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if props := existing.Properties; props != nil && props.AccessPolicies != nil {
+			accessPolicies = props.AccessPolicies
+		}
+	}
+
 	parameters := keyvault.VaultCreateOrUpdateParameters{
 		Location: &location,
 		Properties: &keyvault.VaultProperties{
 			TenantID:                     &tenantUUID,
 			Sku:                          expandKeyVaultSku(d),
-			AccessPolicies:               expandKeyVaultAccessPolicies(d),
+			AccessPolicies:               accessPolicies,
 			EnabledForDeployment:         &enabledForDeployment,
 			EnabledForDiskEncryption:     &enabledForDiskEncryption,
 			EnabledForTemplateDeployment: &enabledForTemplateDeployment,
 		},
-		Tags: expandTags(tags),
+		Tags: expandResourceTags(d, tags, meta),
 	}
 
 	_, err := client.CreateOrUpdate(resGroup, name, parameters)
@@ -272,7 +228,7 @@ func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("access_policy", flattenKeyVaultAccessPolicies(resp.Properties.AccessPolicies))
 	d.Set("vault_uri", resp.Properties.VaultURI)
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -287,9 +243,110 @@ func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 	resGroup := id.ResourceGroup
 	name := id.Path["vaults"]
 
-	_, err = client.Delete(resGroup, name)
+	read, err := client.Get(resGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	if _, err = client.Delete(resGroup, name); err != nil {
+		return fmt.Errorf("Error deleting Key Vault %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	softDeleteEnabled := read.Properties != nil && read.Properties.EnableSoftDelete != nil && *read.Properties.EnableSoftDelete
+	if softDeleteEnabled && meta.(*ArmClient).features.KeyVault.PurgeSoftDeleteOnDestroy {
+		if read.Location == nil {
+			return fmt.Errorf("Error purging Key Vault %q (Resource Group %q): `location` was nil", name, resGroup)
+		}
+
+		log.Printf("[DEBUG] Purging soft-deleted Key Vault %q (Resource Group %q)", name, resGroup)
+		cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+		defer release()
+		_, purgeErr := client.PurgeDeleted(name, *read.Location, cancel)
+		if err := <-purgeErr; err != nil {
+			return fmt.Errorf("Error purging soft-deleted Key Vault %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func keyVaultCertificatePermissionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(keyvault.Create),
+				string(keyvault.Delete),
+				string(keyvault.Deleteissuers),
+				string(keyvault.Get),
+				string(keyvault.Getissuers),
+				string(keyvault.Import),
+				string(keyvault.List),
+				string(keyvault.Listissuers),
+				string(keyvault.Managecontacts),
+				string(keyvault.Manageissuers),
+				string(keyvault.Setissuers),
+				string(keyvault.Update),
+			}, true),
+			DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+		},
+	}
+}
+
+func keyVaultKeyPermissionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(keyvault.KeyPermissionsBackup),
+				string(keyvault.KeyPermissionsCreate),
+				string(keyvault.KeyPermissionsDecrypt),
+				string(keyvault.KeyPermissionsDelete),
+				string(keyvault.KeyPermissionsEncrypt),
+				string(keyvault.KeyPermissionsGet),
+				string(keyvault.KeyPermissionsImport),
+				string(keyvault.KeyPermissionsList),
+				string(keyvault.KeyPermissionsPurge),
+				string(keyvault.KeyPermissionsRecover),
+				string(keyvault.KeyPermissionsRestore),
+				string(keyvault.KeyPermissionsSign),
+				string(keyvault.KeyPermissionsUnwrapKey),
+				string(keyvault.KeyPermissionsUpdate),
+				string(keyvault.KeyPermissionsVerify),
+				string(keyvault.KeyPermissionsWrapKey),
+			}, true),
+			DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+		},
+	}
+}
 
-	return err
+func keyVaultSecretPermissionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(keyvault.SecretPermissionsBackup),
+				string(keyvault.SecretPermissionsDelete),
+				string(keyvault.SecretPermissionsGet),
+				string(keyvault.SecretPermissionsList),
+				string(keyvault.SecretPermissionsPurge),
+				string(keyvault.SecretPermissionsRecover),
+				string(keyvault.SecretPermissionsRestore),
+				string(keyvault.SecretPermissionsSet),
+			}, true),
+			DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+		},
+	}
 }
 
 func expandKeyVaultSku(d *schema.ResourceData) *keyvault.Sku {
@@ -308,47 +365,50 @@ func expandKeyVaultAccessPolicies(d *schema.ResourceData) *[]keyvault.AccessPoli
 
 	for _, policySet := range policies {
 		policyRaw := policySet.(map[string]interface{})
+		result = append(result, expandKeyVaultAccessPolicy(policyRaw))
+	}
 
-		certificatePermissionsRaw := policyRaw["certificate_permissions"].([]interface{})
-		certificatePermissions := []keyvault.CertificatePermissions{}
-		for _, permission := range certificatePermissionsRaw {
-			certificatePermissions = append(certificatePermissions, keyvault.CertificatePermissions(permission.(string)))
-		}
+	return &result
+}
 
-		keyPermissionsRaw := policyRaw["key_permissions"].([]interface{})
-		keyPermissions := []keyvault.KeyPermissions{}
-		for _, permission := range keyPermissionsRaw {
-			keyPermissions = append(keyPermissions, keyvault.KeyPermissions(permission.(string)))
-		}
+func expandKeyVaultAccessPolicy(policyRaw map[string]interface{}) keyvault.AccessPolicyEntry {
+	certificatePermissionsRaw := policyRaw["certificate_permissions"].([]interface{})
+	certificatePermissions := []keyvault.CertificatePermissions{}
+	for _, permission := range certificatePermissionsRaw {
+		certificatePermissions = append(certificatePermissions, keyvault.CertificatePermissions(permission.(string)))
+	}
 
-		secretPermissionsRaw := policyRaw["secret_permissions"].([]interface{})
-		secretPermissions := []keyvault.SecretPermissions{}
-		for _, permission := range secretPermissionsRaw {
-			secretPermissions = append(secretPermissions, keyvault.SecretPermissions(permission.(string)))
-		}
+	keyPermissionsRaw := policyRaw["key_permissions"].([]interface{})
+	keyPermissions := []keyvault.KeyPermissions{}
+	for _, permission := range keyPermissionsRaw {
+		keyPermissions = append(keyPermissions, keyvault.KeyPermissions(permission.(string)))
+	}
 
-		policy := keyvault.AccessPolicyEntry{
-			Permissions: &keyvault.Permissions{
-				Certificates: &certificatePermissions,
-				Keys:         &keyPermissions,
-				Secrets:      &secretPermissions,
-			},
-		}
+	secretPermissionsRaw := policyRaw["secret_permissions"].([]interface{})
+	secretPermissions := []keyvault.SecretPermissions{}
+	for _, permission := range secretPermissionsRaw {
+		secretPermissions = append(secretPermissions, keyvault.SecretPermissions(permission.(string)))
+	}
 
-		tenantUUID := uuid.FromStringOrNil(policyRaw["tenant_id"].(string))
-		policy.TenantID = &tenantUUID
-		objectUUID := policyRaw["object_id"].(string)
-		policy.ObjectID = &objectUUID
+	policy := keyvault.AccessPolicyEntry{
+		Permissions: &keyvault.Permissions{
+			Certificates: &certificatePermissions,
+			Keys:         &keyPermissions,
+			Secrets:      &secretPermissions,
+		},
+	}
 
-		if v := policyRaw["application_id"]; v != "" {
-			applicationUUID := uuid.FromStringOrNil(v.(string))
-			policy.ApplicationID = &applicationUUID
-		}
+	tenantUUID := uuid.FromStringOrNil(policyRaw["tenant_id"].(string))
+	policy.TenantID = &tenantUUID
+	objectUUID := policyRaw["object_id"].(string)
+	policy.ObjectID = &objectUUID
 
-		result = append(result, policy)
+	if v := policyRaw["application_id"]; v != nil && v.(string) != "" {
+		applicationUUID := uuid.FromStringOrNil(v.(string))
+		policy.ApplicationID = &applicationUUID
 	}
 
-	return &result
+	return policy
 }
 
 func flattenKeyVaultSku(sku *keyvault.Sku) []interface{} {
@@ -360,41 +420,61 @@ func flattenKeyVaultSku(sku *keyvault.Sku) []interface{} {
 }
 
 func flattenKeyVaultAccessPolicies(policies *[]keyvault.AccessPolicyEntry) []interface{} {
-	result := make([]interface{}, 0, len(*policies))
+	result := make([]interface{}, 0)
+
+	if policies == nil {
+		return result
+	}
 
 	for _, policy := range *policies {
-		policyRaw := make(map[string]interface{})
+		result = append(result, flattenKeyVaultAccessPolicy(policy))
+	}
 
-		keyPermissionsRaw := make([]interface{}, 0, len(*policy.Permissions.Keys))
-		for _, keyPermission := range *policy.Permissions.Keys {
-			keyPermissionsRaw = append(keyPermissionsRaw, string(keyPermission))
-		}
+	return result
+}
 
-		secretPermissionsRaw := make([]interface{}, 0, len(*policy.Permissions.Secrets))
-		for _, secretPermission := range *policy.Permissions.Secrets {
-			secretPermissionsRaw = append(secretPermissionsRaw, string(secretPermission))
-		}
+func flattenKeyVaultAccessPolicy(policy keyvault.AccessPolicyEntry) map[string]interface{} {
+	policyRaw := make(map[string]interface{})
 
+	if policy.TenantID != nil {
 		policyRaw["tenant_id"] = policy.TenantID.String()
+	}
+	if policy.ObjectID != nil {
 		policyRaw["object_id"] = *policy.ObjectID
-		if policy.ApplicationID != nil {
-			policyRaw["application_id"] = policy.ApplicationID.String()
+	}
+	if policy.ApplicationID != nil {
+		policyRaw["application_id"] = policy.ApplicationID.String()
+	}
+
+	keyPermissionsRaw := make([]interface{}, 0)
+	secretPermissionsRaw := make([]interface{}, 0)
+	certificatePermissionsRaw := make([]interface{}, 0)
+
+	if permissions := policy.Permissions; permissions != nil {
+		if permissions.Keys != nil {
+			for _, keyPermission := range *permissions.Keys {
+				keyPermissionsRaw = append(keyPermissionsRaw, string(keyPermission))
+			}
 		}
-		policyRaw["key_permissions"] = keyPermissionsRaw
-		policyRaw["secret_permissions"] = secretPermissionsRaw
 
-		if policy.Permissions.Certificates != nil {
-			certificatePermissionsRaw := make([]interface{}, 0, len(*policy.Permissions.Certificates))
-			for _, certificatePermission := range *policy.Permissions.Certificates {
-				certificatePermissionsRaw = append(certificatePermissionsRaw, string(certificatePermission))
+		if permissions.Secrets != nil {
+			for _, secretPermission := range *permissions.Secrets {
+				secretPermissionsRaw = append(secretPermissionsRaw, string(secretPermission))
 			}
-			policyRaw["certificate_permissions"] = certificatePermissionsRaw
 		}
 
-		result = append(result, policyRaw)
+		if permissions.Certificates != nil {
+			for _, certificatePermission := range *permissions.Certificates {
+				certificatePermissionsRaw = append(certificatePermissionsRaw, string(certificatePermission))
+			}
+		}
 	}
 
-	return result
+	policyRaw["key_permissions"] = keyPermissionsRaw
+	policyRaw["secret_permissions"] = secretPermissionsRaw
+	policyRaw["certificate_permissions"] = certificatePermissionsRaw
+
+	return policyRaw
 }
 
 func validateKeyVaultName(v interface{}, k string) (ws []string, errors []error) {
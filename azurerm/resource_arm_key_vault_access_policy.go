@@ -0,0 +1,282 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/keyvault"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKeyVaultAccessPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKeyVaultAccessPolicyCreate,
+		Read:   resourceArmKeyVaultAccessPolicyRead,
+		Update: resourceArmKeyVaultAccessPolicyUpdate,
+		Delete: resourceArmKeyVaultAccessPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vault_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"tenant_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateUUID,
+			},
+
+			"object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateUUID,
+			},
+
+			"application_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateUUID,
+			},
+
+			"certificate_permissions": keyVaultCertificatePermissionsSchema(),
+
+			"key_permissions": keyVaultKeyPermissionsSchema(),
+
+			"secret_permissions": keyVaultSecretPermissionsSchema(),
+		},
+	}
+}
+
+func resourceArmKeyVaultAccessPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+
+	vaultName := d.Get("vault_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	objectId := d.Get("object_id").(string)
+	applicationId := d.Get("application_id").(string)
+
+	azureRMLockByName(vaultName, keyVaultResourceName)
+	defer azureRMUnlockByName(vaultName, keyVaultResourceName)
+
+	vault, err := client.Get(resGroup, vaultName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", vaultName, resGroup, err)
+	}
+	if vault.ID == nil {
+		return fmt.Errorf("Cannot read Key Vault %q (Resource Group %q) ID", vaultName, resGroup)
+	}
+
+	policies := make([]keyvault.AccessPolicyEntry, 0)
+	if props := vault.Properties; props != nil && props.AccessPolicies != nil {
+		policies = *props.AccessPolicies
+	}
+
+	if findKeyVaultAccessPolicy(policies, objectId, applicationId) != -1 {
+		return fmt.Errorf("An Access Policy for Object ID %q (Application ID %q) already exists in Key Vault %q (Resource Group %q) - to be managed via Terraform this resource needs to be imported into the State.", objectId, applicationId, vaultName, resGroup)
+	}
+
+	policies = append(policies, expandKeyVaultAccessPolicyFromResource(d))
+	if err := updateKeyVaultAccessPolicies(client, resGroup, vaultName, vault, policies); err != nil {
+		return fmt.Errorf("Error adding Access Policy to Key Vault %q (Resource Group %q): %+v", vaultName, resGroup, err)
+	}
+
+	id := fmt.Sprintf("%s/objectId/%s", *vault.ID, objectId)
+	if applicationId != "" {
+		id = fmt.Sprintf("%s/applicationId/%s", id, applicationId)
+	}
+	d.SetId(id)
+
+	return resourceArmKeyVaultAccessPolicyRead(d, meta)
+}
+
+func resourceArmKeyVaultAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	objectId := id.Path["objectId"]
+	applicationId := id.Path["applicationId"]
+
+	vault, err := client.Get(resGroup, vaultName)
+	if err != nil {
+		if utils.ResponseWasNotFound(vault.Response) {
+			log.Printf("[DEBUG] Key Vault %q (Resource Group %q) was not found - removing Access Policy from state", vaultName, resGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", vaultName, resGroup, err)
+	}
+
+	policies := make([]keyvault.AccessPolicyEntry, 0)
+	if props := vault.Properties; props != nil && props.AccessPolicies != nil {
+		policies = *props.AccessPolicies
+	}
+
+	index := findKeyVaultAccessPolicy(policies, objectId, applicationId)
+	if index == -1 {
+		log.Printf("[DEBUG] Access Policy for Object ID %q (Application ID %q) was not found in Key Vault %q (Resource Group %q) - removing from state", objectId, applicationId, vaultName, resGroup)
+		d.SetId("")
+		return nil
+	}
+
+	policy := flattenKeyVaultAccessPolicy(policies[index])
+
+	d.Set("vault_name", vaultName)
+	d.Set("resource_group_name", resGroup)
+	d.Set("tenant_id", policy["tenant_id"])
+	d.Set("object_id", policy["object_id"])
+	d.Set("application_id", policy["application_id"])
+	d.Set("certificate_permissions", policy["certificate_permissions"])
+	d.Set("key_permissions", policy["key_permissions"])
+	d.Set("secret_permissions", policy["secret_permissions"])
+
+	return nil
+}
+
+func resourceArmKeyVaultAccessPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	objectId := id.Path["objectId"]
+	applicationId := id.Path["applicationId"]
+
+	azureRMLockByName(vaultName, keyVaultResourceName)
+	defer azureRMUnlockByName(vaultName, keyVaultResourceName)
+
+	vault, err := client.Get(resGroup, vaultName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", vaultName, resGroup, err)
+	}
+
+	policies := make([]keyvault.AccessPolicyEntry, 0)
+	if props := vault.Properties; props != nil && props.AccessPolicies != nil {
+		policies = *props.AccessPolicies
+	}
+
+	policy := expandKeyVaultAccessPolicyFromResource(d)
+	if index := findKeyVaultAccessPolicy(policies, objectId, applicationId); index != -1 {
+		policies[index] = policy
+	} else {
+		policies = append(policies, policy)
+	}
+
+	if err := updateKeyVaultAccessPolicies(client, resGroup, vaultName, vault, policies); err != nil {
+		return fmt.Errorf("Error updating Access Policy in Key Vault %q (Resource Group %q): %+v", vaultName, resGroup, err)
+	}
+
+	return resourceArmKeyVaultAccessPolicyRead(d, meta)
+}
+
+func resourceArmKeyVaultAccessPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).keyVaultClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	objectId := id.Path["objectId"]
+	applicationId := id.Path["applicationId"]
+
+	azureRMLockByName(vaultName, keyVaultResourceName)
+	defer azureRMUnlockByName(vaultName, keyVaultResourceName)
+
+	vault, err := client.Get(resGroup, vaultName)
+	if err != nil {
+		if utils.ResponseWasNotFound(vault.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", vaultName, resGroup, err)
+	}
+
+	policies := make([]keyvault.AccessPolicyEntry, 0)
+	if props := vault.Properties; props != nil && props.AccessPolicies != nil {
+		policies = *props.AccessPolicies
+	}
+
+	index := findKeyVaultAccessPolicy(policies, objectId, applicationId)
+	if index == -1 {
+		return nil
+	}
+
+	policies = append(policies[:index], policies[index+1:]...)
+	if err := updateKeyVaultAccessPolicies(client, resGroup, vaultName, vault, policies); err != nil {
+		return fmt.Errorf("Error removing Access Policy from Key Vault %q (Resource Group %q): %+v", vaultName, resGroup, err)
+	}
+
+	return nil
+}
+
+func expandKeyVaultAccessPolicyFromResource(d *schema.ResourceData) keyvault.AccessPolicyEntry {
+	return expandKeyVaultAccessPolicy(map[string]interface{}{
+		"tenant_id":               d.Get("tenant_id"),
+		"object_id":               d.Get("object_id"),
+		"application_id":          d.Get("application_id"),
+		"certificate_permissions": d.Get("certificate_permissions"),
+		"key_permissions":         d.Get("key_permissions"),
+		"secret_permissions":      d.Get("secret_permissions"),
+	})
+}
+
+// findKeyVaultAccessPolicy returns the index of the Access Policy for the given Object ID and Application ID, or -1
+func findKeyVaultAccessPolicy(policies []keyvault.AccessPolicyEntry, objectId string, applicationId string) int {
+	for i, policy := range policies {
+		if policy.ObjectID == nil || !strings.EqualFold(*policy.ObjectID, objectId) {
+			continue
+		}
+
+		existingApplicationId := ""
+		if policy.ApplicationID != nil {
+			existingApplicationId = policy.ApplicationID.String()
+		}
+
+		if strings.EqualFold(existingApplicationId, applicationId) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// updateKeyVaultAccessPolicies replaces the Access Policies on the Key Vault, since the API only supports updating them as a whole
+func updateKeyVaultAccessPolicies(client keyvault.VaultsClient, resGroup string, vaultName string, vault keyvault.Vault, policies []keyvault.AccessPolicyEntry) error {
+	if vault.Properties == nil {
+		return fmt.Errorf("`properties` was nil")
+	}
+
+	props := *vault.Properties
+	props.AccessPolicies = &policies
+
+	parameters := keyvault.VaultCreateOrUpdateParameters{
+		Location:   vault.Location,
+		Properties: &props,
+		Tags:       vault.Tags,
+	}
+
+	_, err := client.CreateOrUpdate(resGroup, vaultName, parameters)
+	return err
+}
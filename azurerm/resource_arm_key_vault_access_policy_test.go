@@ -0,0 +1,248 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMKeyVaultAccessPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_key_vault_access_policy.test"
+	rs := acctest.RandString(6)
+	config := testAccAzureRMKeyVaultAccessPolicy_basic(rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultAccessPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_permissions.0", "get"),
+					resource.TestCheckResourceAttr(resourceName, "secret_permissions.0", "get"),
+					resource.TestCheckResourceAttr(resourceName, "secret_permissions.1", "set"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVaultAccessPolicy_multiple(t *testing.T) {
+	rs := acctest.RandString(6)
+	config := testAccAzureRMKeyVaultAccessPolicy_multiple(rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultAccessPolicyExists("azurerm_key_vault_access_policy.test_with_application_id"),
+					resource.TestCheckResourceAttr("azurerm_key_vault_access_policy.test_with_application_id", "key_permissions.0", "create"),
+					resource.TestCheckResourceAttr("azurerm_key_vault_access_policy.test_with_application_id", "certificate_permissions.0", "get"),
+					testCheckAzureRMKeyVaultAccessPolicyExists("azurerm_key_vault_access_policy.test_no_application_id"),
+					resource.TestCheckResourceAttr("azurerm_key_vault_access_policy.test_no_application_id", "key_permissions.0", "list"),
+					resource.TestCheckResourceAttr("azurerm_key_vault_access_policy.test_no_application_id", "secret_permissions.0", "get"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKeyVaultAccessPolicy_update(t *testing.T) {
+	resourceName := "azurerm_key_vault_access_policy.test"
+	rs := acctest.RandString(6)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVaultAccessPolicy_basic(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultAccessPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_permissions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "secret_permissions.#", "2"),
+				),
+			},
+			{
+				Config: testAccAzureRMKeyVaultAccessPolicy_update(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultAccessPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_permissions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "key_permissions.0", "list"),
+					resource.TestCheckResourceAttr(resourceName, "key_permissions.1", "encrypt"),
+					resource.TestCheckResourceAttr(resourceName, "secret_permissions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKeyVaultAccessPolicyExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resGroup := id.ResourceGroup
+		vaultName := id.Path["vaults"]
+		objectId := rs.Primary.Attributes["object_id"]
+		applicationId := rs.Primary.Attributes["application_id"]
+
+		client := testAccProvider.Meta().(*ArmClient).keyVaultClient
+		resp, err := client.Get(resGroup, vaultName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on keyVaultClient: %+v", err)
+		}
+
+		if resp.Properties == nil || resp.Properties.AccessPolicies == nil {
+			return fmt.Errorf("Bad: Key Vault %q (resource group: %q) has no Access Policies", vaultName, resGroup)
+		}
+
+		if findKeyVaultAccessPolicy(*resp.Properties.AccessPolicies, objectId, applicationId) == -1 {
+			return fmt.Errorf("Bad: Access Policy for Object ID %q (Application ID %q) does not exist in Key Vault %q (resource group: %q)", objectId, applicationId, vaultName, resGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMKeyVaultAccessPolicy_template(rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%s"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "premium"
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+`, rString, location, rString)
+}
+
+func testAccAzureRMKeyVaultAccessPolicy_basic(rString string, location string) string {
+	template := testAccAzureRMKeyVaultAccessPolicy_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_access_policy" "test" {
+  vault_name          = "${azurerm_key_vault.test.name}"
+  resource_group_name = "${azurerm_key_vault.test.resource_group_name}"
+
+  key_permissions = [
+    "get",
+  ]
+
+  secret_permissions = [
+    "get",
+    "set",
+  ]
+
+  tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+  object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+}
+`, template)
+}
+
+func testAccAzureRMKeyVaultAccessPolicy_multiple(rString string, location string) string {
+	template := testAccAzureRMKeyVaultAccessPolicy_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_access_policy" "test_with_application_id" {
+  vault_name          = "${azurerm_key_vault.test.name}"
+  resource_group_name = "${azurerm_key_vault.test.resource_group_name}"
+
+  key_permissions = [
+    "create",
+    "get",
+  ]
+
+  secret_permissions = [
+    "get",
+    "delete",
+  ]
+
+  certificate_permissions = [
+    "get",
+    "delete",
+  ]
+
+  application_id = "${data.azurerm_client_config.current.service_principal_application_id}"
+  tenant_id      = "${data.azurerm_client_config.current.tenant_id}"
+  object_id      = "${data.azurerm_client_config.current.service_principal_object_id}"
+}
+
+resource "azurerm_key_vault_access_policy" "test_no_application_id" {
+  vault_name          = "${azurerm_key_vault.test.name}"
+  resource_group_name = "${azurerm_key_vault.test.resource_group_name}"
+
+  key_permissions = [
+    "list",
+    "encrypt",
+  ]
+
+  secret_permissions = [
+    "get",
+  ]
+
+  certificate_permissions = [
+    "list",
+  ]
+
+  tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+  object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+}
+`, template)
+}
+
+func testAccAzureRMKeyVaultAccessPolicy_update(rString string, location string) string {
+	template := testAccAzureRMKeyVaultAccessPolicy_template(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_access_policy" "test" {
+  vault_name          = "${azurerm_key_vault.test.name}"
+  resource_group_name = "${azurerm_key_vault.test.resource_group_name}"
+
+  key_permissions = [
+    "list",
+    "encrypt",
+  ]
+
+  secret_permissions = []
+
+  tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+  object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+}
+`, template)
+}
@@ -1,7 +1,10 @@
 package azurerm
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/dataplane/keyvault"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -41,14 +44,16 @@ func resourceArmKeyVaultCertificate() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"contents": {
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
+							Type:      schema.TypeString,
+							Required:  true,
+							ForceNew:  true,
+							Sensitive: true,
 						},
 						"password": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
+							Type:      schema.TypeString,
+							Optional:  true,
+							ForceNew:  true,
+							Sensitive: true,
 						},
 					},
 				},
@@ -222,6 +227,21 @@ func resourceArmKeyVaultCertificate() *schema.Resource {
 				Computed: true,
 			},
 
+			"secret_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"certificate_data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"thumbprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -243,7 +263,7 @@ func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface
 			Base64EncodedCertificate: utils.String(certificate.CertificateData),
 			Password:                 utils.String(certificate.CertificatePassword),
 			CertificatePolicy:        &policy,
-			Tags:                     expandTags(tags),
+			Tags:                     expandResourceTags(d, tags, meta),
 		}
 		_, err := client.ImportCertificate(keyVaultBaseUrl, name, importParameters)
 		if err != nil {
@@ -253,7 +273,7 @@ func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface
 		// Generate new
 		parameters := keyvault.CertificateCreateParameters{
 			CertificatePolicy: &policy,
-			Tags:              expandTags(tags),
+			Tags:              expandResourceTags(d, tags, meta),
 		}
 		_, err := client.CreateCertificate(keyVaultBaseUrl, name, parameters)
 		if err != nil {
@@ -293,14 +313,29 @@ func resourceArmKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}
 	d.Set("name", id.Name)
 	d.Set("vault_uri", id.KeyVaultBaseUrl)
 
-	certificatePolicy := flattenKeyVaultCertificatePolicy(cert.Policy)
-	if err := d.Set("certificate_policy", certificatePolicy); err != nil {
-		return fmt.Errorf("Error flattening Key Vault Certificate Policy: %+v", err)
+	if policy := cert.Policy; policy != nil {
+		certificatePolicy := flattenKeyVaultCertificatePolicy(policy)
+		if err := d.Set("certificate_policy", certificatePolicy); err != nil {
+			return fmt.Errorf("Error flattening Key Vault Certificate Policy: %+v", err)
+		}
 	}
 
 	// Computed
 	d.Set("version", id.Version)
-	flattenAndSetTags(d, cert.Tags)
+	d.Set("secret_id", cert.Sid)
+
+	if contents := cert.Cer; contents != nil {
+		d.Set("certificate_data", strings.ToUpper(hex.EncodeToString(*contents)))
+	}
+
+	if v := cert.X509Thumbprint; v != nil {
+		thumbprint, err := flattenKeyVaultCertificateThumbprint(*v)
+		if err != nil {
+			return fmt.Errorf("Error decoding the Thumbprint for Key Vault Certificate %q: %+v", id.Name, err)
+		}
+		d.Set("thumbprint", thumbprint)
+	}
+	flattenAndSetResourceTags(d, cert.Tags, meta)
 
 	return nil
 }
@@ -480,6 +515,17 @@ func flattenKeyVaultCertificatePolicy(input *keyvault.CertificatePolicy) []inter
 	return []interface{}{policy}
 }
 
+// flattenKeyVaultCertificateThumbprint converts the thumbprint returned from the API, which is
+// base64url encoded (without padding), into the hex form that's displayed everywhere else
+func flattenKeyVaultCertificateThumbprint(input string) (string, error) {
+	thumbprint, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(input, "="))
+	if err != nil {
+		return "", err
+	}
+
+	return strings.ToUpper(hex.EncodeToString(thumbprint)), nil
+}
+
 type KeyVaultCertificateImportParameters struct {
 	CertificateData     string
 	CertificatePassword string
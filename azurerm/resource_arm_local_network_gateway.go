@@ -16,7 +16,7 @@ func resourceArmLocalNetworkGateway() *schema.Resource {
 		Update: resourceArmLocalNetworkGatewayCreate,
 		Delete: resourceArmLocalNetworkGatewayDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("localNetworkGateways"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -71,7 +71,9 @@ func resourceArmLocalNetworkGatewayCreate(d *schema.ResourceData, meta interface
 		},
 	}
 
-	_, error := lnetClient.CreateOrUpdate(resGroup, name, gateway, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, error := lnetClient.CreateOrUpdate(resGroup, name, gateway, cancel)
 	err := <-error
 	if err != nil {
 		return fmt.Errorf("Error creating Azure ARM Local Network Gateway '%s': %s", name, err)
@@ -138,7 +140,9 @@ func resourceArmLocalNetworkGatewayDelete(d *schema.ResourceData, meta interface
 	name := id.Path["localNetworkGateways"]
 	resGroup := id.ResourceGroup
 
-	deleteResp, error := lnetClient.Delete(resGroup, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, error := lnetClient.Delete(resGroup, name, cancel)
 	resp := <-deleteResp
 	err = <-error
 
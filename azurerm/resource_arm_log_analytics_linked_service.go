@@ -0,0 +1,148 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/operationalinsights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLogAnalyticsLinkedService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogAnalyticsLinkedServiceCreateUpdate,
+		Read:   resourceArmLogAnalyticsLinkedServiceRead,
+		Update: resourceArmLogAnalyticsLinkedServiceCreateUpdate,
+		Delete: resourceArmLogAnalyticsLinkedServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("workspaces", "linkedServices"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameDiffSuppressSchema(),
+
+			"workspace_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc:     validateAzureRmLogAnalyticsWorkspaceName,
+			},
+
+			"linked_service_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "automation",
+			},
+
+			"resource_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			// Exported properties
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmLogAnalyticsLinkedServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).linkedServicesClient
+	log.Printf("[INFO] preparing arguments for AzureRM Log Analytics Linked Services creation.")
+
+	resGroup := d.Get("resource_group_name").(string)
+	workspaceName := d.Get("workspace_name").(string)
+	linkedServiceName := d.Get("linked_service_name").(string)
+	resourceId := d.Get("resource_id").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := operationalinsights.LinkedService{
+		Tags: expandResourceTags(d, tags, meta),
+		LinkedServiceProperties: &operationalinsights.LinkedServiceProperties{
+			ResourceID: utils.String(resourceId),
+		},
+	}
+
+	_, err := client.CreateOrUpdate(resGroup, workspaceName, linkedServiceName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating Linked Service %q (Workspace %q / Resource Group %q): %+v", linkedServiceName, workspaceName, resGroup, err)
+	}
+
+	read, err := client.Get(resGroup, workspaceName, linkedServiceName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Linked Service %q (Workspace %q / Resource Group %q): %+v", linkedServiceName, workspaceName, resGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Linked Service %q (Workspace %q / Resource Group %q) ID", linkedServiceName, workspaceName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmLogAnalyticsLinkedServiceRead(d, meta)
+}
+
+func resourceArmLogAnalyticsLinkedServiceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).linkedServicesClient
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	workspaceName := id.Path["workspaces"]
+	linkedServiceName := id.Path["linkedServices"]
+
+	resp, err := client.Get(resGroup, workspaceName, linkedServiceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Linked Service %q was not found in Workspace %q / Resource Group %q - removing from state", linkedServiceName, workspaceName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Linked Service %q (Workspace %q / Resource Group %q): %+v", linkedServiceName, workspaceName, resGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("workspace_name", workspaceName)
+	d.Set("linked_service_name", linkedServiceName)
+
+	if props := resp.LinkedServiceProperties; props != nil {
+		d.Set("resource_id", props.ResourceID)
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+	return nil
+}
+
+func resourceArmLogAnalyticsLinkedServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).linkedServicesClient
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	workspaceName := id.Path["workspaces"]
+	linkedServiceName := id.Path["linkedServices"]
+
+	resp, err := client.Delete(resGroup, workspaceName, linkedServiceName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Linked Service %q (Workspace %q / Resource Group %q): %+v", linkedServiceName, workspaceName, resGroup, err)
+	}
+
+	return nil
+}
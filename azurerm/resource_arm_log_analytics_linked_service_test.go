@@ -0,0 +1,166 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMLogAnalyticsLinkedService_basic(t *testing.T) {
+	resourceName := "azurerm_log_analytics_linked_service.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMLogAnalyticsLinkedService_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsLinkedServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsLinkedServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("acctestlaw-%d/Automation", ri)),
+					resource.TestCheckResourceAttr(resourceName, "workspace_name", fmt.Sprintf("acctestlaw-%d", ri)),
+					resource.TestCheckResourceAttr(resourceName, "linked_service_name", "automation"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLogAnalyticsLinkedService_complete(t *testing.T) {
+	resourceName := "azurerm_log_analytics_linked_service.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMLogAnalyticsLinkedService_complete(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsLinkedServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsLinkedServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "linked_service_name", "automation"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "test"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMLogAnalyticsLinkedServiceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).linkedServicesClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_log_analytics_linked_service" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		workspaceName := rs.Primary.Attributes["workspace_name"]
+		linkedServiceName := rs.Primary.Attributes["linked_service_name"]
+
+		resp, err := conn.Get(resourceGroup, workspaceName, linkedServiceName)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Log Analytics Linked Service still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMLogAnalyticsLinkedServiceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		workspaceName := rs.Primary.Attributes["workspace_name"]
+		linkedServiceName := rs.Primary.Attributes["linked_service_name"]
+
+		conn := testAccProvider.Meta().(*ArmClient).linkedServicesClient
+
+		resp, err := conn.Get(resourceGroup, workspaceName, linkedServiceName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on Log Analytics Linked Service Client: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Log Analytics Linked Service %q (Workspace %q / Resource Group %q) does not exist", linkedServiceName, workspaceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMLogAnalyticsLinkedService_basic(rInt int, location string) string {
+	template := testAccAzureRMLogAnalyticsLinkedService_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_linked_service" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  workspace_name      = "${azurerm_log_analytics_workspace.test.name}"
+  resource_id         = "${azurerm_automation_account.test.id}"
+}
+`, template)
+}
+
+func testAccAzureRMLogAnalyticsLinkedService_complete(rInt int, location string) string {
+	template := testAccAzureRMLogAnalyticsLinkedService_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_log_analytics_linked_service" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  workspace_name      = "${azurerm_log_analytics_workspace.test.name}"
+  linked_service_name = "automation"
+  resource_id         = "${azurerm_automation_account.test.id}"
+
+  tags {
+    environment = "test"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMLogAnalyticsLinkedService_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctestAutomation-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Basic"
+  }
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestlaw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerNode"
+}
+`, rInt, location, rInt, rInt)
+}
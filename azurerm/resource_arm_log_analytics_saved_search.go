@@ -0,0 +1,185 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/operationalinsights"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLogAnalyticsSavedSearch() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogAnalyticsSavedSearchCreateUpdate,
+		Read:   resourceArmLogAnalyticsSavedSearchRead,
+		Update: resourceArmLogAnalyticsSavedSearchCreateUpdate,
+		Delete: resourceArmLogAnalyticsSavedSearchDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("workspaces", "savedSearches"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameDiffSuppressSchema(),
+
+			"workspace_name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc:     validateAzureRmLogAnalyticsWorkspaceName,
+			},
+
+			"category": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"query": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmLogAnalyticsSavedSearchCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).savedSearchesClient
+	log.Printf("[INFO] preparing arguments for AzureRM Log Analytics Saved Search creation.")
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	workspaceName := d.Get("workspace_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := operationalinsights.SavedSearch{
+		SavedSearchProperties: &operationalinsights.SavedSearchProperties{
+			Category:    utils.String(d.Get("category").(string)),
+			DisplayName: utils.String(d.Get("display_name").(string)),
+			Query:       utils.String(d.Get("query").(string)),
+			// the only supported version of the Saved Search schema is 1
+			Version: utils.Int64(int64(1)),
+			Tags:    expandLogAnalyticsSavedSearchTags(expandResourceTags(d, tags, meta)),
+		},
+	}
+
+	_, err := client.CreateOrUpdate(resGroup, workspaceName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating Saved Search %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	read, err := client.Get(resGroup, workspaceName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Saved Search %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Saved Search %q (Workspace %q / Resource Group %q) ID", name, workspaceName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmLogAnalyticsSavedSearchRead(d, meta)
+}
+
+func resourceArmLogAnalyticsSavedSearchRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).savedSearchesClient
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	workspaceName := id.Path["workspaces"]
+	name := id.Path["savedSearches"]
+
+	resp, err := client.Get(resGroup, workspaceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Saved Search %q was not found in Workspace %q / Resource Group %q - removing from state", name, workspaceName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Saved Search %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("workspace_name", workspaceName)
+
+	if props := resp.SavedSearchProperties; props != nil {
+		d.Set("category", props.Category)
+		d.Set("display_name", props.DisplayName)
+		d.Set("query", props.Query)
+
+		flattenAndSetResourceTags(d, flattenLogAnalyticsSavedSearchTags(props.Tags), meta)
+	}
+
+	return nil
+}
+
+func resourceArmLogAnalyticsSavedSearchDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).savedSearchesClient
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	workspaceName := id.Path["workspaces"]
+	name := id.Path["savedSearches"]
+
+	resp, err := client.Delete(resGroup, workspaceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Saved Search %q (Workspace %q / Resource Group %q): %+v", name, workspaceName, resGroup, err)
+	}
+
+	return nil
+}
+
+// Saved Searches store their tags as a list of Name/Value pairs, rather than the map used by ARM resources
+func expandLogAnalyticsSavedSearchTags(input *map[string]*string) *[]operationalinsights.Tag {
+	output := make([]operationalinsights.Tag, 0)
+
+	if input != nil {
+		for k, v := range *input {
+			output = append(output, operationalinsights.Tag{
+				Name:  utils.String(k),
+				Value: v,
+			})
+		}
+	}
+
+	return &output
+}
+
+func flattenLogAnalyticsSavedSearchTags(input *[]operationalinsights.Tag) *map[string]*string {
+	output := make(map[string]*string, 0)
+
+	if input != nil {
+		for _, tag := range *input {
+			if tag.Name != nil && tag.Value != nil {
+				output[*tag.Name] = tag.Value
+			}
+		}
+	}
+
+	return &output
+}
@@ -0,0 +1,169 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMLogAnalyticsSavedSearch_basic(t *testing.T) {
+	resourceName := "azurerm_log_analytics_saved_search.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMLogAnalyticsSavedSearch_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsSavedSearchDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsSavedSearchExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "category", "Saved Searches"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLogAnalyticsSavedSearch_update(t *testing.T) {
+	resourceName := "azurerm_log_analytics_saved_search.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsSavedSearchDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLogAnalyticsSavedSearch_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsSavedSearchExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Error Events"),
+				),
+			},
+			{
+				Config: testAccAzureRMLogAnalyticsSavedSearch_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsSavedSearchExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Warning Events"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "production"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMLogAnalyticsSavedSearchDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).savedSearchesClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_log_analytics_saved_search" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		workspaceName := rs.Primary.Attributes["workspace_name"]
+
+		resp, err := conn.Get(resourceGroup, workspaceName, name)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Log Analytics Saved Search still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMLogAnalyticsSavedSearchExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		searchName := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		workspaceName := rs.Primary.Attributes["workspace_name"]
+
+		conn := testAccProvider.Meta().(*ArmClient).savedSearchesClient
+
+		resp, err := conn.Get(resourceGroup, workspaceName, searchName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on Log Analytics Saved Searches Client: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Log Analytics Saved Search %q (Workspace %q / Resource Group %q) does not exist", searchName, workspaceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMLogAnalyticsSavedSearch_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerNode"
+}
+
+resource "azurerm_log_analytics_saved_search" "test" {
+  name                = "acctestss-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  workspace_name      = "${azurerm_log_analytics_workspace.test.name}"
+  category            = "Saved Searches"
+  display_name        = "Error Events"
+  query               = "Event | where EventLevelName == \"Error\""
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMLogAnalyticsSavedSearch_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerNode"
+}
+
+resource "azurerm_log_analytics_saved_search" "test" {
+  name                = "acctestss-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  workspace_name      = "${azurerm_log_analytics_workspace.test.name}"
+  category            = "Saved Searches"
+  display_name        = "Warning Events"
+  query               = "Event | where EventLevelName == \"Warning\""
+
+  tags {
+    environment = "production"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
@@ -0,0 +1,236 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/operationsmanagement"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLogAnalyticsSolution() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogAnalyticsSolutionCreate,
+		Read:   resourceArmLogAnalyticsSolutionRead,
+		Delete: resourceArmLogAnalyticsSolutionDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("solutions"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"solution_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"workspace_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRmLogAnalyticsWorkspaceName,
+			},
+
+			"workspace_resource_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameDiffSuppressSchema(),
+
+			"plan": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"publisher": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"promotion_code": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"product": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmLogAnalyticsSolutionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).solutionsClient
+	log.Printf("[INFO] preparing arguments for AzureRM Log Analytics Solution creation.")
+
+	// the API requires that both `name` and `plan.name` are in the format "SolutionName(WorkspaceName)"
+	name := fmt.Sprintf("%s(%s)", d.Get("solution_name").(string), d.Get("workspace_name").(string))
+	solutionPlan := expandAzureRmLogAnalyticsSolutionPlan(d)
+	solutionPlan.Name = &name
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	resGroup := d.Get("resource_group_name").(string)
+	workspaceID := d.Get("workspace_resource_id").(string)
+
+	parameters := operationsmanagement.Solution{
+		Name:     &name,
+		Location: &location,
+		Plan:     &solutionPlan,
+		Properties: &operationsmanagement.SolutionProperties{
+			WorkspaceResourceID: &workspaceID,
+		},
+	}
+
+	// the Solution Name is a property of the client rather than an argument to each method
+	client.SolutionName = name
+
+	_, err := client.CreateOrUpdate(resGroup, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating Log Analytics Solution %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	solution, err := client.Get(resGroup)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Log Analytics Solution %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	if solution.ID == nil {
+		return fmt.Errorf("Cannot read Log Analytics Solution %q (Resource Group %q) ID", name, resGroup)
+	}
+
+	d.SetId(*solution.ID)
+
+	return resourceArmLogAnalyticsSolutionRead(d, meta)
+}
+
+func resourceArmLogAnalyticsSolutionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).solutionsClient
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["solutions"]
+
+	client.SolutionName = name
+	resp, err := client.Get(resGroup)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Log Analytics Solution %q was not found in Resource Group %q - removing from state", name, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Log Analytics Solution %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	if resp.Plan == nil {
+		return fmt.Errorf("Error retrieving Log Analytics Solution %q (Resource Group %q): `plan` was nil", name, resGroup)
+	}
+
+	// The Solution Name is returned in the format "SolutionName(WorkspaceName)"
+	if resp.Name != nil {
+		parts := strings.SplitN(*resp.Name, "(", 2)
+		if len(parts) == 2 {
+			d.Set("solution_name", parts[0])
+			d.Set("workspace_name", strings.TrimSuffix(parts[1], ")"))
+		}
+	}
+
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+	d.Set("resource_group_name", resGroup)
+
+	if props := resp.Properties; props != nil {
+		d.Set("workspace_resource_id", props.WorkspaceResourceID)
+	}
+
+	if err := d.Set("plan", flattenAzureRmLogAnalyticsSolutionPlan(resp.Plan)); err != nil {
+		return fmt.Errorf("Error setting `plan`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmLogAnalyticsSolutionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).solutionsClient
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	name := id.Path["solutions"]
+
+	client.SolutionName = name
+	resp, err := client.Delete(resGroup)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Log Analytics Solution %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	return nil
+}
+
+func expandAzureRmLogAnalyticsSolutionPlan(d *schema.ResourceData) operationsmanagement.SolutionPlan {
+	plans := d.Get("plan").([]interface{})
+	plan := plans[0].(map[string]interface{})
+
+	name := plan["name"].(string)
+	publisher := plan["publisher"].(string)
+	promotionCode := plan["promotion_code"].(string)
+	product := plan["product"].(string)
+
+	expandedPlan := operationsmanagement.SolutionPlan{
+		Name:          utils.String(name),
+		PromotionCode: utils.String(promotionCode),
+		Publisher:     utils.String(publisher),
+		Product:       utils.String(product),
+	}
+
+	return expandedPlan
+}
+
+func flattenAzureRmLogAnalyticsSolutionPlan(plan *operationsmanagement.SolutionPlan) []interface{} {
+	plans := make([]interface{}, 0)
+	if plan == nil {
+		return plans
+	}
+
+	values := make(map[string]interface{}, 0)
+
+	if plan.Name != nil {
+		values["name"] = *plan.Name
+	}
+	if plan.Product != nil {
+		values["product"] = *plan.Product
+	}
+	if plan.PromotionCode != nil {
+		values["promotion_code"] = *plan.PromotionCode
+	}
+	if plan.Publisher != nil {
+		values["publisher"] = *plan.Publisher
+	}
+
+	return append(plans, values)
+}
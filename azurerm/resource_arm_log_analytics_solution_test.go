@@ -0,0 +1,170 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMLogAnalyticsSolution_basicContainerMonitoring(t *testing.T) {
+	resourceName := "azurerm_log_analytics_solution.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMLogAnalyticsSolution_containerMonitoring(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsSolutionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsSolutionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "solution_name", "ContainerInsights"),
+					resource.TestCheckResourceAttr(resourceName, "plan.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "plan.0.publisher", "Microsoft"),
+					resource.TestCheckResourceAttr(resourceName, "plan.0.product", "OMSGallery/ContainerInsights"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLogAnalyticsSolution_basicSecurity(t *testing.T) {
+	resourceName := "azurerm_log_analytics_solution.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMLogAnalyticsSolution_security(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogAnalyticsSolutionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogAnalyticsSolutionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "solution_name", "Security"),
+					resource.TestCheckResourceAttr(resourceName, "plan.0.product", "OMSGallery/Security"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMLogAnalyticsSolutionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).solutionsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_log_analytics_solution" {
+			continue
+		}
+
+		name := fmt.Sprintf("%s(%s)", rs.Primary.Attributes["solution_name"], rs.Primary.Attributes["workspace_name"])
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		conn.SolutionName = name
+		resp, err := conn.Get(resourceGroup)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Log Analytics Solution still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMLogAnalyticsSolutionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		solutionName := fmt.Sprintf("%s(%s)", rs.Primary.Attributes["solution_name"], rs.Primary.Attributes["workspace_name"])
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Log Analytics Solution: %q", solutionName)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).solutionsClient
+		conn.SolutionName = solutionName
+
+		resp, err := conn.Get(resourceGroup)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on Log Analytics Solutions Client: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Log Analytics Solution %q (resource group: %q) does not exist", solutionName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMLogAnalyticsSolution_containerMonitoring(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Free"
+}
+
+resource "azurerm_log_analytics_solution" "test" {
+  solution_name         = "ContainerInsights"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  workspace_resource_id = "${azurerm_log_analytics_workspace.test.id}"
+  workspace_name        = "${azurerm_log_analytics_workspace.test.name}"
+
+  plan {
+    publisher = "Microsoft"
+    product   = "OMSGallery/ContainerInsights"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMLogAnalyticsSolution_security(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Free"
+}
+
+resource "azurerm_log_analytics_solution" "test" {
+  solution_name         = "Security"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  workspace_resource_id = "${azurerm_log_analytics_workspace.test.id}"
+  workspace_name        = "${azurerm_log_analytics_workspace.test.name}"
+
+  plan {
+    publisher = "Microsoft"
+    product   = "OMSGallery/Security"
+  }
+}
+`, rInt, location, rInt)
+}
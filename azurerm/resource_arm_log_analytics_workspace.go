@@ -18,7 +18,7 @@ func resourceArmLogAnalyticsWorkspace() *schema.Resource {
 		Update: resourceArmLogAnalyticsWorkspaceCreateUpdate,
 		Delete: resourceArmLogAnalyticsWorkspaceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("workspaces"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -66,13 +66,15 @@ func resourceArmLogAnalyticsWorkspace() *schema.Resource {
 			},
 
 			"primary_shared_key": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 
 			"secondary_shared_key": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 
 			"tags": tagsSchema(),
@@ -100,14 +102,16 @@ func resourceArmLogAnalyticsWorkspaceCreateUpdate(d *schema.ResourceData, meta i
 	parameters := operationalinsights.Workspace{
 		Name:     &name,
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandResourceTags(d, tags, meta),
 		WorkspaceProperties: &operationalinsights.WorkspaceProperties{
 			Sku:             sku,
 			RetentionInDays: &retentionInDays,
 		},
 	}
 
-	_, error := client.CreateOrUpdate(resGroup, name, parameters, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, error := client.CreateOrUpdate(resGroup, name, parameters, cancel)
 	err := <-error
 	if err != nil {
 		return err
@@ -148,14 +152,19 @@ func resourceArmLogAnalyticsWorkspaceRead(d *schema.ResourceData, meta interface
 	}
 
 	d.Set("name", resp.Name)
-	d.Set("location", resp.Location)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
 	d.Set("resource_group_name", resGroup)
-	d.Set("workspace_id", resp.CustomerID)
-	d.Set("portal_url", resp.PortalURL)
-	if sku := resp.Sku; sku != nil {
-		d.Set("sku", sku.Name)
+
+	if props := resp.WorkspaceProperties; props != nil {
+		d.Set("workspace_id", props.CustomerID)
+		d.Set("portal_url", props.PortalURL)
+		if sku := props.Sku; sku != nil {
+			d.Set("sku", sku.Name)
+		}
+		d.Set("retention_in_days", props.RetentionInDays)
 	}
-	d.Set("retention_in_days", resp.RetentionInDays)
 
 	sharedKeys, err := client.GetSharedKeys(resGroup, name)
 	if err != nil {
@@ -165,7 +174,7 @@ func resourceArmLogAnalyticsWorkspaceRead(d *schema.ResourceData, meta interface
 		d.Set("secondary_shared_key", sharedKeys.SecondarySharedKey)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 	return nil
 }
 
@@ -64,6 +64,9 @@ func TestAccAzureRMLogAnalyticsWorkspace_requiredOnly(t *testing.T) {
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMLogAnalyticsWorkspaceExists("azurerm_log_analytics_workspace.test"),
+					resource.TestCheckResourceAttrSet("azurerm_log_analytics_workspace.test", "workspace_id"),
+					resource.TestCheckResourceAttrSet("azurerm_log_analytics_workspace.test", "primary_shared_key"),
+					resource.TestCheckResourceAttrSet("azurerm_log_analytics_workspace.test", "secondary_shared_key"),
 				),
 			},
 		},
@@ -82,6 +85,7 @@ func TestAccAzureRMLogAnalyticsWorkspace_retentionInDaysComplete(t *testing.T) {
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMLogAnalyticsWorkspaceExists("azurerm_log_analytics_workspace.test"),
+					resource.TestCheckResourceAttr("azurerm_log_analytics_workspace.test", "retention_in_days", "30"),
 				),
 			},
 		},
@@ -0,0 +1,100 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceArmLogicAppActionCustom() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogicAppActionCustomCreateUpdate,
+		Read:   resourceArmLogicAppActionCustomRead,
+		Update: resourceArmLogicAppActionCustomCreateUpdate,
+		Delete: resourceArmLogicAppActionCustomDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("workflows", "actions"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"logic_app_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"body": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+		},
+	}
+}
+
+func resourceArmLogicAppActionCustomCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	body, err := structure.ExpandJsonFromString(d.Get("body").(string))
+	if err != nil {
+		return fmt.Errorf("Error expanding `body`: %+v", err)
+	}
+
+	logicAppId := d.Get("logic_app_id").(string)
+	name := d.Get("name").(string)
+	if err := resourceLogicAppComponentUpdate(d, meta, "Action", "actions", logicAppId, name, body); err != nil {
+		return err
+	}
+
+	return resourceArmLogicAppActionCustomRead(d, meta)
+}
+
+func resourceArmLogicAppActionCustomRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	logicAppName := id.Path["workflows"]
+	name := id.Path["actions"]
+
+	t, app, err := retrieveLogicAppComponent(meta, resourceGroup, logicAppName, "actions", name)
+	if err != nil {
+		return err
+	}
+
+	if t == nil {
+		log.Printf("[DEBUG] Logic App Workflow %q (Resource Group %q) or Custom Action %q was not found - removing from state!", logicAppName, resourceGroup, name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("logic_app_id", app.ID)
+
+	body, err := structure.FlattenJsonToString(t)
+	if err != nil {
+		return fmt.Errorf("Error flattening `body`: %+v", err)
+	}
+	d.Set("body", body)
+
+	return nil
+}
+
+func resourceArmLogicAppActionCustomDelete(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	name := id.Path["actions"]
+
+	return resourceLogicAppComponentRemove(d, meta, "Action", "actions", name)
+}
@@ -0,0 +1,59 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMLogicAppActionCustom_basic(t *testing.T) {
+	resourceName := "azurerm_logic_app_action_custom.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogicAppWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLogicAppActionCustom_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppActionExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "body"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMLogicAppActionCustom_basic(rInt int, location string) string {
+	template := testAccAzureRMLogicAppWorkflow_empty(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_action_custom" "test" {
+  name         = "action-test"
+  logic_app_id = "${azurerm_logic_app_workflow.test.id}"
+
+  body = <<BODY
+{
+  "description": "A variable to configure the auto expiration age in days. Configured in negative number. Default is -30 (30 days old).",
+  "inputs": {
+    "variables": [
+      {
+        "name": "ExpirationAgeInDays",
+        "type": "Integer",
+        "value": -30
+      }
+    ]
+  },
+  "runAfter": {},
+  "type": "InitializeVariable"
+}
+BODY
+}
+`, template)
+}
@@ -0,0 +1,150 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceArmLogicAppActionHttp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogicAppActionHttpCreateUpdate,
+		Read:   resourceArmLogicAppActionHttpRead,
+		Update: resourceArmLogicAppActionHttpCreateUpdate,
+		Delete: resourceArmLogicAppActionHttpDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("workflows", "actions"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"logic_app_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"method": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"DELETE",
+					"GET",
+					"PATCH",
+					"POST",
+					"PUT",
+				}, false),
+			},
+
+			"uri": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"body": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"headers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmLogicAppActionHttpCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	inputs := map[string]interface{}{
+		"method": d.Get("method").(string),
+		"uri":    d.Get("uri").(string),
+	}
+
+	if v, ok := d.GetOk("body"); ok {
+		inputs["body"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("headers"); ok {
+		inputs["headers"] = v.(map[string]interface{})
+	}
+
+	action := map[string]interface{}{
+		"inputs": inputs,
+		"type":   "Http",
+	}
+
+	logicAppId := d.Get("logic_app_id").(string)
+	name := d.Get("name").(string)
+	if err := resourceLogicAppComponentUpdate(d, meta, "Action", "actions", logicAppId, name, action); err != nil {
+		return err
+	}
+
+	return resourceArmLogicAppActionHttpRead(d, meta)
+}
+
+func resourceArmLogicAppActionHttpRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	logicAppName := id.Path["workflows"]
+	name := id.Path["actions"]
+
+	t, app, err := retrieveLogicAppComponent(meta, resourceGroup, logicAppName, "actions", name)
+	if err != nil {
+		return err
+	}
+
+	if t == nil {
+		log.Printf("[DEBUG] Logic App Workflow %q (Resource Group %q) or HTTP Action %q was not found - removing from state!", logicAppName, resourceGroup, name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("logic_app_id", app.ID)
+
+	inputs, ok := t["inputs"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("Error parsing `inputs` for HTTP Action %q (Logic App Workflow %q / Resource Group %q)", name, logicAppName, resourceGroup)
+	}
+
+	if v, ok := inputs["method"].(string); ok {
+		d.Set("method", strings.ToUpper(v))
+	}
+
+	if v, ok := inputs["uri"].(string); ok {
+		d.Set("uri", v)
+	}
+
+	if v, ok := inputs["body"].(string); ok {
+		d.Set("body", v)
+	}
+
+	if v, ok := inputs["headers"].(map[string]interface{}); ok {
+		if err := d.Set("headers", v); err != nil {
+			return fmt.Errorf("Error setting `headers`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmLogicAppActionHttpDelete(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	name := id.Path["actions"]
+
+	return resourceLogicAppComponentRemove(d, meta, "Action", "actions", name)
+}
@@ -0,0 +1,74 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMLogicAppActionHttp_update(t *testing.T) {
+	resourceName := "azurerm_logic_app_action_http.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogicAppWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLogicAppActionHttp_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppActionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "method", "GET"),
+					resource.TestCheckResourceAttr(resourceName, "uri", "http://example.com/hello"),
+				),
+			},
+			{
+				Config: testAccAzureRMLogicAppActionHttp_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppActionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "method", "POST"),
+					resource.TestCheckResourceAttr(resourceName, "body", `{"hello":"world"}`),
+					resource.TestCheckResourceAttr(resourceName, "headers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "headers.Content-Type", "application/json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMLogicAppActionHttp_basic(rInt int, location string) string {
+	template := testAccAzureRMLogicAppWorkflow_empty(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_action_http" "test" {
+  name         = "action-test"
+  logic_app_id = "${azurerm_logic_app_workflow.test.id}"
+  method       = "GET"
+  uri          = "http://example.com/hello"
+}
+`, template)
+}
+
+func testAccAzureRMLogicAppActionHttp_complete(rInt int, location string) string {
+	template := testAccAzureRMLogicAppWorkflow_empty(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_action_http" "test" {
+  name         = "action-test"
+  logic_app_id = "${azurerm_logic_app_workflow.test.id}"
+  method       = "POST"
+  uri          = "http://example.com/hello"
+  body         = "{\"hello\":\"world\"}"
+
+  headers {
+    "Content-Type" = "application/json"
+  }
+}
+`, template)
+}
@@ -0,0 +1,52 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMLogicAppTriggerCustom_basic(t *testing.T) {
+	resourceName := "azurerm_logic_app_trigger_custom.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogicAppWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLogicAppTriggerCustom_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppTriggerExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "body"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMLogicAppTriggerCustom_basic(rInt int, location string) string {
+	template := testAccAzureRMLogicAppWorkflow_empty(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_trigger_custom" "test" {
+  name         = "recurrence-test"
+  logic_app_id = "${azurerm_logic_app_workflow.test.id}"
+
+  body = <<BODY
+{
+  "recurrence": {
+    "frequency": "Day",
+    "interval": 1
+  },
+  "type": "Recurrence"
+}
+BODY
+}
+`, template)
+}
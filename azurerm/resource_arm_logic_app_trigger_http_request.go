@@ -0,0 +1,151 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceArmLogicAppTriggerHttpRequest() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogicAppTriggerHttpRequestCreateUpdate,
+		Read:   resourceArmLogicAppTriggerHttpRequestRead,
+		Update: resourceArmLogicAppTriggerHttpRequestCreateUpdate,
+		Delete: resourceArmLogicAppTriggerHttpRequestDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("workflows", "triggers"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"logic_app_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"schema": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			"method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"DELETE",
+					"GET",
+					"PATCH",
+					"POST",
+					"PUT",
+				}, false),
+			},
+
+			"relative_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceArmLogicAppTriggerHttpRequestCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	requestSchema, err := structure.ExpandJsonFromString(d.Get("schema").(string))
+	if err != nil {
+		return fmt.Errorf("Error expanding `schema`: %+v", err)
+	}
+
+	inputs := map[string]interface{}{
+		"schema": requestSchema,
+	}
+
+	if v, ok := d.GetOk("method"); ok {
+		inputs["method"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("relative_path"); ok {
+		inputs["relativePath"] = v.(string)
+	}
+
+	trigger := map[string]interface{}{
+		"inputs": inputs,
+		"kind":   "Http",
+		"type":   "Request",
+	}
+
+	logicAppId := d.Get("logic_app_id").(string)
+	name := d.Get("name").(string)
+	if err := resourceLogicAppComponentUpdate(d, meta, "Trigger", "triggers", logicAppId, name, trigger); err != nil {
+		return err
+	}
+
+	return resourceArmLogicAppTriggerHttpRequestRead(d, meta)
+}
+
+func resourceArmLogicAppTriggerHttpRequestRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	logicAppName := id.Path["workflows"]
+	name := id.Path["triggers"]
+
+	t, app, err := retrieveLogicAppComponent(meta, resourceGroup, logicAppName, "triggers", name)
+	if err != nil {
+		return err
+	}
+
+	if t == nil {
+		log.Printf("[DEBUG] Logic App Workflow %q (Resource Group %q) or HTTP Request Trigger %q was not found - removing from state!", logicAppName, resourceGroup, name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("logic_app_id", app.ID)
+
+	inputs, ok := t["inputs"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("Error parsing `inputs` for HTTP Request Trigger %q (Logic App Workflow %q / Resource Group %q)", name, logicAppName, resourceGroup)
+	}
+
+	if v, ok := inputs["schema"].(map[string]interface{}); ok {
+		requestSchema, err := structure.FlattenJsonToString(v)
+		if err != nil {
+			return fmt.Errorf("Error flattening `schema`: %+v", err)
+		}
+		d.Set("schema", requestSchema)
+	}
+
+	if v, ok := inputs["method"].(string); ok {
+		d.Set("method", strings.ToUpper(v))
+	}
+
+	if v, ok := inputs["relativePath"].(string); ok {
+		d.Set("relative_path", v)
+	}
+
+	return nil
+}
+
+func resourceArmLogicAppTriggerHttpRequestDelete(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	name := id.Path["triggers"]
+
+	return resourceLogicAppComponentRemove(d, meta, "Trigger", "triggers", name)
+}
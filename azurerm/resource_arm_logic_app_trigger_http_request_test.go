@@ -0,0 +1,56 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMLogicAppTriggerHttpRequest_basic(t *testing.T) {
+	resourceName := "azurerm_logic_app_trigger_http_request.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogicAppWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLogicAppTriggerHttpRequest_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppTriggerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "method", "POST"),
+					resource.TestCheckResourceAttr(resourceName, "relative_path", "customers/{id}"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMLogicAppTriggerHttpRequest_basic(rInt int, location string) string {
+	template := testAccAzureRMLogicAppWorkflow_empty(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_trigger_http_request" "test" {
+  name          = "some-http-trigger"
+  logic_app_id  = "${azurerm_logic_app_workflow.test.id}"
+  method        = "POST"
+  relative_path = "customers/{id}"
+
+  schema = <<SCHEMA
+{
+  "type": "object",
+  "properties": {
+    "hello": {
+      "type": "string"
+    }
+  }
+}
+SCHEMA
+}
+`, template)
+}
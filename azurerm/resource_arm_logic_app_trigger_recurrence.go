@@ -0,0 +1,122 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceArmLogicAppTriggerRecurrence() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogicAppTriggerRecurrenceCreateUpdate,
+		Read:   resourceArmLogicAppTriggerRecurrenceRead,
+		Update: resourceArmLogicAppTriggerRecurrenceCreateUpdate,
+		Delete: resourceArmLogicAppTriggerRecurrenceDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("workflows", "triggers"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"logic_app_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"frequency": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Month",
+					"Week",
+					"Day",
+					"Hour",
+					"Minute",
+					"Second",
+				}, false),
+			},
+
+			"interval": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceArmLogicAppTriggerRecurrenceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	trigger := map[string]interface{}{
+		"recurrence": map[string]interface{}{
+			"frequency": d.Get("frequency").(string),
+			"interval":  d.Get("interval").(int),
+		},
+		"type": "Recurrence",
+	}
+
+	logicAppId := d.Get("logic_app_id").(string)
+	name := d.Get("name").(string)
+	if err := resourceLogicAppComponentUpdate(d, meta, "Trigger", "triggers", logicAppId, name, trigger); err != nil {
+		return err
+	}
+
+	return resourceArmLogicAppTriggerRecurrenceRead(d, meta)
+}
+
+func resourceArmLogicAppTriggerRecurrenceRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	logicAppName := id.Path["workflows"]
+	name := id.Path["triggers"]
+
+	t, app, err := retrieveLogicAppComponent(meta, resourceGroup, logicAppName, "triggers", name)
+	if err != nil {
+		return err
+	}
+
+	if t == nil {
+		log.Printf("[DEBUG] Logic App Workflow %q (Resource Group %q) or Recurrence Trigger %q was not found - removing from state!", logicAppName, resourceGroup, name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("logic_app_id", app.ID)
+
+	recurrence, ok := t["recurrence"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("Error parsing `recurrence` for Recurrence Trigger %q (Logic App Workflow %q / Resource Group %q)", name, logicAppName, resourceGroup)
+	}
+
+	if v, ok := recurrence["frequency"].(string); ok {
+		d.Set("frequency", v)
+	}
+
+	// numbers are decoded from JSON as float64's
+	if v, ok := recurrence["interval"].(float64); ok {
+		d.Set("interval", int(v))
+	}
+
+	return nil
+}
+
+func resourceArmLogicAppTriggerRecurrenceDelete(d *schema.ResourceData, meta interface{}) error {
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	name := id.Path["triggers"]
+
+	return resourceLogicAppComponentRemove(d, meta, "Trigger", "triggers", name)
+}
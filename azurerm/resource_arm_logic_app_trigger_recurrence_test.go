@@ -0,0 +1,67 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMLogicAppTriggerRecurrence_update(t *testing.T) {
+	resourceName := "azurerm_logic_app_trigger_recurrence.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogicAppWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLogicAppTriggerRecurrence_month(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppTriggerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "Month"),
+					resource.TestCheckResourceAttr(resourceName, "interval", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMLogicAppTriggerRecurrence_week(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppTriggerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "Week"),
+					resource.TestCheckResourceAttr(resourceName, "interval", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMLogicAppTriggerRecurrence_month(rInt int, location string) string {
+	template := testAccAzureRMLogicAppWorkflow_empty(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_trigger_recurrence" "test" {
+  name         = "frequency-trigger"
+  logic_app_id = "${azurerm_logic_app_workflow.test.id}"
+  frequency    = "Month"
+  interval     = 1
+}
+`, template)
+}
+
+func testAccAzureRMLogicAppTriggerRecurrence_week(rInt int, location string) string {
+	template := testAccAzureRMLogicAppWorkflow_empty(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_trigger_recurrence" "test" {
+  name         = "frequency-trigger"
+  logic_app_id = "${azurerm_logic_app_workflow.test.id}"
+  frequency    = "Week"
+  interval     = 2
+}
+`, template)
+}
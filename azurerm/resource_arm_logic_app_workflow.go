@@ -0,0 +1,347 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/logic"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmLogicAppWorkflow() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmLogicAppWorkflowCreate,
+		Read:   resourceArmLogicAppWorkflowRead,
+		Update: resourceArmLogicAppWorkflowUpdate,
+		Delete: resourceArmLogicAppWorkflowDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("workflows"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"integration_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// the Values of Parameters are Objects in the (2016-06-01) API - so each one is specified as a JSON Object
+			"parameters": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				ValidateFunc:     validateLogicAppWorkflowParameters,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			"workflow_schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "https://schema.management.azure.com/providers/Microsoft.Logic/schemas/2016-06-01/workflowdefinition.json#",
+			},
+
+			"workflow_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "1.0.0.0",
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"tags": tagsSchema(),
+
+			"access_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmLogicAppWorkflowCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logicWorkflowsClient
+
+	name := d.Get("name").(string)
+	location := d.Get("location").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	log.Printf("[INFO] preparing arguments for Logic App Workflow creation %q (Resource Group %q)", name, resourceGroup)
+
+	parameters, err := expandLogicAppWorkflowParameters(d.Get("parameters").(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
+	definition := map[string]interface{}{
+		"$schema":        d.Get("workflow_schema").(string),
+		"contentVersion": d.Get("workflow_version").(string),
+		"actions":        make(map[string]interface{}, 0),
+		"triggers":       make(map[string]interface{}, 0),
+		"parameters":     expandLogicAppWorkflowParameterDeclarations(parameters),
+	}
+
+	workflow := logic.Workflow{
+		Location: utils.String(location),
+		Tags:     expandResourceTags(d, tags, meta),
+		WorkflowProperties: &logic.WorkflowProperties{
+			Definition:         &definition,
+			Parameters:         &parameters,
+			IntegrationAccount: expandLogicAppWorkflowIntegrationAccount(d),
+			State:              expandLogicAppWorkflowState(d),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(resourceGroup, name, workflow); err != nil {
+		return fmt.Errorf("Error creating Logic App Workflow %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Logic App Workflow %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Logic App Workflow %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmLogicAppWorkflowRead(d, meta)
+}
+
+func resourceArmLogicAppWorkflowUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logicWorkflowsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["workflows"]
+
+	// the Triggers and Actions within the Definition are managed by other resources - so we need to lock and retain them
+	azureRMLockByName(name, logicAppResourceName)
+	defer azureRMUnlockByName(name, logicAppResourceName)
+
+	read, err := client.Get(resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Logic App Workflow %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.WorkflowProperties == nil || read.WorkflowProperties.Definition == nil {
+		return fmt.Errorf("Error parsing Logic App Workflow %q (Resource Group %q) - `properties.definition` was nil", name, resourceGroup)
+	}
+
+	parameters, err := expandLogicAppWorkflowParameters(d.Get("parameters").(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
+	definition := *read.WorkflowProperties.Definition
+	definition["parameters"] = expandLogicAppWorkflowParameterDeclarations(parameters)
+
+	tags := d.Get("tags").(map[string]interface{})
+	workflow := logic.Workflow{
+		Location: utils.String(d.Get("location").(string)),
+		Tags:     expandResourceTags(d, tags, meta),
+		WorkflowProperties: &logic.WorkflowProperties{
+			Definition:         &definition,
+			Parameters:         &parameters,
+			IntegrationAccount: expandLogicAppWorkflowIntegrationAccount(d),
+			State:              expandLogicAppWorkflowState(d),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(resourceGroup, name, workflow); err != nil {
+		return fmt.Errorf("Error updating Logic App Workflow %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return resourceArmLogicAppWorkflowRead(d, meta)
+}
+
+func resourceArmLogicAppWorkflowRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logicWorkflowsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["workflows"]
+
+	resp, err := client.Get(resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Logic App Workflow %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Logic App Workflow %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.WorkflowProperties; props != nil {
+		d.Set("access_endpoint", props.AccessEndpoint)
+		d.Set("enabled", props.State != logic.WorkflowStateDisabled)
+
+		integrationAccountId := ""
+		if account := props.IntegrationAccount; account != nil && account.ID != nil {
+			integrationAccountId = *account.ID
+		}
+		d.Set("integration_account_id", integrationAccountId)
+
+		if definition := props.Definition; definition != nil {
+			if v, ok := (*definition)["$schema"].(string); ok {
+				d.Set("workflow_schema", v)
+			}
+			if v, ok := (*definition)["contentVersion"].(string); ok {
+				d.Set("workflow_version", v)
+			}
+		}
+
+		parameters, err := flattenLogicAppWorkflowParameters(props.Parameters)
+		if err != nil {
+			return fmt.Errorf("Error flattening `parameters`: %+v", err)
+		}
+		if err := d.Set("parameters", parameters); err != nil {
+			return fmt.Errorf("Error setting `parameters`: %+v", err)
+		}
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmLogicAppWorkflowDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).logicWorkflowsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["workflows"]
+
+	resp, err := client.Delete(resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Logic App Workflow %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandLogicAppWorkflowIntegrationAccount(d *schema.ResourceData) *logic.ResourceReference {
+	if v, ok := d.GetOk("integration_account_id"); ok {
+		return &logic.ResourceReference{
+			ID: utils.String(v.(string)),
+		}
+	}
+
+	return nil
+}
+
+func expandLogicAppWorkflowState(d *schema.ResourceData) logic.WorkflowState {
+	if d.Get("enabled").(bool) {
+		return logic.WorkflowStateEnabled
+	}
+
+	return logic.WorkflowStateDisabled
+}
+
+func expandLogicAppWorkflowParameters(input map[string]interface{}) (map[string]*logic.WorkflowParameter, error) {
+	output := make(map[string]*logic.WorkflowParameter, 0)
+
+	for k, v := range input {
+		value, err := structure.ExpandJsonFromString(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("Error expanding the value of Parameter %q: %+v", k, err)
+		}
+
+		output[k] = &logic.WorkflowParameter{
+			Type:  logic.ParameterTypeObject,
+			Value: &value,
+		}
+	}
+
+	return output, nil
+}
+
+// each Parameter which is assigned a value needs to be declared within the Definition of the Logic App Workflow
+func expandLogicAppWorkflowParameterDeclarations(input map[string]*logic.WorkflowParameter) map[string]interface{} {
+	output := make(map[string]interface{}, 0)
+
+	for k, v := range input {
+		output[k] = map[string]interface{}{
+			"type": string(v.Type),
+		}
+	}
+
+	return output
+}
+
+func flattenLogicAppWorkflowParameters(input *map[string]*logic.WorkflowParameter) (map[string]interface{}, error) {
+	output := make(map[string]interface{}, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	for k, v := range *input {
+		if v == nil || v.Value == nil {
+			continue
+		}
+
+		value, err := structure.FlattenJsonToString(*v.Value)
+		if err != nil {
+			return nil, fmt.Errorf("Error flattening the value of Parameter %q: %+v", k, err)
+		}
+
+		output[k] = value
+	}
+
+	return output, nil
+}
+
+func validateLogicAppWorkflowParameters(v interface{}, k string) (ws []string, errors []error) {
+	parameters := v.(map[string]interface{})
+
+	for name, value := range parameters {
+		// values can't be validated until any interpolations have been resolved
+		str, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		if _, err := structure.ExpandJsonFromString(str); err != nil {
+			errors = append(errors, fmt.Errorf("The value of %q in %q must be a JSON Object: %+v", name, k, err))
+		}
+	}
+
+	return
+}
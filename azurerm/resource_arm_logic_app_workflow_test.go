@@ -0,0 +1,210 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMLogicAppWorkflow_empty(t *testing.T) {
+	resourceName := "azurerm_logic_app_workflow.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMLogicAppWorkflow_empty(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogicAppWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppWorkflowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "access_endpoint"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLogicAppWorkflow_update(t *testing.T) {
+	resourceName := "azurerm_logic_app_workflow.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogicAppWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLogicAppWorkflow_empty(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppWorkflowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMLogicAppWorkflow_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppWorkflowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Source", "AcceptanceTests"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateLogicAppWorkflowParameters(t *testing.T) {
+	valid := map[string]interface{}{
+		"settings": `{"retries": 3}`,
+	}
+	if _, errors := validateLogicAppWorkflowParameters(valid, "parameters"); len(errors) != 0 {
+		t.Fatalf("Expected %+v to be valid Parameters: %+v", valid, errors)
+	}
+
+	invalid := map[string]interface{}{
+		"settings": "three",
+	}
+	if _, errors := validateLogicAppWorkflowParameters(invalid, "parameters"); len(errors) == 0 {
+		t.Fatalf("Expected %+v to be invalid Parameters", invalid)
+	}
+}
+
+func testCheckAzureRMLogicAppWorkflowExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		workflowName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Logic App Workflow: %s", workflowName)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).logicWorkflowsClient
+		resp, err := conn.Get(resourceGroup, workflowName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on logicWorkflowsClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Logic App Workflow %q (resource group: %q) does not exist", workflowName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMLogicAppWorkflowDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).logicWorkflowsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_logic_app_workflow" {
+			continue
+		}
+
+		workflowName := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(resourceGroup, workflowName)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Logic App Workflow still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMLogicAppTriggerExists(name string) resource.TestCheckFunc {
+	return testCheckAzureRMLogicAppComponentExists(name, "triggers")
+}
+
+func testCheckAzureRMLogicAppActionExists(name string) resource.TestCheckFunc {
+	return testCheckAzureRMLogicAppComponentExists(name, "actions")
+}
+
+func testCheckAzureRMLogicAppComponentExists(name string, propertyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		logicAppName := id.Path["workflows"]
+		componentName := id.Path[propertyName]
+
+		component, _, err := retrieveLogicAppComponent(testAccProvider.Meta(), resourceGroup, logicAppName, propertyName, componentName)
+		if err != nil {
+			return err
+		}
+
+		if component == nil {
+			return fmt.Errorf("Bad: %q %q was not found in Logic App Workflow %q (resource group: %q)", propertyName, componentName, logicAppName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMLogicAppWorkflow_empty(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_logic_app_workflow" "test" {
+  name                = "acctestlaw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMLogicAppWorkflow_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_logic_app_workflow" "test" {
+  name                = "acctestlaw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  enabled             = false
+
+  parameters {
+    settings = "{\"retries\":3}"
+  }
+
+  tags {
+    Source = "AcceptanceTests"
+  }
+}
+`, rInt, location, rInt)
+}
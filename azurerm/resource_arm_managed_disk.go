@@ -18,7 +18,7 @@ func resourceArmManagedDisk() *schema.Resource {
 		Update: resourceArmManagedDiskCreate,
 		Delete: resourceArmManagedDiskDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("disks"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -114,7 +114,7 @@ func resourceArmManagedDiskCreate(d *schema.ResourceData, meta interface{}) erro
 	location := d.Get("location").(string)
 	resGroup := d.Get("resource_group_name").(string)
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandResourceTags(d, tags, meta)
 
 	createDisk := disk.Model{
 		Name:     &name,
@@ -168,7 +168,9 @@ func resourceArmManagedDiskCreate(d *schema.ResourceData, meta interface{}) erro
 		createDisk.EncryptionSettings = expandManagedDiskEncryptionSettings(settings)
 	}
 
-	_, diskErr := diskClient.CreateOrUpdate(resGroup, name, createDisk, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, diskErr := diskClient.CreateOrUpdate(resGroup, name, createDisk, cancel)
 	err := <-diskErr
 	if err != nil {
 		return err
@@ -225,7 +227,7 @@ func resourceArmManagedDiskRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -240,7 +242,9 @@ func resourceArmManagedDiskDelete(d *schema.ResourceData, meta interface{}) erro
 	resGroup := id.ResourceGroup
 	name := id.Path["disks"]
 
-	deleteResp, deleteErr := diskClient.Delete(resGroup, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := diskClient.Delete(resGroup, name, cancel)
 	resp := <-deleteResp
 	err = <-deleteErr
 	if err != nil {
@@ -0,0 +1,321 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/monitor"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMonitorActionGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMonitorActionGroupCreateOrUpdate,
+		Read:   resourceArmMonitorActionGroupRead,
+		Update: resourceArmMonitorActionGroupCreateOrUpdate,
+		Delete: resourceArmMonitorActionGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("actionGroups"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"short_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 12),
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"email_receiver": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"email_address": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"sms_receiver": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"country_code": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"phone_number": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"webhook_receiver": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"service_uri": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmMonitorActionGroupCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorActionGroupsClient
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	shortName := d.Get("short_name").(string)
+	enabled := d.Get("enabled").(bool)
+	tags := d.Get("tags").(map[string]interface{})
+
+	emailReceivers := expandMonitorActionGroupEmailReceiver(d)
+	smsReceivers := expandMonitorActionGroupSmsReceiver(d)
+	webhookReceivers := expandMonitorActionGroupWebHookReceiver(d)
+
+	parameters := monitor.ActionGroupResource{
+		// Action Groups are a Global resource
+		Location: utils.String("Global"),
+		ActionGroup: &monitor.ActionGroup{
+			GroupShortName:   utils.String(shortName),
+			Enabled:          utils.Bool(enabled),
+			EmailReceivers:   emailReceivers,
+			SmsReceivers:     smsReceivers,
+			WebhookReceivers: webhookReceivers,
+		},
+		Tags: expandResourceTags(d, tags, meta),
+	}
+
+	_, err := client.CreateOrUpdate(resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating or updating Action Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Action Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Action Group %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMonitorActionGroupRead(d, meta)
+}
+
+func resourceArmMonitorActionGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorActionGroupsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["actionGroups"]
+
+	resp, err := client.Get(resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Action Group %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Action Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+
+	if group := resp.ActionGroup; group != nil {
+		d.Set("short_name", group.GroupShortName)
+		d.Set("enabled", group.Enabled)
+
+		if err := d.Set("email_receiver", flattenMonitorActionGroupEmailReceiver(group.EmailReceivers)); err != nil {
+			return fmt.Errorf("Error setting `email_receiver`: %+v", err)
+		}
+
+		if err := d.Set("sms_receiver", flattenMonitorActionGroupSmsReceiver(group.SmsReceivers)); err != nil {
+			return fmt.Errorf("Error setting `sms_receiver`: %+v", err)
+		}
+
+		if err := d.Set("webhook_receiver", flattenMonitorActionGroupWebHookReceiver(group.WebhookReceivers)); err != nil {
+			return fmt.Errorf("Error setting `webhook_receiver`: %+v", err)
+		}
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmMonitorActionGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorActionGroupsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["actionGroups"]
+
+	resp, err := client.Delete(resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Action Group %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandMonitorActionGroupEmailReceiver(d *schema.ResourceData) *[]monitor.EmailReceiver {
+	receivers := make([]monitor.EmailReceiver, 0)
+
+	for _, v := range d.Get("email_receiver").([]interface{}) {
+		val := v.(map[string]interface{})
+		receiver := monitor.EmailReceiver{
+			Name:         utils.String(val["name"].(string)),
+			EmailAddress: utils.String(val["email_address"].(string)),
+		}
+		receivers = append(receivers, receiver)
+	}
+
+	return &receivers
+}
+
+func expandMonitorActionGroupSmsReceiver(d *schema.ResourceData) *[]monitor.SmsReceiver {
+	receivers := make([]monitor.SmsReceiver, 0)
+
+	for _, v := range d.Get("sms_receiver").([]interface{}) {
+		val := v.(map[string]interface{})
+		receiver := monitor.SmsReceiver{
+			Name:        utils.String(val["name"].(string)),
+			CountryCode: utils.String(val["country_code"].(string)),
+			PhoneNumber: utils.String(val["phone_number"].(string)),
+		}
+		receivers = append(receivers, receiver)
+	}
+
+	return &receivers
+}
+
+func expandMonitorActionGroupWebHookReceiver(d *schema.ResourceData) *[]monitor.WebhookReceiver {
+	receivers := make([]monitor.WebhookReceiver, 0)
+
+	for _, v := range d.Get("webhook_receiver").([]interface{}) {
+		val := v.(map[string]interface{})
+		receiver := monitor.WebhookReceiver{
+			Name:       utils.String(val["name"].(string)),
+			ServiceURI: utils.String(val["service_uri"].(string)),
+		}
+		receivers = append(receivers, receiver)
+	}
+
+	return &receivers
+}
+
+func flattenMonitorActionGroupEmailReceiver(receivers *[]monitor.EmailReceiver) []interface{} {
+	result := make([]interface{}, 0)
+
+	if receivers != nil {
+		for _, receiver := range *receivers {
+			val := make(map[string]interface{}, 0)
+			if receiver.Name != nil {
+				val["name"] = *receiver.Name
+			}
+			if receiver.EmailAddress != nil {
+				val["email_address"] = *receiver.EmailAddress
+			}
+			result = append(result, val)
+		}
+	}
+
+	return result
+}
+
+func flattenMonitorActionGroupSmsReceiver(receivers *[]monitor.SmsReceiver) []interface{} {
+	result := make([]interface{}, 0)
+
+	if receivers != nil {
+		for _, receiver := range *receivers {
+			val := make(map[string]interface{}, 0)
+			if receiver.Name != nil {
+				val["name"] = *receiver.Name
+			}
+			if receiver.CountryCode != nil {
+				val["country_code"] = *receiver.CountryCode
+			}
+			if receiver.PhoneNumber != nil {
+				val["phone_number"] = *receiver.PhoneNumber
+			}
+			result = append(result, val)
+		}
+	}
+
+	return result
+}
+
+func flattenMonitorActionGroupWebHookReceiver(receivers *[]monitor.WebhookReceiver) []interface{} {
+	result := make([]interface{}, 0)
+
+	if receivers != nil {
+		for _, receiver := range *receivers {
+			val := make(map[string]interface{}, 0)
+			if receiver.Name != nil {
+				val["name"] = *receiver.Name
+			}
+			if receiver.ServiceURI != nil {
+				val["service_uri"] = *receiver.ServiceURI
+			}
+			result = append(result, val)
+		}
+	}
+
+	return result
+}
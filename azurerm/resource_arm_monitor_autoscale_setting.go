@@ -0,0 +1,937 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/arm/monitor"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMonitorAutoscaleSetting() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMonitorAutoscaleSettingCreateOrUpdate,
+		Read:   resourceArmMonitorAutoscaleSettingRead,
+		Update: resourceArmMonitorAutoscaleSettingCreateOrUpdate,
+		Delete: resourceArmMonitorAutoscaleSettingDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("autoscalesettings"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"target_resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"profile": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"capacity": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"minimum": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(0, 1000),
+									},
+									"maximum": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(0, 1000),
+									},
+									"default": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(0, 1000),
+									},
+								},
+							},
+						},
+
+						"rule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 10,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_trigger": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"metric_name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"metric_resource_id": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"time_grain": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"statistic": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														string(monitor.Average),
+														string(monitor.Max),
+														string(monitor.Min),
+														string(monitor.Sum),
+													}, true),
+													DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+												},
+												"time_window": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"time_aggregation": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														string(monitor.TimeAggregationTypeAverage),
+														string(monitor.TimeAggregationTypeCount),
+														string(monitor.TimeAggregationTypeMaximum),
+														string(monitor.TimeAggregationTypeMinimum),
+														string(monitor.TimeAggregationTypeTotal),
+													}, true),
+													DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+												},
+												"operator": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														string(monitor.Equals),
+														string(monitor.GreaterThan),
+														string(monitor.GreaterThanOrEqual),
+														string(monitor.LessThan),
+														string(monitor.LessThanOrEqual),
+														string(monitor.NotEquals),
+													}, true),
+													DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+												},
+												"threshold": {
+													Type:     schema.TypeFloat,
+													Required: true,
+												},
+											},
+										},
+									},
+
+									"scale_action": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"direction": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														string(monitor.ScaleDirectionDecrease),
+														string(monitor.ScaleDirectionIncrease),
+													}, true),
+													DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+												},
+												"type": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														string(monitor.ChangeCount),
+														string(monitor.ExactCount),
+														string(monitor.PercentChangeCount),
+													}, true),
+													DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+												},
+												"value": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntAtLeast(0),
+												},
+												"cooldown": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
+						"fixed_date": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"timezone": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "UTC",
+									},
+									"start": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateRFC3339Date,
+									},
+									"end": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateRFC3339Date,
+									},
+								},
+							},
+						},
+
+						"recurrence": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"timezone": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "UTC",
+									},
+									"days": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												"Monday",
+												"Tuesday",
+												"Wednesday",
+												"Thursday",
+												"Friday",
+												"Saturday",
+												"Sunday",
+											}, true),
+											DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+										},
+									},
+									"hours": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeInt,
+											ValidateFunc: validation.IntBetween(0, 23),
+										},
+									},
+									"minutes": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeInt,
+											ValidateFunc: validation.IntBetween(0, 59),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"notification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"send_to_subscription_administrator": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"send_to_subscription_co_administrator": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"custom_emails": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"webhook": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"service_uri": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"properties": {
+										Type:     schema.TypeMap,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmMonitorAutoscaleSettingCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorAutoscaleSettingsClient
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := d.Get("location").(string)
+	enabled := d.Get("enabled").(bool)
+	targetResourceId := d.Get("target_resource_id").(string)
+
+	profiles, err := expandMonitorAutoscaleSettingProfile(d.Get("profile").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("Error expanding `profile`: %+v", err)
+	}
+
+	notifications := expandMonitorAutoscaleSettingNotifications(d.Get("notification").([]interface{}))
+	tags := d.Get("tags").(map[string]interface{})
+
+	parameters := monitor.AutoscaleSettingResource{
+		Location: utils.String(location),
+		AutoscaleSetting: &monitor.AutoscaleSetting{
+			Enabled:           utils.Bool(enabled),
+			Profiles:          profiles,
+			Notifications:     notifications,
+			TargetResourceURI: utils.String(targetResourceId),
+		},
+		Tags: expandResourceTags(d, tags, meta),
+	}
+
+	_, err = client.CreateOrUpdate(resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating AutoScale Setting %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving AutoScale Setting %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("AutoScale Setting %q (Resource Group %q) has no ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMonitorAutoscaleSettingRead(d, meta)
+}
+
+func resourceArmMonitorAutoscaleSettingRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorAutoscaleSettingsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["autoscalesettings"]
+
+	resp, err := client.Get(resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] AutoScale Setting %q (Resource Group %q) was not found - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading AutoScale Setting %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.AutoscaleSetting; props != nil {
+		d.Set("enabled", props.Enabled)
+		d.Set("target_resource_id", props.TargetResourceURI)
+
+		profile, err := flattenMonitorAutoscaleSettingProfile(props.Profiles)
+		if err != nil {
+			return fmt.Errorf("Error flattening `profile`: %+v", err)
+		}
+		if err := d.Set("profile", profile); err != nil {
+			return fmt.Errorf("Error setting `profile`: %+v", err)
+		}
+
+		notifications := flattenMonitorAutoscaleSettingNotification(props.Notifications)
+		if err := d.Set("notification", notifications); err != nil {
+			return fmt.Errorf("Error setting `notification`: %+v", err)
+		}
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmMonitorAutoscaleSettingDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorAutoscaleSettingsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["autoscalesettings"]
+
+	resp, err := client.Delete(resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting AutoScale Setting %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandMonitorAutoscaleSettingProfile(input []interface{}) (*[]monitor.AutoscaleProfile, error) {
+	results := make([]monitor.AutoscaleProfile, 0)
+
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+
+		name := raw["name"].(string)
+
+		// this is Required, so we don't need to check for optionals here
+		capacitiesRaw := raw["capacity"].([]interface{})
+		capacityRaw := capacitiesRaw[0].(map[string]interface{})
+		capacity := monitor.ScaleCapacity{
+			Minimum: utils.String(strconv.Itoa(capacityRaw["minimum"].(int))),
+			Maximum: utils.String(strconv.Itoa(capacityRaw["maximum"].(int))),
+			Default: utils.String(strconv.Itoa(capacityRaw["default"].(int))),
+		}
+
+		recurrencesRaw := raw["recurrence"].([]interface{})
+		recurrence := expandMonitorAutoscaleSettingRecurrence(recurrencesRaw)
+
+		rulesRaw := raw["rule"].([]interface{})
+		rules := expandMonitorAutoscaleSettingRule(rulesRaw)
+
+		fixedDatesRaw := raw["fixed_date"].([]interface{})
+		fixedDate, err := expandMonitorAutoscaleSettingFixedDate(fixedDatesRaw)
+		if err != nil {
+			return nil, fmt.Errorf("Error expanding `fixed_date`: %+v", err)
+		}
+
+		result := monitor.AutoscaleProfile{
+			Name:       utils.String(name),
+			Capacity:   &capacity,
+			FixedDate:  fixedDate,
+			Recurrence: recurrence,
+			Rules:      rules,
+		}
+		results = append(results, result)
+	}
+
+	return &results, nil
+}
+
+func expandMonitorAutoscaleSettingRule(input []interface{}) *[]monitor.ScaleRule {
+	rules := make([]monitor.ScaleRule, 0)
+
+	for _, v := range input {
+		ruleRaw := v.(map[string]interface{})
+
+		triggersRaw := ruleRaw["metric_trigger"].([]interface{})
+		triggerRaw := triggersRaw[0].(map[string]interface{})
+		metricTrigger := monitor.MetricTrigger{
+			MetricName:        utils.String(triggerRaw["metric_name"].(string)),
+			MetricResourceURI: utils.String(triggerRaw["metric_resource_id"].(string)),
+			TimeGrain:         utils.String(triggerRaw["time_grain"].(string)),
+			Statistic:         monitor.MetricStatisticType(triggerRaw["statistic"].(string)),
+			TimeWindow:        utils.String(triggerRaw["time_window"].(string)),
+			TimeAggregation:   monitor.TimeAggregationType(triggerRaw["time_aggregation"].(string)),
+			Operator:          monitor.ComparisonOperationType(triggerRaw["operator"].(string)),
+			Threshold:         utils.Float(triggerRaw["threshold"].(float64)),
+		}
+
+		actionsRaw := ruleRaw["scale_action"].([]interface{})
+		actionRaw := actionsRaw[0].(map[string]interface{})
+		scaleAction := monitor.ScaleAction{
+			Direction: monitor.ScaleDirection(actionRaw["direction"].(string)),
+			Type:      monitor.ScaleType(actionRaw["type"].(string)),
+			Value:     utils.String(strconv.Itoa(actionRaw["value"].(int))),
+			Cooldown:  utils.String(actionRaw["cooldown"].(string)),
+		}
+
+		rule := monitor.ScaleRule{
+			MetricTrigger: &metricTrigger,
+			ScaleAction:   &scaleAction,
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return &rules
+}
+
+func expandMonitorAutoscaleSettingFixedDate(input []interface{}) (*monitor.TimeWindow, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	startString := raw["start"].(string)
+	startTime, err := date.ParseTime(time.RFC3339, startString)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse `start` time %q as an RFC3339 date: %+v", startString, err)
+	}
+	endString := raw["end"].(string)
+	endTime, err := date.ParseTime(time.RFC3339, endString)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse `end` time %q as an RFC3339 date: %+v", endString, err)
+	}
+
+	timeZone := raw["timezone"].(string)
+	timeWindow := monitor.TimeWindow{
+		TimeZone: utils.String(timeZone),
+		Start: &date.Time{
+			Time: startTime,
+		},
+		End: &date.Time{
+			Time: endTime,
+		},
+	}
+	return &timeWindow, nil
+}
+
+func expandMonitorAutoscaleSettingRecurrence(input []interface{}) *monitor.Recurrence {
+	if len(input) == 0 {
+		return nil
+	}
+
+	recurrenceRaw := input[0].(map[string]interface{})
+
+	timeZone := recurrenceRaw["timezone"].(string)
+	days := make([]string, 0)
+	for _, dayItem := range recurrenceRaw["days"].([]interface{}) {
+		days = append(days, dayItem.(string))
+	}
+
+	hours := make([]int32, 0)
+	for _, hourItem := range recurrenceRaw["hours"].([]interface{}) {
+		hours = append(hours, int32(hourItem.(int)))
+	}
+
+	minutes := make([]int32, 0)
+	for _, minuteItem := range recurrenceRaw["minutes"].([]interface{}) {
+		minutes = append(minutes, int32(minuteItem.(int)))
+	}
+
+	return &monitor.Recurrence{
+		// API docs say this has to be `Week`.
+		Frequency: monitor.Week,
+		Schedule: &monitor.RecurrentSchedule{
+			TimeZone: utils.String(timeZone),
+			Days:     &days,
+			Hours:    &hours,
+			Minutes:  &minutes,
+		},
+	}
+}
+
+func expandMonitorAutoscaleSettingNotifications(input []interface{}) *[]monitor.AutoscaleNotification {
+	notifications := make([]monitor.AutoscaleNotification, 0)
+
+	for _, v := range input {
+		notificationRaw := v.(map[string]interface{})
+
+		emailsRaw := notificationRaw["email"].([]interface{})
+		emailRaw := make(map[string]interface{}, 0)
+		if len(emailsRaw) > 0 && emailsRaw[0] != nil {
+			emailRaw = emailsRaw[0].(map[string]interface{})
+		}
+		email := expandMonitorAutoscaleSettingNotificationEmail(emailRaw)
+
+		configsRaw := notificationRaw["webhook"].([]interface{})
+		webhooks := expandMonitorAutoscaleSettingNotificationWebhook(configsRaw)
+
+		notification := monitor.AutoscaleNotification{
+			Email:     email,
+			Operation: utils.String("scale"),
+			Webhooks:  webhooks,
+		}
+		notifications = append(notifications, notification)
+	}
+
+	return &notifications
+}
+
+func expandMonitorAutoscaleSettingNotificationEmail(input map[string]interface{}) *monitor.EmailNotification {
+	customEmails := make([]string, 0)
+	if v, ok := input["custom_emails"]; ok {
+		for _, item := range v.([]interface{}) {
+			customEmails = append(customEmails, item.(string))
+		}
+	}
+
+	sendToAdmin := false
+	if v, ok := input["send_to_subscription_administrator"]; ok {
+		sendToAdmin = v.(bool)
+	}
+
+	sendToCoAdmin := false
+	if v, ok := input["send_to_subscription_co_administrator"]; ok {
+		sendToCoAdmin = v.(bool)
+	}
+
+	return &monitor.EmailNotification{
+		CustomEmails:                       &customEmails,
+		SendToSubscriptionAdministrator:    utils.Bool(sendToAdmin),
+		SendToSubscriptionCoAdministrators: utils.Bool(sendToCoAdmin),
+	}
+}
+
+func expandMonitorAutoscaleSettingNotificationWebhook(input []interface{}) *[]monitor.WebhookNotification {
+	webhooks := make([]monitor.WebhookNotification, 0)
+
+	for _, v := range input {
+		webhookRaw := v.(map[string]interface{})
+
+		webhook := monitor.WebhookNotification{
+			ServiceURI: utils.String(webhookRaw["service_uri"].(string)),
+		}
+
+		if props, ok := webhookRaw["properties"]; ok {
+			properties := make(map[string]*string, 0)
+			for key, value := range props.(map[string]interface{}) {
+				properties[key] = utils.String(value.(string))
+			}
+
+			webhook.Properties = &properties
+		}
+
+		webhooks = append(webhooks, webhook)
+	}
+
+	return &webhooks
+}
+
+func flattenMonitorAutoscaleSettingProfile(profiles *[]monitor.AutoscaleProfile) ([]interface{}, error) {
+	if profiles == nil {
+		return []interface{}{}, nil
+	}
+
+	results := make([]interface{}, 0)
+	for _, profile := range *profiles {
+		result := make(map[string]interface{}, 0)
+
+		if name := profile.Name; name != nil {
+			result["name"] = *name
+		}
+
+		capacity, err := flattenMonitorAutoscaleSettingCapacity(profile.Capacity)
+		if err != nil {
+			return nil, fmt.Errorf("Error flattening `capacity`: %+v", err)
+		}
+		result["capacity"] = capacity
+
+		result["fixed_date"] = flattenMonitorAutoscaleSettingFixedDate(profile.FixedDate)
+		result["recurrence"] = flattenMonitorAutoscaleSettingRecurrence(profile.Recurrence)
+
+		rule, err := flattenMonitorAutoscaleSettingRules(profile.Rules)
+		if err != nil {
+			return nil, fmt.Errorf("Error flattening `rule`: %+v", err)
+		}
+		result["rule"] = rule
+
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func flattenMonitorAutoscaleSettingCapacity(input *monitor.ScaleCapacity) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	result := make(map[string]interface{}, 0)
+
+	if minStr := input.Minimum; minStr != nil {
+		min, err := strconv.Atoi(*minStr)
+		if err != nil {
+			return nil, fmt.Errorf("Error converting Minimum Scale Capacity %q to an int: %+v", *minStr, err)
+		}
+		result["minimum"] = min
+	}
+
+	if maxStr := input.Maximum; maxStr != nil {
+		max, err := strconv.Atoi(*maxStr)
+		if err != nil {
+			return nil, fmt.Errorf("Error converting Maximum Scale Capacity %q to an int: %+v", *maxStr, err)
+		}
+		result["maximum"] = max
+	}
+
+	if defaultCapacityStr := input.Default; defaultCapacityStr != nil {
+		defaultCapacity, err := strconv.Atoi(*defaultCapacityStr)
+		if err != nil {
+			return nil, fmt.Errorf("Error converting Default Scale Capacity %q to an int: %+v", *defaultCapacityStr, err)
+		}
+		result["default"] = defaultCapacity
+	}
+
+	return []interface{}{result}, nil
+}
+
+func flattenMonitorAutoscaleSettingRules(input *[]monitor.ScaleRule) ([]interface{}, error) {
+	if input == nil {
+		return []interface{}{}, nil
+	}
+
+	results := make([]interface{}, 0)
+	for _, rule := range *input {
+		result := make(map[string]interface{}, 0)
+
+		metricTriggers := make([]interface{}, 0)
+		if trigger := rule.MetricTrigger; trigger != nil {
+			output := make(map[string]interface{}, 0)
+
+			output["operator"] = string(trigger.Operator)
+			output["statistic"] = string(trigger.Statistic)
+			output["time_aggregation"] = string(trigger.TimeAggregation)
+
+			if trigger.MetricName != nil {
+				output["metric_name"] = *trigger.MetricName
+			}
+
+			if trigger.MetricResourceURI != nil {
+				output["metric_resource_id"] = *trigger.MetricResourceURI
+			}
+
+			if trigger.TimeGrain != nil {
+				output["time_grain"] = *trigger.TimeGrain
+			}
+
+			if trigger.TimeWindow != nil {
+				output["time_window"] = *trigger.TimeWindow
+			}
+
+			if trigger.Threshold != nil {
+				output["threshold"] = *trigger.Threshold
+			}
+
+			metricTriggers = append(metricTriggers, output)
+		}
+
+		result["metric_trigger"] = metricTriggers
+
+		scaleActions := make([]interface{}, 0)
+		if v := rule.ScaleAction; v != nil {
+			action := make(map[string]interface{}, 0)
+
+			action["direction"] = string(v.Direction)
+			action["type"] = string(v.Type)
+
+			if v.Cooldown != nil {
+				action["cooldown"] = *v.Cooldown
+			}
+
+			if val := v.Value; val != nil && *val != "" {
+				i, err := strconv.Atoi(*val)
+				if err != nil {
+					return nil, fmt.Errorf("`value` %q was not convertable to an int: %s", *val, err)
+				}
+				action["value"] = i
+			}
+
+			scaleActions = append(scaleActions, action)
+		}
+
+		result["scale_action"] = scaleActions
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func flattenMonitorAutoscaleSettingFixedDate(input *monitor.TimeWindow) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	result := make(map[string]interface{}, 0)
+
+	if timezone := input.TimeZone; timezone != nil {
+		result["timezone"] = *timezone
+	}
+
+	if start := input.Start; start != nil {
+		result["start"] = start.String()
+	}
+
+	if end := input.End; end != nil {
+		result["end"] = end.String()
+	}
+
+	return []interface{}{result}
+}
+
+func flattenMonitorAutoscaleSettingRecurrence(input *monitor.Recurrence) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	result := make(map[string]interface{}, 0)
+
+	if schedule := input.Schedule; schedule != nil {
+
+		if timezone := schedule.TimeZone; timezone != nil {
+			result["timezone"] = *timezone
+		}
+
+		days := make([]string, 0)
+		if s := schedule.Days; s != nil {
+			days = *s
+		}
+		result["days"] = days
+
+		hours := make([]int, 0)
+		if schedule.Hours != nil {
+			for _, v := range *schedule.Hours {
+				hours = append(hours, int(v))
+			}
+		}
+		result["hours"] = hours
+
+		minutes := make([]int, 0)
+		if schedule.Minutes != nil {
+			for _, v := range *schedule.Minutes {
+				minutes = append(minutes, int(v))
+			}
+		}
+		result["minutes"] = minutes
+	}
+
+	return []interface{}{result}
+}
+
+func flattenMonitorAutoscaleSettingNotification(notifications *[]monitor.AutoscaleNotification) []interface{} {
+	results := make([]interface{}, 0)
+
+	if notifications == nil {
+		return results
+	}
+
+	for _, notification := range *notifications {
+		result := make(map[string]interface{}, 0)
+
+		emails := make([]interface{}, 0)
+		if email := notification.Email; email != nil {
+			block := make(map[string]interface{}, 0)
+
+			if send := email.SendToSubscriptionAdministrator; send != nil {
+				block["send_to_subscription_administrator"] = *send
+			}
+
+			if send := email.SendToSubscriptionCoAdministrators; send != nil {
+				block["send_to_subscription_co_administrator"] = *send
+			}
+
+			customEmails := make([]interface{}, 0)
+			if custom := email.CustomEmails; custom != nil {
+				for _, v := range *custom {
+					customEmails = append(customEmails, v)
+				}
+			}
+			block["custom_emails"] = customEmails
+
+			emails = append(emails, block)
+		}
+		result["email"] = emails
+
+		webhooks := make([]interface{}, 0)
+		if hooks := notification.Webhooks; hooks != nil {
+			for _, v := range *hooks {
+				hook := make(map[string]interface{}, 0)
+
+				if v.ServiceURI != nil {
+					hook["service_uri"] = *v.ServiceURI
+				}
+
+				props := make(map[string]string, 0)
+				if v.Properties != nil {
+					for key, value := range *v.Properties {
+						if value != nil {
+							props[key] = *value
+						}
+					}
+				}
+				hook["properties"] = props
+				webhooks = append(webhooks, hook)
+			}
+		}
+
+		result["webhook"] = webhooks
+
+		results = append(results, result)
+	}
+	return results
+}
@@ -0,0 +1,303 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMonitorAutoscaleSetting_basic(t *testing.T) {
+	resourceName := "azurerm_monitor_autoscale_setting.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMonitorAutoscaleSetting_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorAutoscaleSettingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorAutoscaleSettingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "profile.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "profile.0.name", "metricRules"),
+					resource.TestCheckResourceAttr(resourceName, "profile.0.rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "profile.0.rule.0.scale_action.0.direction", "Increase"),
+					resource.TestCheckResourceAttr(resourceName, "profile.0.rule.1.scale_action.0.direction", "Decrease"),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorAutoscaleSetting_recurrence(t *testing.T) {
+	resourceName := "azurerm_monitor_autoscale_setting.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMonitorAutoscaleSetting_recurrence(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorAutoscaleSettingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorAutoscaleSettingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "profile.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "profile.0.recurrence.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "profile.0.recurrence.0.days.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "notification.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "notification.0.email.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "notification.0.email.0.custom_emails.0", "admin@contoso.com"),
+					resource.TestCheckResourceAttr(resourceName, "notification.0.webhook.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorAutoscaleSetting_fixedDate(t *testing.T) {
+	resourceName := "azurerm_monitor_autoscale_setting.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMonitorAutoscaleSetting_fixedDate(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorAutoscaleSettingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorAutoscaleSettingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "profile.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "profile.0.fixed_date.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "profile.0.fixed_date.0.timezone", "Pacific Standard Time"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMonitorAutoscaleSettingExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		settingName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for AutoScale Setting: %s", settingName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).monitorAutoscaleSettingsClient
+
+		resp, err := client.Get(resourceGroup, settingName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: AutoScale Setting %q (Resource Group: %q) does not exist", settingName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on monitorAutoscaleSettingsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMonitorAutoscaleSettingDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).monitorAutoscaleSettingsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_monitor_autoscale_setting" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("AutoScale Setting %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMonitorAutoscaleSetting_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMMonitorAutoscaleSetting_basic(rInt int, location string) string {
+	template := testAccAzureRMMonitorAutoscaleSetting_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_autoscale_setting" "test" {
+  name                = "acctestautoscale-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  target_resource_id  = "${azurerm_app_service_plan.test.id}"
+
+  profile {
+    name = "metricRules"
+
+    capacity {
+      default = 1
+      minimum = 1
+      maximum = 3
+    }
+
+    rule {
+      metric_trigger {
+        metric_name        = "CpuPercentage"
+        metric_resource_id = "${azurerm_app_service_plan.test.id}"
+        time_grain         = "PT1M"
+        statistic          = "Average"
+        time_window        = "PT5M"
+        time_aggregation   = "Average"
+        operator           = "GreaterThan"
+        threshold          = 75
+      }
+
+      scale_action {
+        direction = "Increase"
+        type      = "ChangeCount"
+        value     = 1
+        cooldown  = "PT1M"
+      }
+    }
+
+    rule {
+      metric_trigger {
+        metric_name        = "CpuPercentage"
+        metric_resource_id = "${azurerm_app_service_plan.test.id}"
+        time_grain         = "PT1M"
+        statistic          = "Average"
+        time_window        = "PT5M"
+        time_aggregation   = "Average"
+        operator           = "LessThan"
+        threshold          = 25
+      }
+
+      scale_action {
+        direction = "Decrease"
+        type      = "ChangeCount"
+        value     = 1
+        cooldown  = "PT1M"
+      }
+    }
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMonitorAutoscaleSetting_recurrence(rInt int, location string) string {
+	template := testAccAzureRMMonitorAutoscaleSetting_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_autoscale_setting" "test" {
+  name                = "acctestautoscale-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  target_resource_id  = "${azurerm_app_service_plan.test.id}"
+
+  profile {
+    name = "Weekends"
+
+    capacity {
+      default = 1
+      minimum = 1
+      maximum = 3
+    }
+
+    recurrence {
+      timezone = "Pacific Standard Time"
+      days     = ["Saturday", "Sunday"]
+      hours    = [12]
+      minutes  = [0]
+    }
+  }
+
+  notification {
+    email {
+      send_to_subscription_administrator    = false
+      send_to_subscription_co_administrator = false
+      custom_emails                         = ["admin@contoso.com"]
+    }
+
+    webhook {
+      service_uri = "http://example.com/scale"
+
+      properties {
+        "environment" = "test"
+      }
+    }
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMonitorAutoscaleSetting_fixedDate(rInt int, location string) string {
+	template := testAccAzureRMMonitorAutoscaleSetting_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_autoscale_setting" "test" {
+  name                = "acctestautoscale-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  target_resource_id  = "${azurerm_app_service_plan.test.id}"
+
+  profile {
+    name = "fixedDate"
+
+    capacity {
+      default = 1
+      minimum = 1
+      maximum = 3
+    }
+
+    fixed_date {
+      timezone = "Pacific Standard Time"
+      start    = "2020-06-18T00:00:00Z"
+      end      = "2020-06-18T23:59:59Z"
+    }
+  }
+}
+`, template, rInt)
+}
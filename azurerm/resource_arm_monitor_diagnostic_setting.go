@@ -0,0 +1,354 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/monitor"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMonitorDiagnosticSetting() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMonitorDiagnosticSettingCreateOrUpdate,
+		Read:   resourceArmMonitorDiagnosticSettingRead,
+		Update: resourceArmMonitorDiagnosticSettingCreateOrUpdate,
+		Delete: resourceArmMonitorDiagnosticSettingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"target_resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"eventhub_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"eventhub_authorization_rule_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"log_analytics_workspace_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"storage_account_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"log": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"category": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"retention_policy": monitorDiagnosticSettingRetentionPolicySchema(),
+					},
+				},
+			},
+
+			"metric": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"category": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"retention_policy": monitorDiagnosticSettingRetentionPolicySchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func monitorDiagnosticSettingRetentionPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+
+				"days": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 365),
+				},
+			},
+		},
+	}
+}
+
+func resourceArmMonitorDiagnosticSettingCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorDiagnosticSettingsClient
+	log.Printf("[INFO] preparing arguments for Azure ARM Diagnostic Settings.")
+
+	name := d.Get("name").(string)
+	targetResourceId := d.Get("target_resource_id").(string)
+
+	logs := expandMonitorDiagnosticSettingLogs(d.Get("log").(*schema.Set).List())
+	metrics := expandMonitorDiagnosticSettingMetrics(d.Get("metric").(*schema.Set).List())
+	if len(logs) == 0 && len(metrics) == 0 {
+		return fmt.Errorf("At least one `log` or `metric` block must be specified for Diagnostic Setting %q", name)
+	}
+
+	properties := monitor.DiagnosticSettings{
+		Logs:    &logs,
+		Metrics: &metrics,
+	}
+
+	hasDestination := false
+
+	eventHubName := d.Get("eventhub_name").(string)
+	if eventHubName != "" {
+		properties.EventHubName = utils.String(eventHubName)
+	}
+
+	eventHubAuthorizationRuleId := d.Get("eventhub_authorization_rule_id").(string)
+	if eventHubAuthorizationRuleId != "" {
+		properties.EventHubAuthorizationRuleID = utils.String(eventHubAuthorizationRuleId)
+		hasDestination = true
+	}
+
+	workspaceId := d.Get("log_analytics_workspace_id").(string)
+	if workspaceId != "" {
+		properties.WorkspaceID = utils.String(workspaceId)
+		hasDestination = true
+	}
+
+	storageAccountId := d.Get("storage_account_id").(string)
+	if storageAccountId != "" {
+		properties.StorageAccountID = utils.String(storageAccountId)
+		hasDestination = true
+	}
+
+	if !hasDestination {
+		return fmt.Errorf("At least one of `eventhub_authorization_rule_id`, `log_analytics_workspace_id` or `storage_account_id` must be specified for Diagnostic Setting %q", name)
+	}
+
+	parameters := monitor.DiagnosticSettingsResource{
+		DiagnosticSettings: &properties,
+	}
+
+	_, err := monitorDiagnosticSettingsCreateOrUpdate(client, targetResourceId, parameters, name)
+	if err != nil {
+		return fmt.Errorf("Error creating Diagnostic Setting %q (Resource %q): %+v", name, targetResourceId, err)
+	}
+
+	read, err := monitorDiagnosticSettingsGet(client, targetResourceId, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Diagnostic Setting %q (Resource %q): %+v", name, targetResourceId, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Diagnostic Setting %q (Resource %q)", name, targetResourceId)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMonitorDiagnosticSettingRead(d, meta)
+}
+
+func resourceArmMonitorDiagnosticSettingRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorDiagnosticSettingsClient
+
+	id, err := parseMonitorDiagnosticSettingId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := monitorDiagnosticSettingsGet(client, id.ResourceID, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[WARN] Diagnostic Setting %q was not found for Resource %q - removing from state!", id.Name, id.ResourceID)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Diagnostic Setting %q (Resource %q): %+v", id.Name, id.ResourceID, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("target_resource_id", id.ResourceID)
+
+	if props := resp.DiagnosticSettings; props != nil {
+		d.Set("eventhub_name", props.EventHubName)
+		d.Set("eventhub_authorization_rule_id", props.EventHubAuthorizationRuleID)
+		d.Set("log_analytics_workspace_id", props.WorkspaceID)
+		d.Set("storage_account_id", props.StorageAccountID)
+
+		if err := d.Set("log", flattenMonitorDiagnosticSettingLogs(props.Logs)); err != nil {
+			return fmt.Errorf("Error setting `log`: %+v", err)
+		}
+
+		if err := d.Set("metric", flattenMonitorDiagnosticSettingMetrics(props.Metrics)); err != nil {
+			return fmt.Errorf("Error setting `metric`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmMonitorDiagnosticSettingDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorDiagnosticSettingsClient
+
+	id, err := parseMonitorDiagnosticSettingId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := monitorDiagnosticSettingsDelete(client, id.ResourceID, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Diagnostic Setting %q (Resource %q): %+v", id.Name, id.ResourceID, err)
+	}
+
+	return nil
+}
+
+func expandMonitorDiagnosticSettingLogs(input []interface{}) []monitor.LogSettings {
+	results := make([]monitor.LogSettings, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		results = append(results, monitor.LogSettings{
+			Category:        utils.String(v["category"].(string)),
+			Enabled:         utils.Bool(v["enabled"].(bool)),
+			RetentionPolicy: expandMonitorDiagnosticSettingRetentionPolicy(v["retention_policy"].([]interface{})),
+		})
+	}
+
+	return results
+}
+
+func expandMonitorDiagnosticSettingMetrics(input []interface{}) []monitor.MetricSettings {
+	results := make([]monitor.MetricSettings, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		results = append(results, monitor.MetricSettings{
+			Category:        utils.String(v["category"].(string)),
+			Enabled:         utils.Bool(v["enabled"].(bool)),
+			RetentionPolicy: expandMonitorDiagnosticSettingRetentionPolicy(v["retention_policy"].([]interface{})),
+		})
+	}
+
+	return results
+}
+
+func expandMonitorDiagnosticSettingRetentionPolicy(input []interface{}) *monitor.RetentionPolicy {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &monitor.RetentionPolicy{
+		Enabled: utils.Bool(v["enabled"].(bool)),
+		Days:    utils.Int32(int32(v["days"].(int))),
+	}
+}
+
+func flattenMonitorDiagnosticSettingLogs(input *[]monitor.LogSettings) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input != nil {
+		for _, v := range *input {
+			output := make(map[string]interface{}, 0)
+
+			if v.Category != nil {
+				output["category"] = *v.Category
+			}
+			if v.Enabled != nil {
+				output["enabled"] = *v.Enabled
+			}
+			output["retention_policy"] = flattenMonitorDiagnosticSettingRetentionPolicy(v.RetentionPolicy)
+
+			results = append(results, output)
+		}
+	}
+
+	return results
+}
+
+func flattenMonitorDiagnosticSettingMetrics(input *[]monitor.MetricSettings) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input != nil {
+		for _, v := range *input {
+			output := make(map[string]interface{}, 0)
+
+			if v.Category != nil {
+				output["category"] = *v.Category
+			}
+			if v.Enabled != nil {
+				output["enabled"] = *v.Enabled
+			}
+			output["retention_policy"] = flattenMonitorDiagnosticSettingRetentionPolicy(v.RetentionPolicy)
+
+			results = append(results, output)
+		}
+	}
+
+	return results
+}
+
+func flattenMonitorDiagnosticSettingRetentionPolicy(input *monitor.RetentionPolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{}, 0)
+	if input.Enabled != nil {
+		output["enabled"] = *input.Enabled
+	}
+	if input.Days != nil {
+		output["days"] = int(*input.Days)
+	}
+
+	return []interface{}{output}
+}
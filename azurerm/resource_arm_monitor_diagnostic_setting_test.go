@@ -0,0 +1,217 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMonitorDiagnosticSetting_storageAccount(t *testing.T) {
+	resourceName := "azurerm_monitor_diagnostic_setting.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	config := testAccAzureRMMonitorDiagnosticSetting_storageAccount(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorDiagnosticSettingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorDiagnosticSettingExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "target_resource_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "storage_account_id"),
+					resource.TestCheckResourceAttr(resourceName, "log.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metric.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorDiagnosticSetting_logAnalyticsWorkspace(t *testing.T) {
+	resourceName := "azurerm_monitor_diagnostic_setting.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	config := testAccAzureRMMonitorDiagnosticSetting_logAnalyticsWorkspace(ri, rs, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorDiagnosticSettingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorDiagnosticSettingExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "log_analytics_workspace_id"),
+					resource.TestCheckResourceAttr(resourceName, "log.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metric.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMonitorDiagnosticSettingExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).monitorDiagnosticSettingsClient
+
+		settingName := rs.Primary.Attributes["name"]
+		targetResourceId := rs.Primary.Attributes["target_resource_id"]
+
+		resp, err := monitorDiagnosticSettingsGet(client, targetResourceId, settingName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Diagnostic Setting %q (Resource %q) does not exist", settingName, targetResourceId)
+			}
+
+			return fmt.Errorf("Bad: Get on monitorDiagnosticSettingsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMonitorDiagnosticSettingDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).monitorDiagnosticSettingsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_monitor_diagnostic_setting" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		targetResourceId := rs.Primary.Attributes["target_resource_id"]
+
+		resp, err := monitorDiagnosticSettingsGet(client, targetResourceId, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Diagnostic Setting %q (Resource %q) still exists", name, targetResourceId)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMonitorDiagnosticSetting_storageAccount(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctest%s%d"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctest%s%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "standard"
+  }
+}
+
+resource "azurerm_monitor_diagnostic_setting" "test" {
+  name               = "acctestds%d"
+  target_resource_id = "${azurerm_key_vault.test.id}"
+  storage_account_id = "${azurerm_storage_account.test.id}"
+
+  log {
+    category = "AuditEvent"
+    enabled  = false
+
+    retention_policy {
+      enabled = false
+    }
+  }
+
+  metric {
+    category = "AllMetrics"
+
+    retention_policy {
+      enabled = true
+      days    = 7
+    }
+  }
+}
+`, rInt, location, rString, rInt%10000, rString, rInt%10000, rInt)
+}
+
+func testAccAzureRMMonitorDiagnosticSetting_logAnalyticsWorkspace(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestlaw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctest%s%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "standard"
+  }
+}
+
+resource "azurerm_monitor_diagnostic_setting" "test" {
+  name                       = "acctestds%d"
+  target_resource_id         = "${azurerm_key_vault.test.id}"
+  log_analytics_workspace_id = "${azurerm_log_analytics_workspace.test.id}"
+
+  log {
+    category = "AuditEvent"
+    enabled  = false
+
+    retention_policy {
+      enabled = false
+    }
+  }
+
+  metric {
+    category = "AllMetrics"
+
+    retention_policy {
+      enabled = false
+    }
+  }
+}
+`, rInt, location, rInt, rString, rInt%10000, rInt)
+}
@@ -0,0 +1,368 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/monitor"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMonitorMetricAlertRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMonitorMetricAlertRuleCreateOrUpdate,
+		Read:   resourceArmMonitorMetricAlertRuleRead,
+		Update: resourceArmMonitorMetricAlertRuleCreateOrUpdate,
+		Delete: resourceArmMonitorMetricAlertRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("alertrules"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"resource_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"metric_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"operator": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(monitor.ConditionOperatorGreaterThan),
+					string(monitor.ConditionOperatorGreaterThanOrEqual),
+					string(monitor.ConditionOperatorLessThan),
+					string(monitor.ConditionOperatorLessThanOrEqual),
+				}, true),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"threshold": {
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+
+			"period": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"aggregation": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(monitor.TimeAggregationOperatorAverage),
+					string(monitor.TimeAggregationOperatorLast),
+					string(monitor.TimeAggregationOperatorMaximum),
+					string(monitor.TimeAggregationOperatorMinimum),
+					string(monitor.TimeAggregationOperatorTotal),
+				}, true),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"email_action": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"send_to_service_owners": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+
+						"custom_emails": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"webhook_action": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_uri": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"properties": {
+							Type:     schema.TypeMap,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmMonitorMetricAlertRuleCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorAlertRulesClient
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	alertRule := expandMonitorMetricAlertRule(d)
+
+	parameters := monitor.AlertRuleResource{
+		Location:  utils.String(location),
+		AlertRule: alertRule,
+		Tags:      expandResourceTags(d, tags, meta),
+	}
+
+	_, err := client.CreateOrUpdate(resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating or updating Metric Alert Rule %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Metric Alert Rule %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Metric Alert Rule %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmMonitorMetricAlertRuleRead(d, meta)
+}
+
+func resourceArmMonitorMetricAlertRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorAlertRulesClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["alertrules"]
+
+	resp, err := client.Get(resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Metric Alert Rule %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Metric Alert Rule %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if alertRule := resp.AlertRule; alertRule != nil {
+		d.Set("description", alertRule.Description)
+		d.Set("enabled", alertRule.IsEnabled)
+
+		if alertRule.Condition != nil {
+			condition, ok := alertRule.Condition.AsThresholdRuleCondition()
+			if !ok {
+				return fmt.Errorf("Metric Alert Rule %q (Resource Group %q) does not use a Threshold Condition", name, resourceGroup)
+			}
+
+			d.Set("operator", string(condition.Operator))
+			d.Set("threshold", condition.Threshold)
+			d.Set("period", condition.WindowSize)
+			d.Set("aggregation", string(condition.TimeAggregation))
+
+			if condition.DataSource != nil {
+				if dataSource, ok := condition.DataSource.AsRuleMetricDataSource(); ok {
+					d.Set("resource_id", dataSource.ResourceURI)
+					d.Set("metric_name", dataSource.MetricName)
+				}
+			}
+		}
+
+		emailActions, webhookActions := flattenMonitorMetricAlertRuleActions(alertRule.Actions)
+		if err := d.Set("email_action", emailActions); err != nil {
+			return fmt.Errorf("Error setting `email_action`: %+v", err)
+		}
+		if err := d.Set("webhook_action", webhookActions); err != nil {
+			return fmt.Errorf("Error setting `webhook_action`: %+v", err)
+		}
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmMonitorMetricAlertRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).monitorAlertRulesClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["alertrules"]
+
+	resp, err := client.Delete(resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Metric Alert Rule %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandMonitorMetricAlertRule(d *schema.ResourceData) *monitor.AlertRule {
+	name := d.Get("name").(string)
+	description := d.Get("description").(string)
+	enabled := d.Get("enabled").(bool)
+	resourceId := d.Get("resource_id").(string)
+	metricName := d.Get("metric_name").(string)
+	operator := d.Get("operator").(string)
+	threshold := d.Get("threshold").(float64)
+	period := d.Get("period").(string)
+	aggregation := d.Get("aggregation").(string)
+
+	dataSource := monitor.RuleMetricDataSource{
+		ResourceURI: utils.String(resourceId),
+		MetricName:  utils.String(metricName),
+	}
+
+	condition := monitor.ThresholdRuleCondition{
+		DataSource:      dataSource,
+		Operator:        monitor.ConditionOperator(operator),
+		Threshold:       utils.Float(threshold),
+		WindowSize:      utils.String(period),
+		TimeAggregation: monitor.TimeAggregationOperator(aggregation),
+	}
+
+	actions := make([]monitor.RuleAction, 0)
+
+	for _, v := range d.Get("email_action").([]interface{}) {
+		val := v.(map[string]interface{})
+
+		customEmails := make([]string, 0)
+		for _, email := range val["custom_emails"].([]interface{}) {
+			customEmails = append(customEmails, email.(string))
+		}
+
+		actions = append(actions, monitor.RuleEmailAction{
+			SendToServiceOwners: utils.Bool(val["send_to_service_owners"].(bool)),
+			CustomEmails:        &customEmails,
+		})
+	}
+
+	for _, v := range d.Get("webhook_action").([]interface{}) {
+		val := v.(map[string]interface{})
+
+		properties := make(map[string]*string, 0)
+		for key, value := range val["properties"].(map[string]interface{}) {
+			properties[key] = utils.String(value.(string))
+		}
+
+		actions = append(actions, monitor.RuleWebhookAction{
+			ServiceURI: utils.String(val["service_uri"].(string)),
+			Properties: &properties,
+		})
+	}
+
+	return &monitor.AlertRule{
+		Name:        utils.String(name),
+		Description: utils.String(description),
+		IsEnabled:   utils.Bool(enabled),
+		Condition:   condition,
+		Actions:     &actions,
+	}
+}
+
+func flattenMonitorMetricAlertRuleActions(input *[]monitor.RuleAction) ([]interface{}, []interface{}) {
+	emailActions := make([]interface{}, 0)
+	webhookActions := make([]interface{}, 0)
+
+	if input == nil {
+		return emailActions, webhookActions
+	}
+
+	for _, action := range *input {
+		if emailAction, ok := action.AsRuleEmailAction(); ok {
+			val := make(map[string]interface{}, 0)
+			if emailAction.SendToServiceOwners != nil {
+				val["send_to_service_owners"] = *emailAction.SendToServiceOwners
+			}
+
+			customEmails := make([]interface{}, 0)
+			if emailAction.CustomEmails != nil {
+				for _, email := range *emailAction.CustomEmails {
+					customEmails = append(customEmails, email)
+				}
+			}
+			val["custom_emails"] = customEmails
+
+			emailActions = append(emailActions, val)
+			continue
+		}
+
+		if webhookAction, ok := action.AsRuleWebhookAction(); ok {
+			val := make(map[string]interface{}, 0)
+			if webhookAction.ServiceURI != nil {
+				val["service_uri"] = *webhookAction.ServiceURI
+			}
+
+			properties := make(map[string]interface{}, 0)
+			if webhookAction.Properties != nil {
+				for key, value := range *webhookAction.Properties {
+					if value != nil {
+						properties[key] = *value
+					}
+				}
+			}
+			val["properties"] = properties
+
+			webhookActions = append(webhookActions, val)
+		}
+	}
+
+	return emailActions, webhookActions
+}
@@ -0,0 +1,264 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMonitorMetricAlertRule_basic(t *testing.T) {
+	resourceName := "azurerm_monitor_metric_alertrule.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMonitorMetricAlertRule_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorMetricAlertRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorMetricAlertRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "metric_name", "cpu_percent"),
+					resource.TestCheckResourceAttr(resourceName, "operator", "GreaterThan"),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "75"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation", "Average"),
+					resource.TestCheckResourceAttr(resourceName, "period", "PT5M"),
+					resource.TestCheckResourceAttr(resourceName, "email_action.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "webhook_action.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorMetricAlertRule_complete(t *testing.T) {
+	resourceName := "azurerm_monitor_metric_alertrule.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMMonitorMetricAlertRule_complete(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorMetricAlertRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorMetricAlertRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "An alert rule to watch the CPU"),
+					resource.TestCheckResourceAttr(resourceName, "email_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "email_action.0.send_to_service_owners", "false"),
+					resource.TestCheckResourceAttr(resourceName, "email_action.0.custom_emails.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "webhook_action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "webhook_action.0.service_uri", "https://example.com/some-url"),
+					resource.TestCheckResourceAttr(resourceName, "webhook_action.0.properties.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMonitorMetricAlertRule_disabledUpdate(t *testing.T) {
+	resourceName := "azurerm_monitor_metric_alertrule.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMMonitorMetricAlertRule_disabled(ri, location)
+	postConfig := testAccAzureRMMonitorMetricAlertRule_basic(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorMetricAlertRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorMetricAlertRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorMetricAlertRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMMonitorMetricAlertRule_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestsqlserver%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+  name                             = "acctestdb%d"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  server_name                      = "${azurerm_sql_server.test.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  edition                          = "Standard"
+  collation                        = "SQL_Latin1_General_CP1_CI_AS"
+  max_size_bytes                   = "1073741824"
+  requested_service_objective_name = "S0"
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMMonitorMetricAlertRule_basic(rInt int, location string) string {
+	template := testAccAzureRMMonitorMetricAlertRule_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_metric_alertrule" "test" {
+  name                = "acctestalertrule-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_id         = "${azurerm_sql_database.test.id}"
+  metric_name         = "cpu_percent"
+  operator            = "GreaterThan"
+  threshold           = 75
+  aggregation         = "Average"
+  period              = "PT5M"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMonitorMetricAlertRule_disabled(rInt int, location string) string {
+	template := testAccAzureRMMonitorMetricAlertRule_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_metric_alertrule" "test" {
+  name                = "acctestalertrule-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  enabled             = false
+  resource_id         = "${azurerm_sql_database.test.id}"
+  metric_name         = "cpu_percent"
+  operator            = "GreaterThan"
+  threshold           = 75
+  aggregation         = "Average"
+  period              = "PT5M"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMMonitorMetricAlertRule_complete(rInt int, location string) string {
+	template := testAccAzureRMMonitorMetricAlertRule_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_metric_alertrule" "test" {
+  name                = "acctestalertrule-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  description         = "An alert rule to watch the CPU"
+  resource_id         = "${azurerm_sql_database.test.id}"
+  metric_name         = "cpu_percent"
+  operator            = "GreaterThanOrEqual"
+  threshold           = 90
+  aggregation         = "Maximum"
+  period              = "PT15M"
+
+  email_action {
+    send_to_service_owners = false
+
+    custom_emails = [
+      "admin@contoso.com",
+      "devops@contoso.com",
+    ]
+  }
+
+  webhook_action {
+    service_uri = "https://example.com/some-url"
+
+    properties = {
+      severity = "incredible"
+    }
+  }
+
+  tags {
+    environment = "Production"
+  }
+}
+`, template, rInt)
+}
+
+func testCheckAzureRMMonitorMetricAlertRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).monitorAlertRulesClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_monitor_metric_alertrule" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := conn.Get(resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Metric Alert Rule still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMMonitorMetricAlertRuleExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		alertRuleName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Metric Alert Rule: %s", alertRuleName)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).monitorAlertRulesClient
+
+		resp, err := conn.Get(resourceGroup, alertRuleName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on monitorAlertRulesClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Metric Alert Rule %q (resource group: %q) does not exist", alertRuleName, resourceGroup)
+		}
+
+		return nil
+	}
+}
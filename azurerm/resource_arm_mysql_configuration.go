@@ -15,7 +15,7 @@ func resourceArmMySQLConfiguration() *schema.Resource {
 		Read:   resourceArmMySQLConfigurationRead,
 		Delete: resourceArmMySQLConfigurationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("servers", "configurations"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -59,7 +59,9 @@ func resourceArmMySQLConfigurationCreate(d *schema.ResourceData, meta interface{
 		},
 	}
 
-	_, error := client.CreateOrUpdate(resGroup, serverName, name, properties, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, error := client.CreateOrUpdate(resGroup, serverName, name, properties, cancel)
 	err := <-error
 	if err != nil {
 		return err
@@ -132,7 +134,9 @@ func resourceArmMySQLConfigurationDelete(d *schema.ResourceData, meta interface{
 		},
 	}
 
-	_, error := client.CreateOrUpdate(resGroup, serverName, name, properties, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	_, error := client.CreateOrUpdate(resGroup, serverName, name, properties, cancel)
 	err = <-error
 	return err
 }
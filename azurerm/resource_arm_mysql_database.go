@@ -15,7 +15,7 @@ func resourceArmMySqlDatabase() *schema.Resource {
 		Read:   resourceArmMySqlDatabaseRead,
 		Delete: resourceArmMySqlDatabaseDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("servers", "databases"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,7 +67,9 @@ func resourceArmMySqlDatabaseCreate(d *schema.ResourceData, meta interface{}) er
 		},
 	}
 
-	_, createErr := client.CreateOrUpdate(resGroup, serverName, name, properties, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.CreateOrUpdate(resGroup, serverName, name, properties, cancel)
 	err := <-createErr
 	if err != nil {
 		return err
@@ -126,8 +128,18 @@ func resourceArmMySqlDatabaseDelete(d *schema.ResourceData, meta interface{}) er
 	serverName := id.Path["servers"]
 	name := id.Path["databases"]
 
-	_, deleteErr := client.Delete(resGroup, serverName, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := client.Delete(resGroup, serverName, name, cancel)
+	resp := <-deleteResp
 	err = <-deleteErr
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
 
-	return err
+		return fmt.Errorf("Error deleting MySQL Database %q: %+v", name, err)
+	}
+
+	return nil
 }
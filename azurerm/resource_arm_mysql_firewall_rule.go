@@ -16,7 +16,7 @@ func resourceArmMySqlFirewallRule() *schema.Resource {
 		Update: resourceArmMySqlFirewallRuleCreateUpdate,
 		Delete: resourceArmMySqlFirewallRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("servers", "firewallRules"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -65,7 +65,9 @@ func resourceArmMySqlFirewallRuleCreateUpdate(d *schema.ResourceData, meta inter
 		},
 	}
 
-	_, createErr := client.CreateOrUpdate(resGroup, serverName, name, properties, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.CreateOrUpdate(resGroup, serverName, name, properties, cancel)
 	err := <-createErr
 	if err != nil {
 		return err
@@ -124,8 +126,18 @@ func resourceArmMySqlFirewallRuleDelete(d *schema.ResourceData, meta interface{}
 	serverName := id.Path["servers"]
 	name := id.Path["firewallRules"]
 
-	_, deleteErr := client.Delete(resGroup, serverName, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := client.Delete(resGroup, serverName, name, cancel)
+	resp := <-deleteResp
 	err = <-deleteErr
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
 
-	return err
+		return fmt.Errorf("Error deleting MySQL Firewall Rule %q: %+v", name, err)
+	}
+
+	return nil
 }
@@ -18,7 +18,7 @@ func resourceArmMySqlServer() *schema.Resource {
 		Update: resourceArmMySqlServerUpdate,
 		Delete: resourceArmMySqlServerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("servers"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -176,10 +176,12 @@ func resourceArmMySqlServerCreate(d *schema.ResourceData, meta interface{}) erro
 			AdministratorLogin:         utils.String(adminLogin),
 			AdministratorLoginPassword: utils.String(adminLoginPassword),
 		},
-		Tags: expandTags(tags),
+		Tags: expandResourceTags(d, tags, meta),
 	}
 
-	_, error := client.CreateOrUpdate(resGroup, name, properties, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, error := client.CreateOrUpdate(resGroup, name, properties, cancel)
 	err := <-error
 	if err != nil {
 		return err
@@ -222,10 +224,12 @@ func resourceArmMySqlServerUpdate(d *schema.ResourceData, meta interface{}) erro
 			Version:                    mysql.ServerVersion(version),
 			AdministratorLoginPassword: utils.String(adminLoginPassword),
 		},
-		Tags: expandTags(tags),
+		Tags: expandResourceTags(d, tags, meta),
 	}
 
-	_, createErr := client.Update(resGroup, name, properties, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.Update(resGroup, name, properties, cancel)
 	err := <-createErr
 	if err != nil {
 		return err
@@ -265,18 +269,22 @@ func resourceArmMySqlServerRead(d *schema.ResourceData, meta interface{}) error
 
 	d.Set("name", resp.Name)
 	d.Set("resource_group_name", resGroup)
-	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
 
 	d.Set("administrator_login", resp.AdministratorLogin)
 	d.Set("version", string(resp.Version))
-	d.Set("storage_mb", int(*resp.StorageMB))
+	if storageMB := resp.StorageMB; storageMB != nil {
+		d.Set("storage_mb", int(*storageMB))
+	}
 	d.Set("ssl_enforcement", string(resp.SslEnforcement))
 
 	if err := d.Set("sku", flattenMySQLServerSku(d, resp.Sku)); err != nil {
 		return err
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	// Computed
 	d.Set("fqdn", resp.FullyQualifiedDomainName)
@@ -294,10 +302,20 @@ func resourceArmMySqlServerDelete(d *schema.ResourceData, meta interface{}) erro
 	resGroup := id.ResourceGroup
 	name := id.Path["servers"]
 
-	_, deleteErr := client.Delete(resGroup, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := client.Delete(resGroup, name, cancel)
+	resp := <-deleteResp
 	err = <-deleteErr
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting MySQL Server %q: %+v", name, err)
+	}
 
-	return err
+	return nil
 }
 
 func expandMySQLServerSku(d *schema.ResourceData, storageMB int) *mysql.Sku {
@@ -317,10 +335,20 @@ func expandMySQLServerSku(d *schema.ResourceData, storageMB int) *mysql.Sku {
 }
 
 func flattenMySQLServerSku(d *schema.ResourceData, resp *mysql.Sku) []interface{} {
+	if resp == nil {
+		return []interface{}{}
+	}
+
 	values := map[string]interface{}{}
 
-	values["name"] = *resp.Name
-	values["capacity"] = int(*resp.Capacity)
+	if name := resp.Name; name != nil {
+		values["name"] = *name
+	}
+
+	if capacity := resp.Capacity; capacity != nil {
+		values["capacity"] = int(*capacity)
+	}
+
 	values["tier"] = string(resp.Tier)
 
 	sku := []interface{}{values}
@@ -16,7 +16,7 @@ func resourceArmNetworkSecurityRule() *schema.Resource {
 		Update: resourceArmNetworkSecurityRuleCreate,
 		Delete: resourceArmNetworkSecurityRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("networkSecurityGroups", "securityRules"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -138,7 +138,9 @@ func resourceArmNetworkSecurityRuleCreate(d *schema.ResourceData, meta interface
 		rule.SecurityRulePropertiesFormat.Description = &description
 	}
 
-	_, createErr := client.CreateOrUpdate(resGroup, nsgName, name, rule, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.CreateOrUpdate(resGroup, nsgName, name, rule, cancel)
 	err := <-createErr
 	if err != nil {
 		return err
@@ -209,7 +211,9 @@ func resourceArmNetworkSecurityRuleDelete(d *schema.ResourceData, meta interface
 	azureRMLockByName(nsgName, networkSecurityGroupResourceName)
 	defer azureRMUnlockByName(nsgName, networkSecurityGroupResourceName)
 
-	_, deleteErr := client.Delete(resGroup, nsgName, sgRuleName, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	_, deleteErr := client.Delete(resGroup, nsgName, sgRuleName, cancel)
 	err = <-deleteErr
 
 	return err
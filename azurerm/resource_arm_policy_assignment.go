@@ -0,0 +1,159 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/resources/policy"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmPolicyAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPolicyAssignmentCreateOrUpdate,
+		Update: resourceArmPolicyAssignmentCreateOrUpdate,
+		Read:   resourceArmPolicyAssignmentRead,
+		Delete: resourceArmPolicyAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"scope": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"policy_definition_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"parameters": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+		},
+	}
+}
+
+func resourceArmPolicyAssignmentCreateOrUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).policyAssignmentsClient
+
+	name := d.Get("name").(string)
+	scope := d.Get("scope").(string)
+	policyDefinitionId := d.Get("policy_definition_id").(string)
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+
+	properties := policy.AssignmentProperties{
+		PolicyDefinitionID: utils.String(policyDefinitionId),
+		DisplayName:        utils.String(displayName),
+		Description:        utils.String(description),
+	}
+
+	if parametersString := d.Get("parameters").(string); parametersString != "" {
+		parameters, err := structure.ExpandJsonFromString(parametersString)
+		if err != nil {
+			return fmt.Errorf("Error expanding `parameters` JSON: %+v", err)
+		}
+		properties.Parameters = &parameters
+	}
+
+	assignment := policy.Assignment{
+		Name:                 utils.String(name),
+		AssignmentProperties: &properties,
+	}
+
+	if _, err := client.Create(scope, name, assignment); err != nil {
+		return fmt.Errorf("Error creating/updating Policy Assignment %q (Scope %q): %+v", name, scope, err)
+	}
+
+	read, err := client.Get(scope, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Policy Assignment %q (Scope %q): %+v", name, scope, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Policy Assignment %q (Scope %q) ID", name, scope)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmPolicyAssignmentRead(d, meta)
+}
+
+func resourceArmPolicyAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).policyAssignmentsClient
+
+	id := d.Id()
+	resp, err := client.GetByID(id)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Policy Assignment %q was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Policy Assignment %q: %+v", id, err)
+	}
+
+	d.Set("name", resp.Name)
+
+	if props := resp.AssignmentProperties; props != nil {
+		d.Set("scope", props.Scope)
+		d.Set("policy_definition_id", props.PolicyDefinitionID)
+		d.Set("display_name", props.DisplayName)
+		d.Set("description", props.Description)
+
+		if parameters := props.Parameters; parameters != nil {
+			parametersStr, err := structure.FlattenJsonToString(*parameters)
+			if err != nil {
+				return fmt.Errorf("Error flattening `parameters` for Policy Assignment %q: %+v", id, err)
+			}
+			d.Set("parameters", parametersStr)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmPolicyAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).policyAssignmentsClient
+
+	id := d.Id()
+	resp, err := client.DeleteByID(id)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Policy Assignment %q: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMPolicyAssignment_basic(t *testing.T) {
+	resourceName := "azurerm_policy_assignment.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMPolicyAssignment_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPolicyAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPolicyAssignmentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "scope", "azurerm_resource_group.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_definition_id", "azurerm_policy_definition.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPolicyAssignment_complete(t *testing.T) {
+	resourceName := "azurerm_policy_assignment.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMPolicyAssignment_complete(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPolicyAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPolicyAssignmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", fmt.Sprintf("Acceptance Test Run %d", ri)),
+					resource.TestCheckResourceAttr(resourceName, "description", "Policy Assignment created via an Acceptance Test"),
+					resource.TestCheckResourceAttrSet(resourceName, "parameters"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMPolicyAssignment_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_policy_definition" "test" {
+  name         = "acctestpol-%d"
+  policy_type  = "Custom"
+  mode         = "All"
+  display_name = "acctestpol-%d"
+
+  policy_rule = <<POLICY_RULE
+{
+  "if": {
+    "not": {
+      "field": "location",
+      "in": "[parameters('allowedLocations')]"
+    }
+  },
+  "then": {
+    "effect": "audit"
+  }
+}
+POLICY_RULE
+
+  parameters = <<PARAMETERS
+{
+  "allowedLocations": {
+    "type": "Array",
+    "metadata": {
+      "description": "The list of allowed locations for resources.",
+      "displayName": "Allowed locations",
+      "strongType": "location"
+    }
+  }
+}
+PARAMETERS
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMPolicyAssignment_basic(rInt int, location string) string {
+	template := testAccAzureRMPolicyAssignment_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_policy_assignment" "test" {
+  name                 = "acctestpa-%d"
+  scope                = "${azurerm_resource_group.test.id}"
+  policy_definition_id = "${azurerm_policy_definition.test.id}"
+
+  parameters = <<PARAMETERS
+{
+  "allowedLocations": {
+    "value": [ "${azurerm_resource_group.test.location}" ]
+  }
+}
+PARAMETERS
+}
+`, template, rInt)
+}
+
+func testAccAzureRMPolicyAssignment_complete(rInt int, location string) string {
+	template := testAccAzureRMPolicyAssignment_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_policy_assignment" "test" {
+  name                 = "acctestpa-%d"
+  scope                = "${azurerm_resource_group.test.id}"
+  policy_definition_id = "${azurerm_policy_definition.test.id}"
+  description          = "Policy Assignment created via an Acceptance Test"
+  display_name         = "Acceptance Test Run %d"
+
+  parameters = <<PARAMETERS
+{
+  "allowedLocations": {
+    "value": [ "${azurerm_resource_group.test.location}" ]
+  }
+}
+PARAMETERS
+}
+`, template, rInt, rInt)
+}
+
+func testCheckAzureRMPolicyAssignmentExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id := rs.Primary.ID
+
+		client := testAccProvider.Meta().(*ArmClient).policyAssignmentsClient
+		resp, err := client.GetByID(id)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Policy Assignment %q does not exist", id)
+			}
+
+			return fmt.Errorf("Bad: GetByID on policyAssignmentsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMPolicyAssignmentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).policyAssignmentsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_policy_assignment" {
+			continue
+		}
+
+		resp, err := client.GetByID(rs.Primary.ID)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Policy Assignment still exists:\n%#v", resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/resources/policy"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmPolicyDefinition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPolicyDefinitionCreateUpdate,
+		Update: resourceArmPolicyDefinitionCreateUpdate,
+		Read:   resourceArmPolicyDefinitionRead,
+		Delete: resourceArmPolicyDefinitionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"policy_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(policy.TypeBuiltIn),
+					string(policy.TypeCustom),
+					string(policy.TypeNotSpecified),
+				}, true),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"mode": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(policy.All),
+					string(policy.Indexed),
+					string(policy.NotSpecified),
+				}, true),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"policy_rule": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			"metadata": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			"parameters": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+		},
+	}
+}
+
+func resourceArmPolicyDefinitionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).policyDefinitionsClient
+
+	name := d.Get("name").(string)
+	policyType := d.Get("policy_type").(string)
+	mode := d.Get("mode").(string)
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+
+	properties := policy.DefinitionProperties{
+		PolicyType:  policy.Type(policyType),
+		Mode:        policy.Mode(mode),
+		DisplayName: utils.String(displayName),
+		Description: utils.String(description),
+	}
+
+	if policyRuleString := d.Get("policy_rule").(string); policyRuleString != "" {
+		policyRule, err := structure.ExpandJsonFromString(policyRuleString)
+		if err != nil {
+			return fmt.Errorf("Error expanding `policy_rule` JSON: %+v", err)
+		}
+		properties.PolicyRule = &policyRule
+	}
+
+	if metaDataString := d.Get("metadata").(string); metaDataString != "" {
+		metaData, err := structure.ExpandJsonFromString(metaDataString)
+		if err != nil {
+			return fmt.Errorf("Error expanding `metadata` JSON: %+v", err)
+		}
+		properties.Metadata = &metaData
+	}
+
+	if parametersString := d.Get("parameters").(string); parametersString != "" {
+		parameters, err := structure.ExpandJsonFromString(parametersString)
+		if err != nil {
+			return fmt.Errorf("Error expanding `parameters` JSON: %+v", err)
+		}
+		properties.Parameters = &parameters
+	}
+
+	definition := policy.Definition{
+		Name:                 utils.String(name),
+		DefinitionProperties: &properties,
+	}
+
+	if _, err := client.CreateOrUpdate(name, definition); err != nil {
+		return fmt.Errorf("Error creating/updating Policy Definition %q: %+v", name, err)
+	}
+
+	resp, err := client.Get(name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Policy Definition %q: %+v", name, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Policy Definition %q ID", name)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmPolicyDefinitionRead(d, meta)
+}
+
+func resourceArmPolicyDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).policyDefinitionsClient
+
+	name, err := parsePolicyDefinitionNameFromId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Policy Definition %q was not found - removing from state", name)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Policy Definition %q: %+v", name, err)
+	}
+
+	d.Set("name", resp.Name)
+
+	if props := resp.DefinitionProperties; props != nil {
+		d.Set("policy_type", string(props.PolicyType))
+		d.Set("mode", string(props.Mode))
+		d.Set("display_name", props.DisplayName)
+		d.Set("description", props.Description)
+
+		if policyRule := props.PolicyRule; policyRule != nil {
+			policyRuleStr, err := structure.FlattenJsonToString(*policyRule)
+			if err != nil {
+				return fmt.Errorf("Error flattening `policy_rule` for Policy Definition %q: %+v", name, err)
+			}
+			d.Set("policy_rule", policyRuleStr)
+		}
+
+		if metadata := props.Metadata; metadata != nil {
+			metadataStr, err := structure.FlattenJsonToString(*metadata)
+			if err != nil {
+				return fmt.Errorf("Error flattening `metadata` for Policy Definition %q: %+v", name, err)
+			}
+			d.Set("metadata", metadataStr)
+		}
+
+		if parameters := props.Parameters; parameters != nil {
+			parametersStr, err := structure.FlattenJsonToString(*parameters)
+			if err != nil {
+				return fmt.Errorf("Error flattening `parameters` for Policy Definition %q: %+v", name, err)
+			}
+			d.Set("parameters", parametersStr)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmPolicyDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).policyDefinitionsClient
+
+	name, err := parsePolicyDefinitionNameFromId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Delete(name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Policy Definition %q: %+v", name, err)
+	}
+
+	return nil
+}
+
+// parsePolicyDefinitionNameFromId returns the name of the Policy Definition, which is the last segment of
+// the ID - since the casing of the `policyDefinitions` segment isn't consistent across the API
+func parsePolicyDefinitionNameFromId(id string) (string, error) {
+	components := strings.Split(strings.TrimSuffix(id, "/"), "/")
+	if len(components) < 2 || !strings.EqualFold(components[len(components)-2], "policyDefinitions") {
+		return "", fmt.Errorf("Error parsing Policy Definition ID %q: expected it to end in `/policyDefinitions/{name}`", id)
+	}
+
+	return components[len(components)-1], nil
+}
@@ -0,0 +1,201 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestParsePolicyDefinitionNameFromId(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyDefinitions/def1",
+			Expected: "def1",
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policydefinitions/def1/",
+			Expected: "def1",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyAssignments/assignment1",
+			Error: true,
+		},
+		{
+			Input: "def1",
+			Error: true,
+		},
+	}
+
+	for _, tc := range cases {
+		name, err := parsePolicyDefinitionNameFromId(tc.Input)
+		if tc.Error {
+			if err == nil {
+				t.Fatalf("Expected an error parsing %q but didn't get one", tc.Input)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error parsing %q but got: %+v", tc.Input, err)
+		}
+
+		if name != tc.Expected {
+			t.Fatalf("Expected %q to be parsed as %q but got %q", tc.Input, tc.Expected, name)
+		}
+	}
+}
+
+func TestAccAzureRMPolicyDefinition_basic(t *testing.T) {
+	resourceName := "azurerm_policy_definition.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMPolicyDefinition_basic(ri)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPolicyDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPolicyDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "Custom"),
+					resource.TestCheckResourceAttr(resourceName, "mode", "All"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_rule"),
+					resource.TestCheckResourceAttrSet(resourceName, "parameters"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPolicyDefinition_update(t *testing.T) {
+	resourceName := "azurerm_policy_definition.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPolicyDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPolicyDefinition_basic(ri),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPolicyDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", fmt.Sprintf("acctestpol-%d", ri)),
+				),
+			},
+			{
+				Config: testAccAzureRMPolicyDefinition_updated(ri),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPolicyDefinitionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "display_name", fmt.Sprintf("acctestpol-updated-%d", ri)),
+					resource.TestCheckResourceAttr(resourceName, "description", "Only allow resources in the specified locations"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMPolicyDefinition_basic(rInt int) string {
+	return testAccAzureRMPolicyDefinition_template(rInt, fmt.Sprintf("acctestpol-%d", rInt), "")
+}
+
+func testAccAzureRMPolicyDefinition_updated(rInt int) string {
+	return testAccAzureRMPolicyDefinition_template(rInt, fmt.Sprintf("acctestpol-updated-%d", rInt), "Only allow resources in the specified locations")
+}
+
+func testAccAzureRMPolicyDefinition_template(rInt int, displayName string, description string) string {
+	return fmt.Sprintf(`
+resource "azurerm_policy_definition" "test" {
+  name         = "acctestpol-%d"
+  policy_type  = "Custom"
+  mode         = "All"
+  display_name = "%s"
+  description  = "%s"
+
+  policy_rule = <<POLICY_RULE
+{
+  "if": {
+    "not": {
+      "field": "location",
+      "in": "[parameters('allowedLocations')]"
+    }
+  },
+  "then": {
+    "effect": "audit"
+  }
+}
+POLICY_RULE
+
+  parameters = <<PARAMETERS
+{
+  "allowedLocations": {
+    "type": "Array",
+    "metadata": {
+      "description": "The list of allowed locations for resources.",
+      "displayName": "Allowed locations",
+      "strongType": "location"
+    }
+  }
+}
+PARAMETERS
+}
+`, rInt, displayName, description)
+}
+
+func testCheckAzureRMPolicyDefinitionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		policyName := rs.Primary.Attributes["name"]
+
+		client := testAccProvider.Meta().(*ArmClient).policyDefinitionsClient
+		resp, err := client.Get(policyName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Policy Definition %q does not exist", policyName)
+			}
+
+			return fmt.Errorf("Bad: Get on policyDefinitionsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMPolicyDefinitionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).policyDefinitionsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_policy_definition" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+
+		resp, err := client.Get(name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Policy Definition still exists:\n%#v", resp)
+	}
+
+	return nil
+}
@@ -15,7 +15,7 @@ func resourceArmPostgreSQLConfiguration() *schema.Resource {
 		Read:   resourceArmPostgreSQLConfigurationRead,
 		Delete: resourceArmPostgreSQLConfigurationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("servers", "configurations"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -59,7 +59,9 @@ func resourceArmPostgreSQLConfigurationCreateUpdate(d *schema.ResourceData, meta
 		},
 	}
 
-	_, error := client.CreateOrUpdate(resGroup, serverName, name, properties, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, error := client.CreateOrUpdate(resGroup, serverName, name, properties, cancel)
 	err := <-error
 	if err != nil {
 		return err
@@ -132,7 +134,9 @@ func resourceArmPostgreSQLConfigurationDelete(d *schema.ResourceData, meta inter
 		},
 	}
 
-	_, error := client.CreateOrUpdate(resGroup, serverName, name, properties, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	_, error := client.CreateOrUpdate(resGroup, serverName, name, properties, cancel)
 	err = <-error
 	return err
 }
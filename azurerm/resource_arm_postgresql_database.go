@@ -15,7 +15,7 @@ func resourceArmPostgreSQLDatabase() *schema.Resource {
 		Read:   resourceArmPostgreSQLDatabaseRead,
 		Delete: resourceArmPostgreSQLDatabaseDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("servers", "databases"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -67,7 +67,9 @@ func resourceArmPostgreSQLDatabaseCreate(d *schema.ResourceData, meta interface{
 		},
 	}
 
-	_, error := client.CreateOrUpdate(resGroup, serverName, name, properties, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, error := client.CreateOrUpdate(resGroup, serverName, name, properties, cancel)
 	err := <-error
 	if err != nil {
 		return err
@@ -128,8 +130,18 @@ func resourceArmPostgreSQLDatabaseDelete(d *schema.ResourceData, meta interface{
 	serverName := id.Path["servers"]
 	name := id.Path["databases"]
 
-	_, error := client.Delete(resGroup, serverName, name, make(chan struct{}))
-	err = <-error
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := client.Delete(resGroup, serverName, name, cancel)
+	resp := <-deleteResp
+	err = <-deleteErr
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
 
-	return err
+		return fmt.Errorf("Error deleting PostgreSQL Database %q: %+v", name, err)
+	}
+
+	return nil
 }
@@ -15,7 +15,7 @@ func resourceArmPostgreSQLFirewallRule() *schema.Resource {
 		Read:   resourceArmPostgreSQLFirewallRuleRead,
 		Delete: resourceArmPostgreSQLFirewallRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("servers", "firewallRules"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -66,7 +66,9 @@ func resourceArmPostgreSQLFirewallRuleCreate(d *schema.ResourceData, meta interf
 		},
 	}
 
-	_, error := client.CreateOrUpdate(resGroup, serverName, name, properties, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, error := client.CreateOrUpdate(resGroup, serverName, name, properties, cancel)
 	err := <-error
 	if err != nil {
 		return err
@@ -127,8 +129,18 @@ func resourceArmPostgreSQLFirewallRuleDelete(d *schema.ResourceData, meta interf
 	serverName := id.Path["servers"]
 	name := id.Path["firewallRules"]
 
-	_, error := client.Delete(resGroup, serverName, name, make(chan struct{}))
-	err = <-error
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := client.Delete(resGroup, serverName, name, cancel)
+	resp := <-deleteResp
+	err = <-deleteErr
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
 
-	return err
+		return fmt.Errorf("Error deleting PostgreSQL Firewall Rule %q: %+v", name, err)
+	}
+
+	return nil
 }
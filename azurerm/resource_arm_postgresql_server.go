@@ -18,7 +18,7 @@ func resourceArmPostgreSQLServer() *schema.Resource {
 		Update: resourceArmPostgreSQLServerUpdate,
 		Delete: resourceArmPostgreSQLServerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("servers"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -177,10 +177,12 @@ func resourceArmPostgreSQLServerCreate(d *schema.ResourceData, meta interface{})
 			AdministratorLoginPassword: utils.String(adminLoginPassword),
 			CreateMode:                 postgresql.CreateModeDefault,
 		},
-		Tags: expandTags(tags),
+		Tags: expandResourceTags(d, tags, meta),
 	}
 
-	_, error := client.Create(resGroup, name, properties, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, error := client.Create(resGroup, name, properties, cancel)
 	err := <-error
 	if err != nil {
 		return err
@@ -223,10 +225,12 @@ func resourceArmPostgreSQLServerUpdate(d *schema.ResourceData, meta interface{})
 			Version:                    postgresql.ServerVersion(version),
 			AdministratorLoginPassword: utils.String(adminLoginPassword),
 		},
-		Tags: expandTags(tags),
+		Tags: expandResourceTags(d, tags, meta),
 	}
 
-	_, error := client.Update(resGroup, name, properties, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, error := client.Update(resGroup, name, properties, cancel)
 	err := <-error
 	if err != nil {
 		return err
@@ -268,15 +272,19 @@ func resourceArmPostgreSQLServerRead(d *schema.ResourceData, meta interface{}) e
 
 	d.Set("name", resp.Name)
 	d.Set("resource_group_name", resGroup)
-	d.Set("location", azureRMNormalizeLocation(*resp.Location))
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
 
 	d.Set("administrator_login", resp.AdministratorLogin)
 	d.Set("version", string(resp.Version))
-	d.Set("storage_mb", int(*resp.StorageMB))
+	if storageMB := resp.StorageMB; storageMB != nil {
+		d.Set("storage_mb", int(*storageMB))
+	}
 	d.Set("ssl_enforcement", string(resp.SslEnforcement))
 	d.Set("sku", flattenPostgreSQLServerSku(resp.Sku))
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	// Computed
 	d.Set("fqdn", resp.FullyQualifiedDomainName)
@@ -294,10 +302,20 @@ func resourceArmPostgreSQLServerDelete(d *schema.ResourceData, meta interface{})
 	resGroup := id.ResourceGroup
 	name := id.Path["servers"]
 
-	_, deleteErr := client.Delete(resGroup, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := client.Delete(resGroup, name, cancel)
+	resp := <-deleteResp
 	err = <-deleteErr
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting PostgreSQL Server %q: %+v", name, err)
+	}
 
-	return err
+	return nil
 }
 
 func expandAzureRmPostgreSQLServerSku(d *schema.ResourceData, storageMB int) *postgresql.Sku {
@@ -317,10 +335,20 @@ func expandAzureRmPostgreSQLServerSku(d *schema.ResourceData, storageMB int) *po
 }
 
 func flattenPostgreSQLServerSku(resp *postgresql.Sku) []interface{} {
+	if resp == nil {
+		return []interface{}{}
+	}
+
 	values := map[string]interface{}{}
 
-	values["name"] = *resp.Name
-	values["capacity"] = int(*resp.Capacity)
+	if name := resp.Name; name != nil {
+		values["name"] = *name
+	}
+
+	if capacity := resp.Capacity; capacity != nil {
+		values["capacity"] = int(*capacity)
+	}
+
 	values["tier"] = string(resp.Tier)
 
 	sku := []interface{}{values}
@@ -18,17 +18,7 @@ func resourceArmPublicIp() *schema.Resource {
 		Update: resourceArmPublicIpCreate,
 		Delete: resourceArmPublicIpDelete,
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-				id, err := parseAzureResourceID(d.Id())
-				if err != nil {
-					return nil, err
-				}
-				name := id.Path["publicIPAddresses"]
-				if name == "" {
-					return nil, fmt.Errorf("Error parsing supplied resource id. Please check it and rerun:\n %s", d.Id())
-				}
-				return []*schema.ResourceData{d}, nil
-			},
+			State: importAzureResourceID("publicIPAddresses"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -133,10 +123,12 @@ func resourceArmPublicIpCreate(d *schema.ResourceData, meta interface{}) error {
 		Name:                            &name,
 		Location:                        &location,
 		PublicIPAddressPropertiesFormat: &properties,
-		Tags: expandTags(tags),
+		Tags: expandResourceTags(d, tags, meta),
 	}
 
-	_, error := publicIPClient.CreateOrUpdate(resGroup, name, publicIp, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, error := publicIPClient.CreateOrUpdate(resGroup, name, publicIp, cancel)
 	err := <-error
 	if err != nil {
 		return err
@@ -187,7 +179,7 @@ func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("ip_address", resp.PublicIPAddressPropertiesFormat.IPAddress)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -202,7 +194,9 @@ func resourceArmPublicIpDelete(d *schema.ResourceData, meta interface{}) error {
 	resGroup := id.ResourceGroup
 	name := id.Path["publicIPAddresses"]
 
-	_, error := publicIPClient.Delete(resGroup, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	_, error := publicIPClient.Delete(resGroup, name, cancel)
 	err = <-error
 
 	return err
@@ -0,0 +1,187 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/arm/recoveryservices"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmRecoveryServicesVault() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmRecoveryServicesVaultCreateUpdate,
+		Read:   resourceArmRecoveryServicesVaultRead,
+		Update: resourceArmRecoveryServicesVaultCreateUpdate,
+		Delete: resourceArmRecoveryServicesVaultDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("vaults"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRecoveryServicesVaultName,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"sku": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(recoveryservices.RS0),
+					string(recoveryservices.Standard),
+				}, true),
+			},
+
+			"storage_mode_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(recoveryservices.StorageModelTypeGeoRedundant),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(recoveryservices.StorageModelTypeGeoRedundant),
+					string(recoveryservices.StorageModelTypeLocallyRedundant),
+				}, false),
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmRecoveryServicesVaultCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesVaultsClient
+	storageConfigsClient := meta.(*ArmClient).recoveryServicesStorageConfigsClient
+
+	name := d.Get("name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	resourceGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	log.Printf("[DEBUG] Creating/updating Recovery Services Vault %q (Resource Group %q)", name, resourceGroup)
+
+	vault := recoveryservices.Vault{
+		Location: &location,
+		Tags:     expandResourceTags(d, tags, meta),
+		Sku: &recoveryservices.Sku{
+			Name: recoveryservices.SkuName(d.Get("sku").(string)),
+		},
+		Properties: &recoveryservices.VaultProperties{},
+	}
+
+	if _, err := client.CreateOrUpdate(resourceGroup, name, vault); err != nil {
+		return fmt.Errorf("Error creating/updating Recovery Services Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	// the Storage Redundancy can only be changed until Items have been registered with the Vault
+	if d.IsNewResource() || d.HasChange("storage_mode_type") {
+		storageConfig := recoveryservices.BackupStorageConfig{
+			BackupStorageConfigProperties: &recoveryservices.BackupStorageConfigProperties{
+				StorageModelType: recoveryservices.StorageModelType(d.Get("storage_mode_type").(string)),
+			},
+		}
+
+		if _, err := storageConfigsClient.Update(resourceGroup, name, storageConfig); err != nil {
+			return fmt.Errorf("Error updating the Storage Configuration for Recovery Services Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	read, err := client.Get(resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Recovery Services Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Recovery Services Vault %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmRecoveryServicesVaultRead(d, meta)
+}
+
+func resourceArmRecoveryServicesVaultRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesVaultsClient
+	storageConfigsClient := meta.(*ArmClient).recoveryServicesStorageConfigsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["vaults"]
+
+	resp, err := client.Get(resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Recovery Services Vault %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Recovery Services Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if sku := resp.Sku; sku != nil {
+		d.Set("sku", string(sku.Name))
+	}
+
+	storageConfig, err := storageConfigsClient.Get(resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Storage Configuration for Recovery Services Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if props := storageConfig.BackupStorageConfigProperties; props != nil {
+		d.Set("storage_mode_type", string(props.StorageModelType))
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmRecoveryServicesVaultDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServicesVaultsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["vaults"]
+
+	resp, err := client.Delete(resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Recovery Services Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func validateRecoveryServicesVaultName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[a-zA-Z][-a-zA-Z0-9]{1,49}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must start with a letter, may only contain letters, numbers and hyphens and must be between 2-50 chars: %q", k, value))
+	}
+
+	return
+}
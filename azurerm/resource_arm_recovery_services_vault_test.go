@@ -0,0 +1,183 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestValidateRecoveryServicesVaultName(t *testing.T) {
+	validNames := []string{
+		"ab",
+		"vault1",
+		"recovery-services-vault",
+	}
+	for _, v := range validNames {
+		_, errors := validateRecoveryServicesVaultName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Recovery Services Vault Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"a",
+		"1vault",
+		"-vault",
+		"vault_1",
+		"abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxy",
+	}
+	for _, v := range invalidNames {
+		_, errors := validateRecoveryServicesVaultName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Recovery Services Vault Name", v)
+		}
+	}
+}
+
+func TestAccAzureRMRecoveryServicesVault_basic(t *testing.T) {
+	resourceName := "azurerm_recovery_services_vault.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMRecoveryServicesVault_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRecoveryServicesVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku", "Standard"),
+					resource.TestCheckResourceAttr(resourceName, "storage_mode_type", "GeoRedundant"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRecoveryServicesVault_update(t *testing.T) {
+	resourceName := "azurerm_recovery_services_vault.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRecoveryServicesVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRecoveryServicesVault_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_mode_type", "GeoRedundant"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMRecoveryServicesVault_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRecoveryServicesVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_mode_type", "LocallyRedundant"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.env", "test"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMRecoveryServicesVaultExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		vaultName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Recovery Services Vault: %q", vaultName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).recoveryServicesVaultsClient
+		resp, err := client.Get(resourceGroup, vaultName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Recovery Services Vault %q (Resource Group %q) does not exist", vaultName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on recoveryServicesVaultsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMRecoveryServicesVaultDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).recoveryServicesVaultsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_recovery_services_vault" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Recovery Services Vault still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMRecoveryServicesVault_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-Vault-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMRecoveryServicesVault_complete(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-Vault-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+  storage_mode_type   = "LocallyRedundant"
+
+  tags {
+    env = "test"
+  }
+}
+`, rInt, location, rInt)
+}
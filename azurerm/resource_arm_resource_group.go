@@ -17,7 +17,7 @@ func resourceArmResourceGroup() *schema.Resource {
 		Exists: resourceArmResourceGroupExists,
 		Delete: resourceArmResourceGroupDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID(),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -38,7 +38,7 @@ func resourceArmResourceGroupCreateUpdate(d *schema.ResourceData, meta interface
 	tags := d.Get("tags").(map[string]interface{})
 	parameters := resources.Group{
 		Location: utils.String(location),
-		Tags:     expandTags(tags),
+		Tags:     expandResourceTags(d, tags, meta),
 	}
 	_, err := client.CreateOrUpdate(name, parameters)
 	if err != nil {
@@ -78,7 +78,7 @@ func resourceArmResourceGroupRead(d *schema.ResourceData, meta interface{}) erro
 
 	d.Set("name", resp.Name)
 	d.Set("location", azureRMNormalizeLocation(*resp.Location))
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -115,7 +115,9 @@ func resourceArmResourceGroupDelete(d *schema.ResourceData, meta interface{}) er
 
 	name := id.ResourceGroup
 
-	deleteResp, deleteErr := client.Delete(name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := client.Delete(name, cancel)
 	resp := <-deleteResp
 	err = <-deleteErr
 	if err != nil {
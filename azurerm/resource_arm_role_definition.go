@@ -2,8 +2,8 @@ package azurerm
 
 import (
 	"fmt"
-
 	"log"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/arm/authorization"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -33,9 +33,10 @@ func resourceArmRoleDefinition() *schema.Resource {
 			},
 
 			"scope": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 			},
 
 			"description": {
@@ -129,6 +130,14 @@ func resourceArmRoleDefinitionRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error loading Role Definition %q: %+v", d.Id(), err)
 	}
 
+	scope, roleDefinitionId, err := parseRoleDefinitionId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("role_definition_id", roleDefinitionId)
+	d.Set("scope", scope)
+
 	if props := resp.Properties; props != nil {
 		d.Set("name", props.RoleName)
 		d.Set("description", props.Description)
@@ -150,8 +159,10 @@ func resourceArmRoleDefinitionRead(d *schema.ResourceData, meta interface{}) err
 func resourceArmRoleDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).roleDefinitionsClient
 
-	roleDefinitionId := d.Get("role_definition_id").(string)
-	scope := d.Get("scope").(string)
+	scope, roleDefinitionId, err := parseRoleDefinitionId(d.Id())
+	if err != nil {
+		return err
+	}
 
 	resp, err := client.Delete(scope, roleDefinitionId)
 	if err != nil {
@@ -206,6 +217,9 @@ func expandRoleDefinitionAssignableScopes(d *schema.ResourceData) []string {
 
 func flattenRoleDefinitionPermissions(input *[]authorization.Permission) []interface{} {
 	permissions := make([]interface{}, 0)
+	if input == nil {
+		return permissions
+	}
 
 	for _, permission := range *input {
 		output := make(map[string]interface{}, 0)
@@ -234,6 +248,9 @@ func flattenRoleDefinitionPermissions(input *[]authorization.Permission) []inter
 
 func flattenRoleDefinitionAssignableScopes(input *[]string) []interface{} {
 	scopes := make([]interface{}, 0)
+	if input == nil {
+		return scopes
+	}
 
 	for _, scope := range *input {
 		scopes = append(scopes, scope)
@@ -241,3 +258,20 @@ func flattenRoleDefinitionAssignableScopes(input *[]string) []interface{} {
 
 	return scopes
 }
+
+// parseRoleDefinitionId splits a Role Definition ID into the Scope it was created at and the Role Definition ID (GUID)
+func parseRoleDefinitionId(input string) (string, string, error) {
+	segment := "/providers/Microsoft.Authorization/roleDefinitions/"
+	index := strings.LastIndex(strings.ToLower(input), strings.ToLower(segment))
+	if index == -1 {
+		return "", "", fmt.Errorf("Error parsing Role Definition ID %q: expected it to contain %q", input, segment)
+	}
+
+	scope := input[:index]
+	roleDefinitionId := strings.TrimSuffix(input[index+len(segment):], "/")
+	if scope == "" || roleDefinitionId == "" || strings.Contains(roleDefinitionId, "/") {
+		return "", "", fmt.Errorf("Error parsing Role Definition ID %q: expected it to be in the format `{scope}%s{id}`", input, segment)
+	}
+
+	return scope, roleDefinitionId, nil
+}
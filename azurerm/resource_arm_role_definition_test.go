@@ -11,6 +11,56 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+func TestParseRoleDefinitionId(t *testing.T) {
+	cases := []struct {
+		Input            string
+		Scope            string
+		RoleDefinitionId string
+		Error            bool
+	}{
+		{
+			Input:            "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/11111111-1111-1111-1111-111111111111",
+			Scope:            "/subscriptions/00000000-0000-0000-0000-000000000000",
+			RoleDefinitionId: "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			Input:            "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/microsoft.authorization/roledefinitions/11111111-1111-1111-1111-111111111111",
+			Scope:            "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			RoleDefinitionId: "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			Input: "/providers/Microsoft.Authorization/roleDefinitions/11111111-1111-1111-1111-111111111111",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleAssignments/11111111-1111-1111-1111-111111111111",
+			Error: true,
+		},
+	}
+
+	for _, tc := range cases {
+		scope, roleDefinitionId, err := parseRoleDefinitionId(tc.Input)
+		if tc.Error {
+			if err == nil {
+				t.Fatalf("Expected an error parsing %q but didn't get one", tc.Input)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error parsing %q but got: %+v", tc.Input, err)
+		}
+
+		if scope != tc.Scope {
+			t.Fatalf("Expected the Scope for %q to be %q but got %q", tc.Input, tc.Scope, scope)
+		}
+
+		if roleDefinitionId != tc.RoleDefinitionId {
+			t.Fatalf("Expected the Role Definition ID for %q to be %q but got %q", tc.Input, tc.RoleDefinitionId, roleDefinitionId)
+		}
+	}
+}
+
 func TestAccAzureRMRoleDefinition_basic(t *testing.T) {
 	ri := acctest.RandInt()
 	config := testAccAzureRMRoleDefinition_basic(uuid.New().String(), ri)
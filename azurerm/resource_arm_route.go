@@ -16,7 +16,7 @@ func resourceArmRoute() *schema.Resource {
 		Update: resourceArmRouteCreateUpdate,
 		Delete: resourceArmRouteDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("routeTables", "routes"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -89,7 +89,9 @@ func resourceArmRouteCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 		RoutePropertiesFormat: &properties,
 	}
 
-	_, createErr := client.CreateOrUpdate(resGroup, rtName, name, route, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.CreateOrUpdate(resGroup, rtName, name, route, cancel)
 	err := <-createErr
 	if err != nil {
 		return err
@@ -157,7 +159,9 @@ func resourceArmRouteDelete(d *schema.ResourceData, meta interface{}) error {
 	azureRMLockByName(rtName, routeTableResourceName)
 	defer azureRMUnlockByName(rtName, routeTableResourceName)
 
-	_, deleteErr := client.Delete(resGroup, rtName, routeName, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	_, deleteErr := client.Delete(resGroup, rtName, routeName, cancel)
 	err = <-deleteErr
 
 	return err
@@ -19,7 +19,7 @@ func resourceArmRouteTable() *schema.Resource {
 		Update: resourceArmRouteTableCreate,
 		Delete: resourceArmRouteTableDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("routeTables"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -103,10 +103,12 @@ func resourceArmRouteTableCreate(d *schema.ResourceData, meta interface{}) error
 		RouteTablePropertiesFormat: &network.RouteTablePropertiesFormat{
 			Routes: &routes,
 		},
-		Tags: expandTags(tags),
+		Tags: expandResourceTags(d, tags, meta),
 	}
 
-	_, createErr := client.CreateOrUpdate(resGroup, name, routeSet, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.CreateOrUpdate(resGroup, name, routeSet, cancel)
 	err = <-createErr
 	if err != nil {
 		return err
@@ -158,7 +160,7 @@ func resourceArmRouteTableRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -173,7 +175,9 @@ func resourceArmRouteTableDelete(d *schema.ResourceData, meta interface{}) error
 	resGroup := id.ResourceGroup
 	name := id.Path["routeTables"]
 
-	deleteResp, deleteErr := routeTablesClient.Delete(resGroup, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := routeTablesClient.Delete(resGroup, name, cancel)
 	resp := <-deleteResp
 	err = <-deleteErr
 
@@ -16,7 +16,7 @@ func resourceArmSearchService() *schema.Resource {
 		Read:   resourceArmSearchServiceRead,
 		Delete: resourceArmSearchServiceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("searchServices"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -78,7 +78,7 @@ func resourceArmSearchServiceCreateUpdate(d *schema.ResourceData, meta interface
 			Name: search.SkuName(skuName),
 		},
 		ServiceProperties: &search.ServiceProperties{},
-		Tags:              expandTags(tags),
+		Tags:              expandResourceTags(d, tags, meta),
 	}
 
 	if v, ok := d.GetOk("replica_count"); ok {
@@ -91,7 +91,9 @@ func resourceArmSearchServiceCreateUpdate(d *schema.ResourceData, meta interface
 		properties.ServiceProperties.PartitionCount = utils.Int32(partition_count)
 	}
 
-	_, createErr := client.CreateOrUpdate(resourceGroupName, name, properties, nil, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.CreateOrUpdate(resourceGroupName, name, properties, nil, cancel)
 	err := <-createErr
 	if err != nil {
 		return err
@@ -146,7 +148,7 @@ func resourceArmSearchServiceRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -23,7 +23,7 @@ func resourceArmServiceBusNamespace() *schema.Resource {
 		Update: resourceArmServiceBusNamespaceCreate,
 		Delete: resourceArmServiceBusNamespaceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("namespaces"),
 		},
 		MigrateState:  resourceAzureRMServiceBusNamespaceMigrateState,
 		SchemaVersion: 1,
@@ -100,7 +100,7 @@ func resourceArmServiceBusNamespaceCreate(d *schema.ResourceData, meta interface
 			Name: servicebus.SkuName(sku),
 			Tier: servicebus.SkuTier(sku),
 		},
-		Tags: expandTags(tags),
+		Tags: expandResourceTags(d, tags, meta),
 	}
 
 	capacity := d.Get("capacity").(int)
@@ -114,7 +114,9 @@ func resourceArmServiceBusNamespaceCreate(d *schema.ResourceData, meta interface
 		parameters.Sku.Capacity = utils.Int32(int32(capacity))
 	}
 
-	_, error := namespaceClient.CreateOrUpdate(resGroup, name, parameters, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, error := namespaceClient.CreateOrUpdate(resGroup, name, parameters, cancel)
 	err := <-error
 	if err != nil {
 		return err
@@ -169,7 +171,7 @@ func resourceArmServiceBusNamespaceRead(d *schema.ResourceData, meta interface{}
 		d.Set("default_secondary_key", keys.SecondaryKey)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -184,7 +186,9 @@ func resourceArmServiceBusNamespaceDelete(d *schema.ResourceData, meta interface
 	resGroup := id.ResourceGroup
 	name := id.Path["namespaces"]
 
-	deleteResp, error := namespaceClient.Delete(resGroup, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, error := namespaceClient.Delete(resGroup, name, cancel)
 	resp := <-deleteResp
 	err = <-error
 
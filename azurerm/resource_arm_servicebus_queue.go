@@ -16,7 +16,7 @@ func resourceArmServiceBusQueue() *schema.Resource {
 		Update: resourceArmServiceBusQueueCreateUpdate,
 		Delete: resourceArmServiceBusQueueDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("namespaces", "queues"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -16,7 +16,7 @@ func resourceArmServiceBusSubscription() *schema.Resource {
 		Update: resourceArmServiceBusSubscriptionCreate,
 		Delete: resourceArmServiceBusSubscriptionDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("namespaces", "topics", "subscriptions"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -18,7 +18,7 @@ func resourceArmSnapshot() *schema.Resource {
 		Update: resourceArmSnapshotCreateUpdate,
 		Delete: resourceArmSnapshotDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("snapshots"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -90,7 +90,7 @@ func resourceArmSnapshotCreateUpdate(d *schema.ResourceData, meta interface{}) e
 				CreateOption: disk.CreateOption(createOption),
 			},
 		},
-		Tags: expandTags(tags),
+		Tags: expandResourceTags(d, tags, meta),
 	}
 
 	if v, ok := d.GetOk("source_uri"); ok {
@@ -116,7 +116,9 @@ func resourceArmSnapshotCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		properties.EncryptionSettings = expandManagedDiskEncryptionSettings(settings)
 	}
 
-	_, createErr := client.CreateOrUpdate(resourceGroup, name, properties, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.CreateOrUpdate(resourceGroup, name, properties, cancel)
 	err := <-createErr
 	if err != nil {
 		return err
@@ -185,7 +187,7 @@ func resourceArmSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -201,7 +203,9 @@ func resourceArmSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
 	resourceGroup := id.ResourceGroup
 	name := id.Path["snapshots"]
 
-	deleteResp, deleteErr := client.Delete(resourceGroup, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := client.Delete(resourceGroup, name, cancel)
 	resp := <-deleteResp
 	err = <-deleteErr
 	if err != nil {
@@ -0,0 +1,163 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/sql"
+	"github.com/hashicorp/terraform/helper/schema"
+	uuid "github.com/satori/go.uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// a SQL Server only supports a single Active Directory Administrator, which is always named `activeDirectory`
+const sqlActiveDirectoryAdministratorName = "activeDirectory"
+
+func resourceArmSqlActiveDirectoryAdministrator() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSqlActiveDirectoryAdministratorCreateUpdate,
+		Read:   resourceArmSqlActiveDirectoryAdministratorRead,
+		Update: resourceArmSqlActiveDirectoryAdministratorCreateUpdate,
+		Delete: resourceArmSqlActiveDirectoryAdministratorDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("servers", "administrators"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"server_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"login": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateUUID,
+			},
+
+			"tenant_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateUUID,
+			},
+		},
+	}
+}
+
+func resourceArmSqlActiveDirectoryAdministratorCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlServerAzureADAdministratorsClient
+
+	serverName := d.Get("server_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	login := d.Get("login").(string)
+
+	objectId := uuid.FromStringOrNil(d.Get("object_id").(string))
+	tenantId := uuid.FromStringOrNil(d.Get("tenant_id").(string))
+
+	parameters := sql.ServerAzureADAdministrator{
+		ServerAdministratorProperties: &sql.ServerAdministratorProperties{
+			AdministratorType: utils.String("ActiveDirectory"),
+			Login:             utils.String(login),
+			Sid:               &objectId,
+			TenantID:          &tenantId,
+		},
+	}
+
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.CreateOrUpdate(resourceGroup, serverName, sqlActiveDirectoryAdministratorName, parameters, cancel)
+	err := <-createErr
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Active Directory Administrator for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	resp, err := client.Get(resourceGroup, serverName, sqlActiveDirectoryAdministratorName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Active Directory Administrator for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Active Directory Administrator for SQL Server %q (Resource Group %q) ID", serverName, resourceGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmSqlActiveDirectoryAdministratorRead(d, meta)
+}
+
+func resourceArmSqlActiveDirectoryAdministratorRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlServerAzureADAdministratorsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	name := id.Path["administrators"]
+
+	resp, err := client.Get(resourceGroup, serverName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Error reading SQL Active Directory Administrator %q - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading Active Directory Administrator for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+
+	if props := resp.ServerAdministratorProperties; props != nil {
+		d.Set("login", props.Login)
+
+		if sid := props.Sid; sid != nil {
+			d.Set("object_id", sid.String())
+		}
+
+		if tenantId := props.TenantID; tenantId != nil {
+			d.Set("tenant_id", tenantId.String())
+		}
+	}
+
+	return nil
+}
+
+func resourceArmSqlActiveDirectoryAdministratorDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).sqlServerAzureADAdministratorsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	serverName := id.Path["servers"]
+	name := id.Path["administrators"]
+
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := client.Delete(resourceGroup, serverName, name, cancel)
+	resp := <-deleteResp
+	err = <-deleteErr
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Active Directory Administrator for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	return nil
+}
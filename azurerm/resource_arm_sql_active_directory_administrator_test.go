@@ -0,0 +1,122 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSqlActiveDirectoryAdministrator_basic(t *testing.T) {
+	resourceName := "azurerm_sql_active_directory_administrator.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMSqlActiveDirectoryAdministrator_basic(ri, location, "sqladmin")
+	postConfig := testAccAzureRMSqlActiveDirectoryAdministrator_basic(ri, location, "sqladmin2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlActiveDirectoryAdministratorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlActiveDirectoryAdministratorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "login", "sqladmin"),
+					resource.TestCheckResourceAttrSet(resourceName, "object_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "tenant_id"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlActiveDirectoryAdministratorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "login", "sqladmin2"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSqlActiveDirectoryAdministratorExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).sqlServerAzureADAdministratorsClient
+
+		resp, err := client.Get(resourceGroup, serverName, sqlActiveDirectoryAdministratorName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("SQL Active Directory Administrator (server %q / resource group %q) was not found", serverName, resourceGroup)
+			}
+
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSqlActiveDirectoryAdministratorDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_sql_active_directory_administrator" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).sqlServerAzureADAdministratorsClient
+
+		resp, err := client.Get(resourceGroup, serverName, sqlActiveDirectoryAdministratorName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("SQL Active Directory Administrator (server %q / resource group %q) still exists: %+v", serverName, resourceGroup, resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMSqlActiveDirectoryAdministrator_basic(rInt int, location string, login string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG_%d"
+    location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+    name = "acctestsqlserver%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    version = "12.0"
+    administrator_login = "mradministrator"
+    administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_active_directory_administrator" "test" {
+    server_name = "${azurerm_sql_server.test.name}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    login = "%s"
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.object_id}"
+}
+`, rInt, location, rInt, login)
+}
@@ -13,6 +13,9 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// the Transparent Data Encryption configuration for a database is always named `current`
+const sqlTransparentDataEncryptionName = "current"
+
 func resourceArmSqlDatabase() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmSqlDatabaseCreateUpdate,
@@ -20,7 +23,7 @@ func resourceArmSqlDatabase() *schema.Resource {
 		Update: resourceArmSqlDatabaseCreateUpdate,
 		Delete: resourceArmSqlDatabaseDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("servers", "databases"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -129,6 +132,12 @@ func resourceArmSqlDatabase() *schema.Resource {
 				Computed: true,
 			},
 
+			"transparent_data_encryption_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
 			"creation_date": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -160,7 +169,7 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 		DatabaseProperties: &sql.DatabaseProperties{
 			CreateMode: sql.CreateMode(createMode),
 		},
-		Tags: expandTags(tags),
+		Tags: expandResourceTags(d, tags, meta),
 	}
 
 	if v, ok := d.GetOk("source_database_id"); ok {
@@ -226,7 +235,9 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	_, createErr := client.CreateOrUpdate(resourceGroup, serverName, name, properties, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.CreateOrUpdate(resourceGroup, serverName, name, properties, cancel)
 	err := <-createErr
 	if err != nil {
 		return err
@@ -239,6 +250,12 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 
 	d.SetId(*resp.ID)
 
+	if v, ok := d.GetOkExists("transparent_data_encryption_enabled"); ok && (d.IsNewResource() || d.HasChange("transparent_data_encryption_enabled")) {
+		if err := updateSqlDatabaseTransparentDataEncryption(resourceGroup, serverName, name, v.(bool), meta); err != nil {
+			return err
+		}
+	}
+
 	return resourceArmSqlDatabaseRead(d, meta)
 }
 
@@ -300,7 +317,17 @@ func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("encryption", flattenEncryptionStatus(props.TransparentDataEncryption))
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	tdeClient := meta.(*ArmClient).sqlTransparentDataEncryptionsClient
+	tde, err := tdeClient.Get(resourceGroup, serverName, name, sqlTransparentDataEncryptionName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Transparent Data Encryption for SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	if props := tde.TransparentDataEncryptionProperties; props != nil {
+		d.Set("transparent_data_encryption_enabled", props.Status == sql.TransparentDataEncryptionStatusEnabled)
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -333,6 +360,28 @@ func resourceArmSqlDatabaseDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+func updateSqlDatabaseTransparentDataEncryption(resourceGroup, serverName, name string, enabled bool, meta interface{}) error {
+	client := meta.(*ArmClient).sqlTransparentDataEncryptionsClient
+
+	status := sql.TransparentDataEncryptionStatusDisabled
+	if enabled {
+		status = sql.TransparentDataEncryptionStatusEnabled
+	}
+
+	parameters := sql.TransparentDataEncryption{
+		TransparentDataEncryptionProperties: &sql.TransparentDataEncryptionProperties{
+			Status: status,
+		},
+	}
+
+	_, err := client.CreateOrUpdate(resourceGroup, serverName, name, sqlTransparentDataEncryptionName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error updating Transparent Data Encryption for SQL Database %q (Server %q / Resource Group %q): %+v", name, serverName, resourceGroup, err)
+	}
+
+	return nil
+}
+
 func flattenEncryptionStatus(encryption *[]sql.TransparentDataEncryption) string {
 	if encryption != nil {
 		encrypted := *encryption
@@ -103,6 +103,34 @@ func TestAccAzureRMSqlDatabase_withTags(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSqlDatabase_transparentDataEncryption(t *testing.T) {
+	resourceName := "azurerm_sql_database.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSqlDatabase_transparentDataEncryption(ri, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transparent_data_encryption_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccAzureRMSqlDatabase_transparentDataEncryption(ri, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlDatabaseExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transparent_data_encryption_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMSqlDatabase_dataWarehouse(t *testing.T) {
 	ri := acctest.RandInt()
 	config := testAccAzureRMSqlDatabase_dataWarehouse(ri, testLocation())
@@ -328,6 +356,36 @@ resource "azurerm_sql_database" "test" {
 `, rInt, location, rInt, rInt)
 }
 
+func testAccAzureRMSqlDatabase_transparentDataEncryption(rInt int, location string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG_%d"
+    location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+    name = "acctestsqlserver%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    version = "12.0"
+    administrator_login = "mradministrator"
+    administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_sql_database" "test" {
+    name = "acctestdb%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    server_name = "${azurerm_sql_server.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    edition = "Standard"
+    collation = "SQL_Latin1_General_CP1_CI_AS"
+    max_size_bytes = "1073741824"
+    requested_service_objective_name = "S0"
+    transparent_data_encryption_enabled = %t
+}
+`, rInt, location, rInt, rInt, enabled)
+}
+
 func testAccAzureRMSqlDatabase_dataWarehouse(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
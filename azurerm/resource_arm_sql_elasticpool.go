@@ -18,7 +18,7 @@ func resourceArmSqlElasticPool() *schema.Resource {
 		Update: resourceArmSqlElasticPoolCreate,
 		Delete: resourceArmSqlElasticPoolDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("servers", "elasticPools"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -94,10 +94,12 @@ func resourceArmSqlElasticPoolCreate(d *schema.ResourceData, meta interface{}) e
 		Name:                  &name,
 		Location:              &location,
 		ElasticPoolProperties: getArmSqlElasticPoolProperties(d),
-		Tags: expandTags(tags),
+		Tags: expandResourceTags(d, tags, meta),
 	}
 
-	_, error := elasticPoolsClient.CreateOrUpdate(resGroup, serverName, name, elasticPool, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, error := elasticPoolsClient.CreateOrUpdate(resGroup, serverName, name, elasticPool, cancel)
 	err := <-error
 	if err != nil {
 		return err
@@ -143,17 +145,29 @@ func resourceArmSqlElasticPoolRead(d *schema.ResourceData, meta interface{}) err
 
 	if elasticPool != nil {
 		d.Set("edition", string(elasticPool.Edition))
-		d.Set("dtu", int(*elasticPool.Dtu))
-		d.Set("db_dtu_min", int(*elasticPool.DatabaseDtuMin))
-		d.Set("db_dtu_max", int(*elasticPool.DatabaseDtuMax))
-		d.Set("pool_size", int(*elasticPool.StorageMB))
+
+		if dtu := elasticPool.Dtu; dtu != nil {
+			d.Set("dtu", int(*dtu))
+		}
+
+		if dtuMin := elasticPool.DatabaseDtuMin; dtuMin != nil {
+			d.Set("db_dtu_min", int(*dtuMin))
+		}
+
+		if dtuMax := elasticPool.DatabaseDtuMax; dtuMax != nil {
+			d.Set("db_dtu_max", int(*dtuMax))
+		}
+
+		if poolSize := elasticPool.StorageMB; poolSize != nil {
+			d.Set("pool_size", int(*poolSize))
+		}
 
 		if elasticPool.CreationDate != nil {
 			d.Set("creation_date", elasticPool.CreationDate.Format(time.RFC3339))
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -167,9 +181,16 @@ func resourceArmSqlElasticPoolDelete(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	_, err = elasticPoolsClient.Delete(resGroup, serverName, name)
+	resp, err := elasticPoolsClient.Delete(resGroup, serverName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
 
-	return err
+		return fmt.Errorf("Error deleting SQL ElasticPool %q (Server %q / Resource Group %q): %+v", name, serverName, resGroup, err)
+	}
+
+	return nil
 }
 
 func getArmSqlElasticPoolProperties(d *schema.ResourceData) *sql.ElasticPoolProperties {
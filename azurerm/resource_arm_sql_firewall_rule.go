@@ -16,7 +16,7 @@ func resourceArmSqlFirewallRule() *schema.Resource {
 		Update: resourceArmSqlFirewallRuleCreateUpdate,
 		Delete: resourceArmSqlFirewallRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("servers", "firewallRules"),
 		},
 
 		Schema: map[string]*schema.Schema{
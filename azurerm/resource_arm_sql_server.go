@@ -18,7 +18,7 @@ func resourceArmSqlServer() *schema.Resource {
 		Update: resourceArmSqlServerCreateUpdate,
 		Delete: resourceArmSqlServerDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("servers"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -60,6 +60,36 @@ func resourceArmSqlServer() *schema.Resource {
 				Computed: true,
 			},
 
+			"allow_azure_services": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"firewall_rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"start_ip_address": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"end_ip_address": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -76,7 +106,7 @@ func resourceArmSqlServerCreateUpdate(d *schema.ResourceData, meta interface{})
 	version := d.Get("version").(string)
 
 	tags := d.Get("tags").(map[string]interface{})
-	metadata := expandTags(tags)
+	metadata := expandResourceTags(d, tags, meta)
 
 	parameters := sql.Server{
 		Location: utils.String(location),
@@ -88,7 +118,9 @@ func resourceArmSqlServerCreateUpdate(d *schema.ResourceData, meta interface{})
 		},
 	}
 
-	createResp, createErr := client.CreateOrUpdate(resGroup, name, parameters, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	createResp, createErr := client.CreateOrUpdate(resGroup, name, parameters, cancel)
 	resp := <-createResp
 	err := <-createErr
 	if err != nil {
@@ -107,6 +139,20 @@ func resourceArmSqlServerCreateUpdate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(*resp.ID)
 
+	if v, ok := d.GetOkExists("allow_azure_services"); ok && (d.IsNewResource() || d.HasChange("allow_azure_services")) {
+		if err := updateSqlServerAllowAzureServices(resGroup, name, v.(bool), meta); err != nil {
+			return err
+		}
+	}
+
+	// GetOk is false for an empty set - which needs to remove any existing rules from the server
+	if d.HasChange("firewall_rule") {
+		rules := d.Get("firewall_rule").(*schema.Set).List()
+		if err := updateSqlServerFirewallRules(resGroup, name, rules, meta); err != nil {
+			return err
+		}
+	}
+
 	return resourceArmSqlServerRead(d, meta)
 }
 
@@ -142,7 +188,20 @@ func resourceArmSqlServerRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("fully_qualified_domain_name", serverProperties.FullyQualifiedDomainName)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	// the Firewall Rules are read back in full so that any created outside of Terraform show up as a diff
+	firewallClient := meta.(*ArmClient).sqlFirewallRulesClient
+	rules, err := firewallClient.ListByServer(resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error listing Firewall Rules for SQL Server %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	allowAzureServices, firewallRules := flattenSqlServerFirewallRules(rules.Value)
+	d.Set("allow_azure_services", allowAzureServices)
+	if err := d.Set("firewall_rule", firewallRules); err != nil {
+		return fmt.Errorf("Error setting `firewall_rule`: %+v", err)
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -158,7 +217,9 @@ func resourceArmSqlServerDelete(d *schema.ResourceData, meta interface{}) error
 	resGroup := id.ResourceGroup
 	name := id.Path["servers"]
 
-	deleteResp, deleteErr := client.Delete(resGroup, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteResp, deleteErr := client.Delete(resGroup, name, cancel)
 	resp := <-deleteResp
 	err = <-deleteErr
 	if err != nil {
@@ -171,3 +232,145 @@ func resourceArmSqlServerDelete(d *schema.ResourceData, meta interface{}) error
 
 	return nil
 }
+
+// Azure represents "Allow access to Azure services" as a Firewall Rule spanning 0.0.0.0 - 0.0.0.0
+const (
+	sqlServerAllowAzureServicesRuleName = "AllowAllWindowsAzureIps"
+	sqlServerAllowAzureServicesIP       = "0.0.0.0"
+)
+
+func isSqlServerAllowAzureServicesRule(rule sql.FirewallRule) bool {
+	props := rule.FirewallRuleProperties
+	if props == nil || props.StartIPAddress == nil || props.EndIPAddress == nil {
+		return false
+	}
+
+	return *props.StartIPAddress == sqlServerAllowAzureServicesIP && *props.EndIPAddress == sqlServerAllowAzureServicesIP
+}
+
+func updateSqlServerAllowAzureServices(resourceGroup, serverName string, enabled bool, meta interface{}) error {
+	client := meta.(*ArmClient).sqlFirewallRulesClient
+
+	if enabled {
+		parameters := sql.FirewallRule{
+			FirewallRuleProperties: &sql.FirewallRuleProperties{
+				StartIPAddress: utils.String(sqlServerAllowAzureServicesIP),
+				EndIPAddress:   utils.String(sqlServerAllowAzureServicesIP),
+			},
+		}
+
+		_, err := client.CreateOrUpdate(resourceGroup, serverName, sqlServerAllowAzureServicesRuleName, parameters)
+		if err != nil {
+			return fmt.Errorf("Error allowing access to Azure Services on SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+		}
+
+		return nil
+	}
+
+	existing, err := client.ListByServer(resourceGroup, serverName)
+	if err != nil {
+		return fmt.Errorf("Error listing Firewall Rules for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	if existing.Value == nil {
+		return nil
+	}
+
+	for _, rule := range *existing.Value {
+		if !isSqlServerAllowAzureServicesRule(rule) || rule.Name == nil {
+			continue
+		}
+
+		log.Printf("[DEBUG] Removing Firewall Rule %q from SQL Server %q (Resource Group %q)", *rule.Name, serverName, resourceGroup)
+		resp, err := client.Delete(resourceGroup, serverName, *rule.Name)
+		if err != nil && !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error removing Firewall Rule %q from SQL Server %q (Resource Group %q): %+v", *rule.Name, serverName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func updateSqlServerFirewallRules(resourceGroup, serverName string, input []interface{}, meta interface{}) error {
+	client := meta.(*ArmClient).sqlFirewallRulesClient
+
+	expected := make(map[string]sql.FirewallRule, 0)
+	for _, v := range input {
+		raw := v.(map[string]interface{})
+		name := raw["name"].(string)
+		expected[name] = sql.FirewallRule{
+			FirewallRuleProperties: &sql.FirewallRuleProperties{
+				StartIPAddress: utils.String(raw["start_ip_address"].(string)),
+				EndIPAddress:   utils.String(raw["end_ip_address"].(string)),
+			},
+		}
+	}
+
+	existing, err := client.ListByServer(resourceGroup, serverName)
+	if err != nil {
+		return fmt.Errorf("Error listing Firewall Rules for SQL Server %q (Resource Group %q): %+v", serverName, resourceGroup, err)
+	}
+
+	if existing.Value != nil {
+		for _, rule := range *existing.Value {
+			// the Allow Azure Services rule is managed via `allow_azure_services`
+			if isSqlServerAllowAzureServicesRule(rule) || rule.Name == nil {
+				continue
+			}
+
+			if _, ok := expected[*rule.Name]; ok {
+				continue
+			}
+
+			log.Printf("[DEBUG] Removing Firewall Rule %q from SQL Server %q (Resource Group %q)", *rule.Name, serverName, resourceGroup)
+			resp, err := client.Delete(resourceGroup, serverName, *rule.Name)
+			if err != nil && !utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Error removing Firewall Rule %q from SQL Server %q (Resource Group %q): %+v", *rule.Name, serverName, resourceGroup, err)
+			}
+		}
+	}
+
+	for name, rule := range expected {
+		log.Printf("[DEBUG] Creating/updating Firewall Rule %q on SQL Server %q (Resource Group %q)", name, serverName, resourceGroup)
+		_, err := client.CreateOrUpdate(resourceGroup, serverName, name, rule)
+		if err != nil {
+			return fmt.Errorf("Error creating/updating Firewall Rule %q on SQL Server %q (Resource Group %q): %+v", name, serverName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func flattenSqlServerFirewallRules(input *[]sql.FirewallRule) (bool, []interface{}) {
+	allowAzureServices := false
+	results := make([]interface{}, 0)
+
+	if input == nil {
+		return allowAzureServices, results
+	}
+
+	for _, rule := range *input {
+		if isSqlServerAllowAzureServicesRule(rule) {
+			allowAzureServices = true
+			continue
+		}
+
+		output := make(map[string]interface{}, 0)
+		if rule.Name != nil {
+			output["name"] = *rule.Name
+		}
+
+		if props := rule.FirewallRuleProperties; props != nil {
+			if props.StartIPAddress != nil {
+				output["start_ip_address"] = *props.StartIPAddress
+			}
+			if props.EndIPAddress != nil {
+				output["end_ip_address"] = *props.EndIPAddress
+			}
+		}
+
+		results = append(results, output)
+	}
+
+	return allowAzureServices, results
+}
@@ -127,6 +127,46 @@ func TestAccAzureRMSqlServer_withTags(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSqlServer_firewallRules(t *testing.T) {
+	resourceName := "azurerm_sql_server.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	preConfig := testAccAzureRMSqlServer_firewallRules(ri, location)
+	postConfig := testAccAzureRMSqlServer_firewallRulesUpdated(ri, location)
+	removedConfig := testAccAzureRMSqlServer_firewallRulesRemoved(ri, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSqlServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: preConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_azure_services", "true"),
+					resource.TestCheckResourceAttr(resourceName, "firewall_rule.#", "2"),
+				),
+			},
+			{
+				Config: postConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_azure_services", "false"),
+					resource.TestCheckResourceAttr(resourceName, "firewall_rule.#", "1"),
+				),
+			},
+			{
+				Config: removedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSqlServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "firewall_rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMSqlServerExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -270,3 +310,79 @@ resource "azurerm_sql_server" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMSqlServer_firewallRules(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG_%d"
+    location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+    name = "acctestsqlserver%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    version = "12.0"
+    administrator_login = "mradministrator"
+    administrator_login_password = "thisIsDog11"
+    allow_azure_services = true
+
+    firewall_rule {
+        name = "office"
+        start_ip_address = "10.0.17.1"
+        end_ip_address = "10.0.17.254"
+    }
+
+    firewall_rule {
+        name = "datacenter"
+        start_ip_address = "10.0.18.1"
+        end_ip_address = "10.0.18.254"
+    }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMSqlServer_firewallRulesUpdated(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG_%d"
+    location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+    name = "acctestsqlserver%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    version = "12.0"
+    administrator_login = "mradministrator"
+    administrator_login_password = "thisIsDog11"
+    allow_azure_services = false
+
+    firewall_rule {
+        name = "office"
+        start_ip_address = "10.0.17.1"
+        end_ip_address = "10.0.17.100"
+    }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMSqlServer_firewallRulesRemoved(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG_%d"
+    location = "%s"
+}
+
+resource "azurerm_sql_server" "test" {
+    name = "acctestsqlserver%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    location = "${azurerm_resource_group.test.location}"
+    version = "12.0"
+    administrator_login = "mradministrator"
+    administrator_login_password = "thisIsDog11"
+    allow_azure_services = false
+    firewall_rule = []
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,268 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/streamanalytics"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStreamAnalyticsFunctionJavaScriptUDF() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStreamAnalyticsFunctionJavaScriptUDFCreateUpdate,
+		Read:   resourceArmStreamAnalyticsFunctionJavaScriptUDFRead,
+		Update: resourceArmStreamAnalyticsFunctionJavaScriptUDFCreateUpdate,
+		Delete: resourceArmStreamAnalyticsFunctionJavaScriptUDFDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("streamingjobs", "functions"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"stream_analytics_job_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"input": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateStreamAnalyticsFunctionDataType,
+						},
+					},
+				},
+			},
+
+			"output": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateStreamAnalyticsFunctionDataType,
+						},
+					},
+				},
+			},
+
+			"script": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+var validateStreamAnalyticsFunctionDataType = validation.StringInSlice([]string{
+	"any",
+	"datetime",
+	"array",
+	"bigint",
+	"float",
+	"nvarchar(max)",
+	"record",
+}, false)
+
+func resourceArmStreamAnalyticsFunctionJavaScriptUDFCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsFunctionsClient
+	log.Printf("[INFO] preparing arguments for Azure Stream Analytics Function Javascript UDF creation.")
+
+	name := d.Get("name").(string)
+	jobName := d.Get("stream_analytics_job_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	inputs := expandStreamAnalyticsFunctionInputs(d.Get("input").([]interface{}))
+	output := expandStreamAnalyticsFunctionOutput(d.Get("output").([]interface{}))
+
+	function := streamanalytics.Function{
+		Name: utils.String(name),
+		Properties: &streamanalytics.ScalarFunctionProperties{
+			Type: streamanalytics.TypeScalar,
+			ScalarFunctionConfiguration: &streamanalytics.ScalarFunctionConfiguration{
+				Binding: &streamanalytics.JavaScriptFunctionBinding{
+					Type: streamanalytics.TypeMicrosoftStreamAnalyticsJavascriptUdf,
+					JavaScriptFunctionBindingProperties: &streamanalytics.JavaScriptFunctionBindingProperties{
+						Script: utils.String(d.Get("script").(string)),
+					},
+				},
+				Inputs: inputs,
+				Output: output,
+			},
+		},
+	}
+
+	if _, err := client.CreateOrReplace(function, resourceGroup, jobName, name, "", ""); err != nil {
+		return fmt.Errorf("Error creating/updating Stream Analytics Function Javascript UDF %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+
+	read, err := client.Get(resourceGroup, jobName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Stream Analytics Function Javascript UDF %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Stream Analytics Function Javascript UDF %q (Job %q / Resource Group %q)", name, jobName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmStreamAnalyticsFunctionJavaScriptUDFRead(d, meta)
+}
+
+func resourceArmStreamAnalyticsFunctionJavaScriptUDFRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsFunctionsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+	name := id.Path["functions"]
+
+	resp, err := client.Get(resourceGroup, jobName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Stream Analytics Function Javascript UDF %q was not found in Job %q / Resource Group %q - removing from state!", name, jobName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Stream Analytics Function Javascript UDF %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("stream_analytics_job_name", jobName)
+
+	if props := resp.Properties; props != nil {
+		scalarProps, ok := props.AsScalarFunctionProperties()
+		if !ok {
+			return fmt.Errorf("Error converting Function %q to a Scalar Function", name)
+		}
+
+		if config := scalarProps.ScalarFunctionConfiguration; config != nil {
+			if binding := config.Binding; binding != nil {
+				javascriptBinding, ok := binding.AsJavaScriptFunctionBinding()
+				if !ok {
+					return fmt.Errorf("Error converting Binding for Function %q to a JavaScript Binding", name)
+				}
+
+				if bindingProps := javascriptBinding.JavaScriptFunctionBindingProperties; bindingProps != nil {
+					d.Set("script", bindingProps.Script)
+				}
+			}
+
+			if err := d.Set("input", flattenStreamAnalyticsFunctionInputs(config.Inputs)); err != nil {
+				return fmt.Errorf("Error setting `input`: %+v", err)
+			}
+
+			if err := d.Set("output", flattenStreamAnalyticsFunctionOutput(config.Output)); err != nil {
+				return fmt.Errorf("Error setting `output`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStreamAnalyticsFunctionJavaScriptUDFDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsFunctionsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+	name := id.Path["functions"]
+
+	resp, err := client.Delete(resourceGroup, jobName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Stream Analytics Function Javascript UDF %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandStreamAnalyticsFunctionInputs(input []interface{}) *[]streamanalytics.FunctionInput {
+	outputs := make([]streamanalytics.FunctionInput, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+		variableType := v["type"].(string)
+		outputs = append(outputs, streamanalytics.FunctionInput{
+			DataType: utils.String(variableType),
+		})
+	}
+
+	return &outputs
+}
+
+func flattenStreamAnalyticsFunctionInputs(input *[]streamanalytics.FunctionInput) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	outputs := make([]interface{}, 0)
+
+	for _, v := range *input {
+		var variableType string
+		if v.DataType != nil {
+			variableType = *v.DataType
+		}
+
+		outputs = append(outputs, map[string]interface{}{
+			"type": variableType,
+		})
+	}
+
+	return outputs
+}
+
+func expandStreamAnalyticsFunctionOutput(input []interface{}) *streamanalytics.FunctionOutput {
+	output := input[0].(map[string]interface{})
+
+	dataType := output["type"].(string)
+	return &streamanalytics.FunctionOutput{
+		DataType: utils.String(dataType),
+	}
+}
+
+func flattenStreamAnalyticsFunctionOutput(input *streamanalytics.FunctionOutput) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	var variableType string
+	if input.DataType != nil {
+		variableType = *input.DataType
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type": variableType,
+		},
+	}
+}
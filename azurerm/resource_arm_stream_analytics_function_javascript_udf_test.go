@@ -0,0 +1,166 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMStreamAnalyticsFunctionJavaScriptUDF_basic(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_function_javascript_udf.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsFunctionJavaScriptUDFDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStreamAnalyticsFunctionJavaScriptUDF_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsFunctionJavaScriptUDFExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStreamAnalyticsFunctionJavaScriptUDF_update(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_function_javascript_udf.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsFunctionJavaScriptUDFDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStreamAnalyticsFunctionJavaScriptUDF_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsFunctionJavaScriptUDFExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMStreamAnalyticsFunctionJavaScriptUDF_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsFunctionJavaScriptUDFExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "input.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStreamAnalyticsFunctionJavaScriptUDFExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		resourceName := rs.Primary.Attributes["name"]
+		jobName := rs.Primary.Attributes["stream_analytics_job_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		conn := testAccProvider.Meta().(*ArmClient).streamAnalyticsFunctionsClient
+		resp, err := conn.Get(resourceGroup, jobName, resourceName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on streamAnalyticsFunctionsClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Stream Analytics Function JavaScript UDF %q (Job %q / Resource Group %q) does not exist", resourceName, jobName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStreamAnalyticsFunctionJavaScriptUDFDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).streamAnalyticsFunctionsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_stream_analytics_function_javascript_udf" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		jobName := rs.Primary.Attributes["stream_analytics_job_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		resp, err := conn.Get(resourceGroup, jobName, name)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Stream Analytics Function JavaScript UDF still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMStreamAnalyticsFunctionJavaScriptUDF_basic(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsJob_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_function_javascript_udf" "test" {
+  name                      = "acctestfunction-%d"
+  stream_analytics_job_name = "${azurerm_stream_analytics_job.test.name}"
+  resource_group_name       = "${azurerm_stream_analytics_job.test.resource_group_name}"
+
+  script = <<SCRIPT
+function getRandomNumber(in) {
+  return in;
+}
+SCRIPT
+
+  input {
+    type = "bigint"
+  }
+
+  output {
+    type = "bigint"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMStreamAnalyticsFunctionJavaScriptUDF_updated(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsJob_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_function_javascript_udf" "test" {
+  name                      = "acctestfunction-%d"
+  stream_analytics_job_name = "${azurerm_stream_analytics_job.test.name}"
+  resource_group_name       = "${azurerm_stream_analytics_job.test.resource_group_name}"
+
+  script = <<SCRIPT
+function getRandomNumber(first, second) {
+  return first + second;
+}
+SCRIPT
+
+  input {
+    type = "bigint"
+  }
+
+  input {
+    type = "bigint"
+  }
+
+  output {
+    type = "bigint"
+  }
+}
+`, template, rInt)
+}
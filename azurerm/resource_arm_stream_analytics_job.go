@@ -0,0 +1,362 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/streamanalytics"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStreamAnalyticsJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStreamAnalyticsJobCreate,
+		Read:   resourceArmStreamAnalyticsJobRead,
+		Update: resourceArmStreamAnalyticsJobUpdate,
+		Delete: resourceArmStreamAnalyticsJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("streamingjobs"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"compatibility_level": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(streamanalytics.OneFullStopZero),
+					"1.1",
+				}, false),
+			},
+
+			"data_locale": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"events_late_arrival_max_delay_in_seconds": {
+				// portal allows for up to 20d 23h 59m 59s
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(-1, 1814399),
+			},
+
+			"events_out_of_order_max_delay_in_seconds": {
+				// portal allows for up to 9m 59s
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 599),
+			},
+
+			"events_out_of_order_policy": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(streamanalytics.Adjust),
+					string(streamanalytics.Drop),
+				}, false),
+			},
+
+			"output_error_policy": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(streamanalytics.OutputErrorPolicyDrop),
+					string(streamanalytics.OutputErrorPolicyStop),
+				}, false),
+			},
+
+			"streaming_units": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateIntInSlice([]int{1, 3, 6, 12, 18, 24, 30, 36, 42, 48}),
+			},
+
+			"transformation_query": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"started": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"output_start_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(streamanalytics.JobStartTime),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(streamanalytics.JobStartTime),
+					string(streamanalytics.LastOutputEventTime),
+				}, false),
+			},
+
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmStreamAnalyticsJobCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsJobsClient
+	log.Printf("[INFO] preparing arguments for Azure Stream Analytics Job creation.")
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	props := expandStreamAnalyticsJobProperties(d)
+	props.Sku = &streamanalytics.Sku{
+		Name: streamanalytics.Standard,
+	}
+	props.Transformation = &streamanalytics.Transformation{
+		Name: utils.String("main"),
+		TransformationProperties: &streamanalytics.TransformationProperties{
+			StreamingUnits: utils.Int32(int32(d.Get("streaming_units").(int))),
+			Query:          utils.String(d.Get("transformation_query").(string)),
+		},
+	}
+
+	job := streamanalytics.StreamingJob{
+		Name:                   utils.String(name),
+		Location:               utils.String(location),
+		StreamingJobProperties: props,
+		Tags:                   expandResourceTags(d, tags, meta),
+	}
+
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, errChan := client.CreateOrReplace(job, resourceGroup, name, "", "", cancel)
+	if err := <-errChan; err != nil {
+		return fmt.Errorf("Error creating Stream Analytics Job %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Stream Analytics Job %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Stream Analytics Job %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	if d.Get("started").(bool) {
+		if err := startStreamAnalyticsJob(d, meta, resourceGroup, name); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmStreamAnalyticsJobRead(d, meta)
+}
+
+func resourceArmStreamAnalyticsJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsJobsClient
+	transformationsClient := meta.(*ArmClient).streamAnalyticsTransformationsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["streamingjobs"]
+
+	existing, err := client.Get(resourceGroup, name, "transformation")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Stream Analytics Job %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	isRunning := false
+	if props := existing.StreamingJobProperties; props != nil {
+		isRunning = streamAnalyticsJobIsRunning(props.JobState)
+	}
+
+	// the Job has to be stopped before the Job or its Transformation can be modified
+	otherChanges := d.HasChange("compatibility_level") || d.HasChange("data_locale") ||
+		d.HasChange("events_late_arrival_max_delay_in_seconds") || d.HasChange("events_out_of_order_max_delay_in_seconds") ||
+		d.HasChange("events_out_of_order_policy") || d.HasChange("output_error_policy") ||
+		d.HasChange("streaming_units") || d.HasChange("transformation_query") || d.HasChange("tags") ||
+		d.HasChange("default_tags_applied")
+	if isRunning && (otherChanges || !d.Get("started").(bool)) {
+		log.Printf("[DEBUG] Stopping Stream Analytics Job %q (Resource Group %q)..", name, resourceGroup)
+		cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+		defer release()
+		_, errChan := client.Stop(resourceGroup, name, cancel)
+		if err := <-errChan; err != nil {
+			return fmt.Errorf("Error stopping Stream Analytics Job %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+		isRunning = false
+	}
+
+	if otherChanges {
+		tags := d.Get("tags").(map[string]interface{})
+		job := streamanalytics.StreamingJob{
+			StreamingJobProperties: expandStreamAnalyticsJobProperties(d),
+			Tags:                   expandResourceTags(d, tags, meta),
+		}
+
+		if _, err := client.Update(job, resourceGroup, name, ""); err != nil {
+			return fmt.Errorf("Error updating Stream Analytics Job %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		transformation := streamanalytics.Transformation{
+			TransformationProperties: &streamanalytics.TransformationProperties{
+				StreamingUnits: utils.Int32(int32(d.Get("streaming_units").(int))),
+				Query:          utils.String(d.Get("transformation_query").(string)),
+			},
+		}
+
+		transformationName := "main"
+		if props := existing.StreamingJobProperties; props != nil {
+			if t := props.Transformation; t != nil && t.Name != nil {
+				transformationName = *t.Name
+			}
+		}
+
+		if _, err := transformationsClient.Update(transformation, resourceGroup, name, transformationName, ""); err != nil {
+			return fmt.Errorf("Error updating Transformation %q for Stream Analytics Job %q (Resource Group %q): %+v", transformationName, name, resourceGroup, err)
+		}
+	}
+
+	if d.Get("started").(bool) && !isRunning {
+		if err := startStreamAnalyticsJob(d, meta, resourceGroup, name); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmStreamAnalyticsJobRead(d, meta)
+}
+
+func resourceArmStreamAnalyticsJobRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsJobsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["streamingjobs"]
+
+	resp, err := client.Get(resourceGroup, name, "transformation")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Stream Analytics Job %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Stream Analytics Job %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.StreamingJobProperties; props != nil {
+		d.Set("compatibility_level", string(props.CompatibilityLevel))
+		d.Set("data_locale", props.DataLocale)
+		if props.EventsLateArrivalMaxDelayInSeconds != nil {
+			d.Set("events_late_arrival_max_delay_in_seconds", int(*props.EventsLateArrivalMaxDelayInSeconds))
+		}
+		if props.EventsOutOfOrderMaxDelayInSeconds != nil {
+			d.Set("events_out_of_order_max_delay_in_seconds", int(*props.EventsOutOfOrderMaxDelayInSeconds))
+		}
+		d.Set("events_out_of_order_policy", string(props.EventsOutOfOrderPolicy))
+		d.Set("output_error_policy", string(props.OutputErrorPolicy))
+		d.Set("job_id", props.JobID)
+		d.Set("started", streamAnalyticsJobIsRunning(props.JobState))
+
+		if transformation := props.Transformation; transformation != nil {
+			if tprops := transformation.TransformationProperties; tprops != nil {
+				if tprops.StreamingUnits != nil {
+					d.Set("streaming_units", int(*tprops.StreamingUnits))
+				}
+				d.Set("transformation_query", tprops.Query)
+			}
+		}
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmStreamAnalyticsJobDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsJobsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["streamingjobs"]
+
+	// a running Job can't be deleted, so it needs to be stopped first
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	_, errChan := client.Stop(resourceGroup, name, cancel)
+	if err := <-errChan; err != nil {
+		return fmt.Errorf("Error stopping Stream Analytics Job %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	cancel, release = cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	deleteRespChan, errChan := client.Delete(resourceGroup, name, cancel)
+	deleteResp := <-deleteRespChan
+	if err := <-errChan; err != nil {
+		if !utils.ResponseWasNotFound(deleteResp) {
+			return fmt.Errorf("Error deleting Stream Analytics Job %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandStreamAnalyticsJobProperties(d *schema.ResourceData) *streamanalytics.StreamingJobProperties {
+	return &streamanalytics.StreamingJobProperties{
+		CompatibilityLevel:                 streamanalytics.CompatibilityLevel(d.Get("compatibility_level").(string)),
+		DataLocale:                         utils.String(d.Get("data_locale").(string)),
+		EventsLateArrivalMaxDelayInSeconds: utils.Int32(int32(d.Get("events_late_arrival_max_delay_in_seconds").(int))),
+		EventsOutOfOrderMaxDelayInSeconds:  utils.Int32(int32(d.Get("events_out_of_order_max_delay_in_seconds").(int))),
+		EventsOutOfOrderPolicy:             streamanalytics.EventsOutOfOrderPolicy(d.Get("events_out_of_order_policy").(string)),
+		OutputErrorPolicy:                  streamanalytics.OutputErrorPolicy(d.Get("output_error_policy").(string)),
+	}
+}
+
+func startStreamAnalyticsJob(d *schema.ResourceData, meta interface{}, resourceGroup string, name string) error {
+	client := meta.(*ArmClient).streamAnalyticsJobsClient
+
+	log.Printf("[DEBUG] Starting Stream Analytics Job %q (Resource Group %q)..", name, resourceGroup)
+	params := streamanalytics.StartStreamingJobParameters{
+		OutputStartMode: streamanalytics.OutputStartMode(d.Get("output_start_mode").(string)),
+	}
+
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, errChan := client.Start(resourceGroup, name, &params, cancel)
+	if err := <-errChan; err != nil {
+		return fmt.Errorf("Error starting Stream Analytics Job %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMStreamAnalyticsJob_basic(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_job.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStreamAnalyticsJob_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "streaming_units", "3"),
+					resource.TestCheckResourceAttr(resourceName, "started", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStreamAnalyticsJob_update(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_job.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStreamAnalyticsJob_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "streaming_units", "3"),
+				),
+			},
+			{
+				Config: testAccAzureRMStreamAnalyticsJob_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsJobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "streaming_units", "6"),
+					resource.TestCheckResourceAttr(resourceName, "events_out_of_order_policy", "Drop"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Test"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStreamAnalyticsJobExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		jobName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Stream Analytics Job: %s", jobName)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).streamAnalyticsJobsClient
+		resp, err := conn.Get(resourceGroup, jobName, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on streamAnalyticsJobsClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Stream Analytics Job %q (resource group: %q) does not exist", jobName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStreamAnalyticsJobDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).streamAnalyticsJobsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_stream_analytics_job" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		resp, err := conn.Get(resourceGroup, name, "")
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Stream Analytics Job still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMStreamAnalyticsJob_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_stream_analytics_job" "test" {
+  name                                     = "acctestjob-%d"
+  resource_group_name                      = "${azurerm_resource_group.test.name}"
+  location                                 = "${azurerm_resource_group.test.location}"
+  compatibility_level                      = "1.0"
+  data_locale                              = "en-GB"
+  events_late_arrival_max_delay_in_seconds = 60
+  events_out_of_order_max_delay_in_seconds = 50
+  events_out_of_order_policy               = "Adjust"
+  output_error_policy                      = "Drop"
+  streaming_units                          = 3
+
+  transformation_query = <<QUERY
+    SELECT *
+    INTO [YourOutputAlias]
+    FROM [YourInputAlias]
+QUERY
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMStreamAnalyticsJob_updated(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_stream_analytics_job" "test" {
+  name                                     = "acctestjob-%d"
+  resource_group_name                      = "${azurerm_resource_group.test.name}"
+  location                                 = "${azurerm_resource_group.test.location}"
+  compatibility_level                      = "1.0"
+  data_locale                              = "en-GB"
+  events_late_arrival_max_delay_in_seconds = 10
+  events_out_of_order_max_delay_in_seconds = 20
+  events_out_of_order_policy               = "Drop"
+  output_error_policy                      = "Stop"
+  streaming_units                          = 6
+
+  transformation_query = <<QUERY
+    SELECT *
+    INTO [SomeOtherOutputAlias]
+    FROM [SomeOtherInputAlias]
+QUERY
+
+  tags {
+    environment = "Test"
+  }
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,170 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/streamanalytics"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStreamAnalyticsOutputSql() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStreamAnalyticsOutputSqlCreateUpdate,
+		Read:   resourceArmStreamAnalyticsOutputSqlRead,
+		Update: resourceArmStreamAnalyticsOutputSqlCreateUpdate,
+		Delete: resourceArmStreamAnalyticsOutputSqlDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("streamingjobs", "outputs"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"stream_analytics_job_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"server": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"database": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"table": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"user": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceArmStreamAnalyticsOutputSqlCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsOutputsClient
+	log.Printf("[INFO] preparing arguments for Azure Stream Analytics Output SQL creation.")
+
+	name := d.Get("name").(string)
+	jobName := d.Get("stream_analytics_job_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	props := streamanalytics.Output{
+		Name: utils.String(name),
+		OutputProperties: &streamanalytics.OutputProperties{
+			Datasource: &streamanalytics.AzureSQLDatabaseOutputDataSource{
+				Type: streamanalytics.TypeMicrosoftSQLServerDatabase,
+				AzureSQLDatabaseOutputDataSourceProperties: &streamanalytics.AzureSQLDatabaseOutputDataSourceProperties{
+					Server:   utils.String(d.Get("server").(string)),
+					Database: utils.String(d.Get("database").(string)),
+					User:     utils.String(d.Get("user").(string)),
+					Password: utils.String(d.Get("password").(string)),
+					Table:    utils.String(d.Get("table").(string)),
+				},
+			},
+		},
+	}
+
+	if _, err := client.CreateOrReplace(props, resourceGroup, jobName, name, "", ""); err != nil {
+		return fmt.Errorf("Error creating/updating Stream Analytics Output SQL %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+
+	read, err := client.Get(resourceGroup, jobName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Stream Analytics Output SQL %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Stream Analytics Output SQL %q (Job %q / Resource Group %q)", name, jobName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmStreamAnalyticsOutputSqlRead(d, meta)
+}
+
+func resourceArmStreamAnalyticsOutputSqlRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsOutputsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+	name := id.Path["outputs"]
+
+	resp, err := client.Get(resourceGroup, jobName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Stream Analytics Output SQL %q was not found in Job %q / Resource Group %q - removing from state!", name, jobName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Stream Analytics Output SQL %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("stream_analytics_job_name", jobName)
+
+	if props := resp.OutputProperties; props != nil && props.Datasource != nil {
+		v, ok := props.Datasource.AsAzureSQLDatabaseOutputDataSource()
+		if !ok {
+			return fmt.Errorf("Error converting Output Data Source %q to a SQL Output", name)
+		}
+
+		// the Password isn't returned from the API
+		if sqlProps := v.AzureSQLDatabaseOutputDataSourceProperties; sqlProps != nil {
+			d.Set("server", sqlProps.Server)
+			d.Set("database", sqlProps.Database)
+			d.Set("table", sqlProps.Table)
+			d.Set("user", sqlProps.User)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStreamAnalyticsOutputSqlDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsOutputsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+	name := id.Path["outputs"]
+
+	resp, err := client.Delete(resourceGroup, jobName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Stream Analytics Output SQL %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
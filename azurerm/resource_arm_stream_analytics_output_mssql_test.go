@@ -0,0 +1,184 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAzureRMStreamAnalyticsOutputSql_basic(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_mssql.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputSqlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStreamAnalyticsOutputSql_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputSqlExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStreamAnalyticsOutputSql_update(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_mssql.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputSqlDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStreamAnalyticsOutputSql_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputSqlExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMStreamAnalyticsOutputSql_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputSqlExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "table", "AccTestTableUpdated"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStreamAnalyticsOutputSqlExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		resourceName := rs.Primary.Attributes["name"]
+		jobName := rs.Primary.Attributes["stream_analytics_job_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		conn := testAccProvider.Meta().(*ArmClient).streamAnalyticsOutputsClient
+		resp, err := conn.Get(resourceGroup, jobName, resourceName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on streamAnalyticsOutputsClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Stream Analytics Output SQL %q (Job %q / Resource Group %q) does not exist", resourceName, jobName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStreamAnalyticsOutputSqlDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).streamAnalyticsOutputsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_stream_analytics_output_mssql" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		jobName := rs.Primary.Attributes["stream_analytics_job_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		resp, err := conn.Get(resourceGroup, jobName, name)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Stream Analytics Output SQL still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMStreamAnalyticsOutputSql_basic(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsJob_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestserver-%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "acctestadmin"
+  administrator_login_password = "t2RX8A76GrnE4EKC"
+}
+
+resource "azurerm_sql_database" "test" {
+  name                             = "acctestdb"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  server_name                      = "${azurerm_sql_server.test.name}"
+  requested_service_objective_name = "S0"
+  collation                        = "SQL_LATIN1_GENERAL_CP1_CI_AS"
+  max_size_bytes                   = "268435456000"
+  create_mode                      = "Default"
+}
+
+resource "azurerm_stream_analytics_output_mssql" "test" {
+  name                      = "acctestoutput-%d"
+  stream_analytics_job_name = "${azurerm_stream_analytics_job.test.name}"
+  resource_group_name       = "${azurerm_stream_analytics_job.test.resource_group_name}"
+  server   = "${azurerm_sql_server.test.fully_qualified_domain_name}"
+  user     = "${azurerm_sql_server.test.administrator_login}"
+  password = "${azurerm_sql_server.test.administrator_login_password}"
+  database = "${azurerm_sql_database.test.name}"
+  table    = "AccTestTable"
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMStreamAnalyticsOutputSql_updated(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsJob_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_server" "test" {
+  name                         = "acctestserver-%d"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  location                     = "${azurerm_resource_group.test.location}"
+  version                      = "12.0"
+  administrator_login          = "acctestadmin"
+  administrator_login_password = "t2RX8A76GrnE4EKC"
+}
+
+resource "azurerm_sql_database" "test" {
+  name                             = "acctestdb"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  location                         = "${azurerm_resource_group.test.location}"
+  server_name                      = "${azurerm_sql_server.test.name}"
+  requested_service_objective_name = "S0"
+  collation                        = "SQL_LATIN1_GENERAL_CP1_CI_AS"
+  max_size_bytes                   = "268435456000"
+  create_mode                      = "Default"
+}
+
+resource "azurerm_stream_analytics_output_mssql" "test" {
+  name                      = "acctestoutput-%d"
+  stream_analytics_job_name = "${azurerm_stream_analytics_job.test.name}"
+  resource_group_name       = "${azurerm_stream_analytics_job.test.resource_group_name}"
+  server   = "${azurerm_sql_server.test.fully_qualified_domain_name}"
+  user     = "${azurerm_sql_server.test.administrator_login}"
+  password = "${azurerm_sql_server.test.administrator_login_password}"
+  database = "${azurerm_sql_database.test.name}"
+  table    = "AccTestTableUpdated"
+}
+`, template, rInt, rInt)
+}
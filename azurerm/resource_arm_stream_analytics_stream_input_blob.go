@@ -0,0 +1,205 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/streamanalytics"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStreamAnalyticsStreamInputBlob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStreamAnalyticsStreamInputBlobCreateUpdate,
+		Read:   resourceArmStreamAnalyticsStreamInputBlobRead,
+		Update: resourceArmStreamAnalyticsStreamInputBlobCreateUpdate,
+		Delete: resourceArmStreamAnalyticsStreamInputBlobDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("streamingjobs", "inputs"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"stream_analytics_job_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"date_format": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"path_pattern": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"storage_account_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+
+			"storage_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"storage_container_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"time_format": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"serialization": azureRmStreamAnalyticsSerializationSchema(),
+		},
+	}
+}
+
+func resourceArmStreamAnalyticsStreamInputBlobCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsInputsClient
+	log.Printf("[INFO] preparing arguments for Azure Stream Analytics Stream Input Blob creation.")
+
+	name := d.Get("name").(string)
+	jobName := d.Get("stream_analytics_job_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	serialization, err := expandStreamAnalyticsSerialization(d.Get("serialization").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("Error expanding `serialization`: %+v", err)
+	}
+
+	props := streamanalytics.Input{
+		Name: utils.String(name),
+		Properties: &streamanalytics.StreamInputProperties{
+			Type: streamanalytics.TypeStream,
+			Datasource: &streamanalytics.BlobStreamInputDataSource{
+				Type: streamanalytics.TypeStreamInputDataSourceTypeMicrosoftStorageBlob,
+				BlobStreamInputDataSourceProperties: &streamanalytics.BlobStreamInputDataSourceProperties{
+					Container:   utils.String(d.Get("storage_container_name").(string)),
+					DateFormat:  utils.String(d.Get("date_format").(string)),
+					PathPattern: utils.String(d.Get("path_pattern").(string)),
+					TimeFormat:  utils.String(d.Get("time_format").(string)),
+					StorageAccounts: &[]streamanalytics.StorageAccount{
+						{
+							AccountName: utils.String(d.Get("storage_account_name").(string)),
+							AccountKey:  utils.String(d.Get("storage_account_key").(string)),
+						},
+					},
+				},
+			},
+			Serialization: serialization,
+		},
+	}
+
+	if _, err := client.CreateOrReplace(props, resourceGroup, jobName, name, "", ""); err != nil {
+		return fmt.Errorf("Error creating/updating Stream Analytics Stream Input Blob %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+
+	read, err := client.Get(resourceGroup, jobName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Stream Analytics Stream Input Blob %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Stream Analytics Stream Input Blob %q (Job %q / Resource Group %q)", name, jobName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmStreamAnalyticsStreamInputBlobRead(d, meta)
+}
+
+func resourceArmStreamAnalyticsStreamInputBlobRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsInputsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+	name := id.Path["inputs"]
+
+	resp, err := client.Get(resourceGroup, jobName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Stream Analytics Stream Input Blob %q was not found in Job %q / Resource Group %q - removing from state!", name, jobName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Stream Analytics Stream Input Blob %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("stream_analytics_job_name", jobName)
+
+	if props := resp.Properties; props != nil {
+		v, ok := props.AsStreamInputProperties()
+		if !ok {
+			return fmt.Errorf("Error converting Stream Input Blob %q to a Stream Input", name)
+		}
+
+		if ds := v.Datasource; ds != nil {
+			blob, ok := ds.AsBlobStreamInputDataSource()
+			if !ok {
+				return fmt.Errorf("Error converting Stream Input Blob %q to a Blob Stream Input", name)
+			}
+
+			if blobProps := blob.BlobStreamInputDataSourceProperties; blobProps != nil {
+				d.Set("date_format", blobProps.DateFormat)
+				d.Set("path_pattern", blobProps.PathPattern)
+				d.Set("storage_container_name", blobProps.Container)
+				d.Set("time_format", blobProps.TimeFormat)
+
+				// the Account Key isn't returned from the API
+				if accounts := blobProps.StorageAccounts; accounts != nil && len(*accounts) > 0 {
+					account := (*accounts)[0]
+					d.Set("storage_account_name", account.AccountName)
+				}
+			}
+		}
+
+		if err := d.Set("serialization", flattenStreamAnalyticsSerialization(v.Serialization, false)); err != nil {
+			return fmt.Errorf("Error setting `serialization`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStreamAnalyticsStreamInputBlobDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsInputsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+	name := id.Path["inputs"]
+
+	resp, err := client.Delete(resourceGroup, jobName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Stream Analytics Stream Input Blob %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/streamanalytics"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStreamAnalyticsStreamInputEventHub() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStreamAnalyticsStreamInputEventHubCreateUpdate,
+		Read:   resourceArmStreamAnalyticsStreamInputEventHubRead,
+		Update: resourceArmStreamAnalyticsStreamInputEventHubCreateUpdate,
+		Delete: resourceArmStreamAnalyticsStreamInputEventHubDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("streamingjobs", "inputs"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"stream_analytics_job_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"eventhub_consumer_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"eventhub_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"servicebus_namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"shared_access_policy_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+
+			"shared_access_policy_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"serialization": azureRmStreamAnalyticsSerializationSchema(),
+		},
+	}
+}
+
+func resourceArmStreamAnalyticsStreamInputEventHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsInputsClient
+	log.Printf("[INFO] preparing arguments for Azure Stream Analytics Stream Input EventHub creation.")
+
+	name := d.Get("name").(string)
+	jobName := d.Get("stream_analytics_job_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	serialization, err := expandStreamAnalyticsSerialization(d.Get("serialization").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("Error expanding `serialization`: %+v", err)
+	}
+
+	props := streamanalytics.Input{
+		Name: utils.String(name),
+		Properties: &streamanalytics.StreamInputProperties{
+			Type: streamanalytics.TypeStream,
+			Datasource: &streamanalytics.EventHubStreamInputDataSource{
+				Type: streamanalytics.TypeStreamInputDataSourceTypeMicrosoftServiceBusEventHub,
+				EventHubStreamInputDataSourceProperties: &streamanalytics.EventHubStreamInputDataSourceProperties{
+					EventHubName:           utils.String(d.Get("eventhub_name").(string)),
+					ServiceBusNamespace:    utils.String(d.Get("servicebus_namespace").(string)),
+					SharedAccessPolicyKey:  utils.String(d.Get("shared_access_policy_key").(string)),
+					SharedAccessPolicyName: utils.String(d.Get("shared_access_policy_name").(string)),
+					ConsumerGroupName:      utils.String(d.Get("eventhub_consumer_group_name").(string)),
+				},
+			},
+			Serialization: serialization,
+		},
+	}
+
+	if _, err := client.CreateOrReplace(props, resourceGroup, jobName, name, "", ""); err != nil {
+		return fmt.Errorf("Error creating/updating Stream Analytics Stream Input EventHub %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+
+	read, err := client.Get(resourceGroup, jobName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Stream Analytics Stream Input EventHub %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Stream Analytics Stream Input EventHub %q (Job %q / Resource Group %q)", name, jobName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmStreamAnalyticsStreamInputEventHubRead(d, meta)
+}
+
+func resourceArmStreamAnalyticsStreamInputEventHubRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsInputsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+	name := id.Path["inputs"]
+
+	resp, err := client.Get(resourceGroup, jobName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Stream Analytics Stream Input EventHub %q was not found in Job %q / Resource Group %q - removing from state!", name, jobName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Stream Analytics Stream Input EventHub %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("stream_analytics_job_name", jobName)
+
+	if props := resp.Properties; props != nil {
+		v, ok := props.AsStreamInputProperties()
+		if !ok {
+			return fmt.Errorf("Error converting Stream Input EventHub %q to a Stream Input", name)
+		}
+
+		if ds := v.Datasource; ds != nil {
+			eventHub, ok := ds.AsEventHubStreamInputDataSource()
+			if !ok {
+				return fmt.Errorf("Error converting Stream Input EventHub %q to an EventHub Stream Input", name)
+			}
+
+			if eventHubProps := eventHub.EventHubStreamInputDataSourceProperties; eventHubProps != nil {
+				d.Set("eventhub_consumer_group_name", eventHubProps.ConsumerGroupName)
+				d.Set("eventhub_name", eventHubProps.EventHubName)
+				d.Set("servicebus_namespace", eventHubProps.ServiceBusNamespace)
+				d.Set("shared_access_policy_name", eventHubProps.SharedAccessPolicyName)
+			}
+		}
+
+		if err := d.Set("serialization", flattenStreamAnalyticsSerialization(v.Serialization, false)); err != nil {
+			return fmt.Errorf("Error setting `serialization`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStreamAnalyticsStreamInputEventHubDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsInputsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+	name := id.Path["inputs"]
+
+	resp, err := client.Delete(resourceGroup, jobName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Stream Analytics Stream Input EventHub %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/arm/streamanalytics"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStreamAnalyticsStreamInputIoTHub() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStreamAnalyticsStreamInputIoTHubCreateUpdate,
+		Read:   resourceArmStreamAnalyticsStreamInputIoTHubRead,
+		Update: resourceArmStreamAnalyticsStreamInputIoTHubCreateUpdate,
+		Delete: resourceArmStreamAnalyticsStreamInputIoTHubDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAzureResourceID("streamingjobs", "inputs"),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"stream_analytics_job_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"eventhub_consumer_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"endpoint": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"iothub_namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"shared_access_policy_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+
+			"shared_access_policy_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"serialization": azureRmStreamAnalyticsSerializationSchema(),
+		},
+	}
+}
+
+func resourceArmStreamAnalyticsStreamInputIoTHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsInputsClient
+	log.Printf("[INFO] preparing arguments for Azure Stream Analytics Stream Input IoTHub creation.")
+
+	name := d.Get("name").(string)
+	jobName := d.Get("stream_analytics_job_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	serialization, err := expandStreamAnalyticsSerialization(d.Get("serialization").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("Error expanding `serialization`: %+v", err)
+	}
+
+	props := streamanalytics.Input{
+		Name: utils.String(name),
+		Properties: &streamanalytics.StreamInputProperties{
+			Type: streamanalytics.TypeStream,
+			Datasource: &streamanalytics.IoTHubStreamInputDataSource{
+				Type: streamanalytics.TypeStreamInputDataSourceTypeMicrosoftDevicesIotHubs,
+				IoTHubStreamInputDataSourceProperties: &streamanalytics.IoTHubStreamInputDataSourceProperties{
+					ConsumerGroupName:      utils.String(d.Get("eventhub_consumer_group_name").(string)),
+					SharedAccessPolicyKey:  utils.String(d.Get("shared_access_policy_key").(string)),
+					SharedAccessPolicyName: utils.String(d.Get("shared_access_policy_name").(string)),
+					IotHubNamespace:        utils.String(d.Get("iothub_namespace").(string)),
+					Endpoint:               utils.String(d.Get("endpoint").(string)),
+				},
+			},
+			Serialization: serialization,
+		},
+	}
+
+	if _, err := client.CreateOrReplace(props, resourceGroup, jobName, name, "", ""); err != nil {
+		return fmt.Errorf("Error creating/updating Stream Analytics Stream Input IoTHub %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+
+	read, err := client.Get(resourceGroup, jobName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Stream Analytics Stream Input IoTHub %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID of Stream Analytics Stream Input IoTHub %q (Job %q / Resource Group %q)", name, jobName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmStreamAnalyticsStreamInputIoTHubRead(d, meta)
+}
+
+func resourceArmStreamAnalyticsStreamInputIoTHubRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsInputsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+	name := id.Path["inputs"]
+
+	resp, err := client.Get(resourceGroup, jobName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Stream Analytics Stream Input IoTHub %q was not found in Job %q / Resource Group %q - removing from state!", name, jobName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Stream Analytics Stream Input IoTHub %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("stream_analytics_job_name", jobName)
+
+	if props := resp.Properties; props != nil {
+		v, ok := props.AsStreamInputProperties()
+		if !ok {
+			return fmt.Errorf("Error converting Stream Input IoTHub %q to a Stream Input", name)
+		}
+
+		if ds := v.Datasource; ds != nil {
+			iotHub, ok := ds.AsIoTHubStreamInputDataSource()
+			if !ok {
+				return fmt.Errorf("Error converting Stream Input IoTHub %q to an IoTHub Stream Input", name)
+			}
+
+			if iotHubProps := iotHub.IoTHubStreamInputDataSourceProperties; iotHubProps != nil {
+				d.Set("eventhub_consumer_group_name", iotHubProps.ConsumerGroupName)
+				d.Set("endpoint", iotHubProps.Endpoint)
+				d.Set("iothub_namespace", iotHubProps.IotHubNamespace)
+				d.Set("shared_access_policy_name", iotHubProps.SharedAccessPolicyName)
+			}
+		}
+
+		if err := d.Set("serialization", flattenStreamAnalyticsSerialization(v.Serialization, false)); err != nil {
+			return fmt.Errorf("Error setting `serialization`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStreamAnalyticsStreamInputIoTHubDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamAnalyticsInputsClient
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+	name := id.Path["inputs"]
+
+	resp, err := client.Delete(resourceGroup, jobName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Stream Analytics Stream Input IoTHub %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
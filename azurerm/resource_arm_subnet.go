@@ -18,7 +18,7 @@ func resourceArmSubnet() *schema.Resource {
 		Update: resourceArmSubnetCreate,
 		Delete: resourceArmSubnetDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("virtualNetworks", "subnets"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -114,7 +114,9 @@ func resourceArmSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		SubnetPropertiesFormat: &properties,
 	}
 
-	_, createErr := client.CreateOrUpdate(resGroup, vnetName, name, subnet, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, createErr := client.CreateOrUpdate(resGroup, vnetName, name, subnet, cancel)
 	err := <-createErr
 	if err != nil {
 		return err
@@ -217,7 +219,9 @@ func resourceArmSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	azureRMLockByName(name, subnetResourceName)
 	defer azureRMUnlockByName(name, subnetResourceName)
 
-	_, deleteErr := client.Delete(resGroup, vnetName, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	_, deleteErr := client.Delete(resGroup, vnetName, name, cancel)
 	err = <-deleteErr
 
 	return err
@@ -18,7 +18,7 @@ func resourceArmTrafficManagerEndpoint() *schema.Resource {
 		Update: resourceArmTrafficManagerEndpointCreate,
 		Delete: resourceArmTrafficManagerEndpointDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("trafficManagerProfiles"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -20,7 +20,7 @@ func resourceArmTrafficManagerProfile() *schema.Resource {
 		Update: resourceArmTrafficManagerProfileCreate,
 		Delete: resourceArmTrafficManagerProfileDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("trafficManagerProfiles"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -126,7 +126,7 @@ func resourceArmTrafficManagerProfileCreate(d *schema.ResourceData, meta interfa
 		Name:              &name,
 		Location:          &location,
 		ProfileProperties: getArmTrafficManagerProfileProperties(d),
-		Tags:              expandTags(tags),
+		Tags:              expandResourceTags(d, tags, meta),
 	}
 
 	_, err := client.CreateOrUpdate(resGroup, name, profile)
@@ -183,7 +183,7 @@ func resourceArmTrafficManagerProfileRead(d *schema.ResourceData, meta interface
 	monitorFlat := flattenAzureRMTrafficManagerProfileMonitorConfig(profile.MonitorConfig)
 	d.Set("monitor_config", schema.NewSet(resourceAzureRMTrafficManagerMonitorConfigHash, monitorFlat))
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -21,7 +21,7 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 		Update: resourceArmVirtualMachineScaleSetCreate,
 		Delete: resourceArmVirtualMachineScaleSetDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("virtualMachineScaleSets"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -566,7 +566,7 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 	scaleSetParams := compute.VirtualMachineScaleSet{
 		Name:     &name,
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandResourceTags(d, tags, meta),
 		Sku:      sku,
 		VirtualMachineScaleSetProperties: &scaleSetProps,
 	}
@@ -580,7 +580,9 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 		scaleSetParams.Plan = plan
 	}
 
-	_, vmError := vmScaleSetClient.CreateOrUpdate(resGroup, name, scaleSetParams, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, vmError := vmScaleSetClient.CreateOrUpdate(resGroup, name, scaleSetParams, cancel)
 	vmErr := <-vmError
 	if vmErr != nil {
 		return vmErr
@@ -694,7 +696,7 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -709,7 +711,9 @@ func resourceArmVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interf
 	resGroup := id.ResourceGroup
 	name := id.Path["virtualMachineScaleSets"]
 
-	_, error := vmScaleSetClient.Delete(resGroup, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	_, error := vmScaleSetClient.Delete(resGroup, name, cancel)
 	err = <-error
 
 	return err
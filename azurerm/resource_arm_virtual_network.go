@@ -21,7 +21,7 @@ func resourceArmVirtualNetwork() *schema.Resource {
 		Update: resourceArmVirtualNetworkCreate,
 		Delete: resourceArmVirtualNetworkDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("virtualNetworks"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -98,7 +98,7 @@ func resourceArmVirtualNetworkCreate(d *schema.ResourceData, meta interface{}) e
 		Name:                           &name,
 		Location:                       &location,
 		VirtualNetworkPropertiesFormat: vnetProperties,
-		Tags: expandTags(tags),
+		Tags: expandResourceTags(d, tags, meta),
 	}
 
 	networkSecurityGroupNames := make([]string, 0)
@@ -116,7 +116,9 @@ func resourceArmVirtualNetworkCreate(d *schema.ResourceData, meta interface{}) e
 	azureRMLockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 	defer azureRMUnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 
-	_, error := vnetClient.CreateOrUpdate(resGroup, name, vnet, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, error := vnetClient.CreateOrUpdate(resGroup, name, vnet, cancel)
 	err := <-error
 	if err != nil {
 		return err
@@ -187,7 +189,7 @@ func resourceArmVirtualNetworkRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("dns_servers", dnses)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
@@ -210,7 +212,9 @@ func resourceArmVirtualNetworkDelete(d *schema.ResourceData, meta interface{}) e
 	azureRMLockMultipleByName(&nsgNames, virtualNetworkResourceName)
 	defer azureRMUnlockMultipleByName(&nsgNames, virtualNetworkResourceName)
 
-	_, error := vnetClient.Delete(resGroup, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	_, error := vnetClient.Delete(resGroup, name, cancel)
 	err = <-error
 
 	return err
@@ -21,7 +21,7 @@ func resourceArmVirtualNetworkPeering() *schema.Resource {
 		Update: resourceArmVirtualNetworkPeeringCreate,
 		Delete: resourceArmVirtualNetworkPeeringDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importAzureResourceID("virtualNetworks", "virtualNetworkPeerings"),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -89,7 +89,9 @@ func resourceArmVirtualNetworkPeeringCreate(d *schema.ResourceData, meta interfa
 	peerMutex.Lock()
 	defer peerMutex.Unlock()
 
-	_, error := client.CreateOrUpdate(resGroup, vnetName, name, peer, make(chan struct{}))
+	cancel, release := cancelAfter(meta, createUpdateTimeout(d))
+	defer release()
+	_, error := client.CreateOrUpdate(resGroup, vnetName, name, peer, cancel)
 	err := <-error
 	if err != nil {
 		return err
@@ -157,7 +159,9 @@ func resourceArmVirtualNetworkPeeringDelete(d *schema.ResourceData, meta interfa
 	peerMutex.Lock()
 	defer peerMutex.Unlock()
 
-	_, error := client.Delete(resGroup, vnetName, name, make(chan struct{}))
+	cancel, release := cancelAfter(meta, d.Timeout(schema.TimeoutDelete))
+	defer release()
+	_, error := client.Delete(resGroup, vnetName, name, cancel)
 	err = <-error
 
 	return err
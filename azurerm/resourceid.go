@@ -5,6 +5,8 @@ import (
 	"net/url"
 	"sort"
 	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 // ResourceID represents a parsed long-form Azure Resource Manager ID
@@ -135,6 +137,60 @@ func composeAzureResourceID(idObj *ResourceID) (id string, err error) {
 	return
 }
 
+// importAzureResourceID returns a StateFunc which validates the ID being imported is an Azure
+// Resource ID containing each of the specified segments (e.g. `virtualNetworks` and `subnets`),
+// so that an incorrect ID is caught at import time rather than when the resource is next read.
+func importAzureResourceID(segments ...string) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if err := validateAzureResourceIDSegments(d.Id(), segments...); err != nil {
+			return nil, fmt.Errorf("Error importing %q: %+v", d.Id(), err)
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// validateAzureResourceIDSegments validates that the specified ID can be parsed as an Azure Resource ID
+// and that it contains a value for each of the specified segments - which are case-sensitive.
+func validateAzureResourceIDSegments(input string, segments ...string) error {
+	expected := expectedAzureResourceIDFormat(segments...)
+
+	id, err := parseAzureResourceID(input)
+	if err != nil {
+		return fmt.Errorf("%+v - expected an ID in the format %q", err, expected)
+	}
+
+	for _, segment := range segments {
+		if v, ok := id.Path[segment]; ok && v != "" {
+			continue
+		}
+
+		for key := range id.Path {
+			if strings.EqualFold(key, segment) {
+				return fmt.Errorf("the segment %q should be %q (segment names are case-sensitive) - expected an ID in the format %q", key, segment, expected)
+			}
+		}
+
+		return fmt.Errorf("the segment %q was not found - expected an ID in the format %q", segment, expected)
+	}
+
+	return nil
+}
+
+func expectedAzureResourceIDFormat(segments ...string) string {
+	format := "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}"
+	if len(segments) == 0 {
+		return format
+	}
+
+	format += "/providers/{resourceProvider}"
+	for _, segment := range segments {
+		format += fmt.Sprintf("/%s/{name}", segment)
+	}
+
+	return format
+}
+
 func parseNetworkSecurityGroupName(networkSecurityGroupId string) (string, error) {
 	id, err := parseAzureResourceID(networkSecurityGroupId)
 	if err != nil {
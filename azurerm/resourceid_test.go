@@ -233,3 +233,91 @@ func TestComposeAzureResourceID(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateAzureResourceIDSegments(t *testing.T) {
+	cases := []struct {
+		ID       string
+		Segments []string
+		Error    bool
+	}{
+		{
+			// not an ID
+			ID:       "hello-world",
+			Segments: []string{"virtualNetworks"},
+			Error:    true,
+		},
+		{
+			// no resource group
+			ID:       "/subscriptions/00000000-0000-0000-0000-000000000000",
+			Segments: []string{},
+			Error:    true,
+		},
+		{
+			ID:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			Segments: []string{},
+			Error:    false,
+		},
+		{
+			ID:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network1",
+			Segments: []string{"virtualNetworks"},
+			Error:    false,
+		},
+		{
+			// incorrect casing
+			ID:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualnetworks/network1",
+			Segments: []string{"virtualNetworks"},
+			Error:    true,
+		},
+		{
+			// a Virtual Network ID rather than a Subnet ID
+			ID:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network1",
+			Segments: []string{"virtualNetworks", "subnets"},
+			Error:    true,
+		},
+		{
+			ID:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Segments: []string{"virtualNetworks", "subnets"},
+			Error:    false,
+		},
+		{
+			// a different type of resource
+			ID:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/networkSecurityGroups/group1",
+			Segments: []string{"virtualNetworks"},
+			Error:    true,
+		},
+	}
+
+	for _, v := range cases {
+		err := validateAzureResourceIDSegments(v.ID, v.Segments...)
+		if v.Error && err == nil {
+			t.Fatalf("Expected an error for %q with the segments %+v but didn't get one", v.ID, v.Segments)
+		}
+
+		if !v.Error && err != nil {
+			t.Fatalf("Expected no error for %q with the segments %+v but got: %+v", v.ID, v.Segments, err)
+		}
+	}
+}
+
+func TestExpectedAzureResourceIDFormat(t *testing.T) {
+	cases := []struct {
+		Segments []string
+		Expected string
+	}{
+		{
+			Segments: []string{},
+			Expected: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}",
+		},
+		{
+			Segments: []string{"virtualNetworks", "subnets"},
+			Expected: "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProvider}/virtualNetworks/{name}/subnets/{name}",
+		},
+	}
+
+	for _, v := range cases {
+		actual := expectedAzureResourceIDFormat(v.Segments...)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/arm/streamanalytics"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func azureRmStreamAnalyticsSerializationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(streamanalytics.TypeAvro),
+						string(streamanalytics.TypeCsv),
+						string(streamanalytics.TypeJSON),
+					}, false),
+				},
+
+				"field_delimiter": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						" ",
+						",",
+						"\t",
+						"|",
+						";",
+					}, false),
+				},
+
+				"encoding": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(streamanalytics.UTF8),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func azureRmStreamAnalyticsOutputSerializationSchema() *schema.Schema {
+	s := azureRmStreamAnalyticsSerializationSchema()
+	s.Elem.(*schema.Resource).Schema["format"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(streamanalytics.Array),
+			string(streamanalytics.LineSeparated),
+		}, false),
+	}
+	return s
+}
+
+func expandStreamAnalyticsSerialization(input []interface{}) (streamanalytics.Serialization, error) {
+	v := input[0].(map[string]interface{})
+
+	serializationType := streamanalytics.Type(v["type"].(string))
+	encoding := v["encoding"].(string)
+	fieldDelimiter := v["field_delimiter"].(string)
+	format := ""
+	if raw, ok := v["format"]; ok {
+		format = raw.(string)
+	}
+
+	switch serializationType {
+	case streamanalytics.TypeAvro:
+		if encoding != "" {
+			return nil, fmt.Errorf("`encoding` cannot be set when `type` is set to `Avro`")
+		}
+		if fieldDelimiter != "" {
+			return nil, fmt.Errorf("`field_delimiter` cannot be set when `type` is set to `Avro`")
+		}
+		if format != "" {
+			return nil, fmt.Errorf("`format` cannot be set when `type` is set to `Avro`")
+		}
+		return streamanalytics.AvroSerialization{
+			Type:       streamanalytics.TypeAvro,
+			Properties: &map[string]interface{}{},
+		}, nil
+
+	case streamanalytics.TypeCsv:
+		if encoding == "" {
+			return nil, fmt.Errorf("`encoding` must be specified when `type` is set to `Csv`")
+		}
+		if fieldDelimiter == "" {
+			return nil, fmt.Errorf("`field_delimiter` must be set when `type` is set to `Csv`")
+		}
+		if format != "" {
+			return nil, fmt.Errorf("`format` cannot be set when `type` is set to `Csv`")
+		}
+		return streamanalytics.CsvSerialization{
+			Type: streamanalytics.TypeCsv,
+			CsvSerializationProperties: &streamanalytics.CsvSerializationProperties{
+				Encoding:       streamanalytics.Encoding(encoding),
+				FieldDelimiter: utils.String(fieldDelimiter),
+			},
+		}, nil
+
+	case streamanalytics.TypeJSON:
+		if encoding == "" {
+			return nil, fmt.Errorf("`encoding` must be specified when `type` is set to `Json`")
+		}
+		if fieldDelimiter != "" {
+			return nil, fmt.Errorf("`field_delimiter` cannot be set when `type` is set to `Json`")
+		}
+		return streamanalytics.JSONSerialization{
+			Type: streamanalytics.TypeJSON,
+			JSONSerializationProperties: &streamanalytics.JSONSerializationProperties{
+				Encoding: streamanalytics.Encoding(encoding),
+				Format:   streamanalytics.JSONOutputSerializationFormat(format),
+			},
+		}, nil
+	}
+
+	return nil, fmt.Errorf("Unsupported Serialization Type %q", serializationType)
+}
+
+func flattenStreamAnalyticsSerialization(input streamanalytics.Serialization, includeFormat bool) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	var encoding string
+	var fieldDelimiter string
+	var format string
+	var inputType string
+
+	if _, ok := input.AsAvroSerialization(); ok {
+		inputType = string(streamanalytics.TypeAvro)
+	}
+
+	if v, ok := input.AsCsvSerialization(); ok {
+		if props := v.CsvSerializationProperties; props != nil {
+			encoding = string(props.Encoding)
+			if props.FieldDelimiter != nil {
+				fieldDelimiter = *props.FieldDelimiter
+			}
+		}
+
+		inputType = string(streamanalytics.TypeCsv)
+	}
+
+	if v, ok := input.AsJSONSerialization(); ok {
+		if props := v.JSONSerializationProperties; props != nil {
+			encoding = string(props.Encoding)
+			format = string(props.Format)
+		}
+
+		inputType = string(streamanalytics.TypeJSON)
+	}
+
+	output := map[string]interface{}{
+		"encoding":        encoding,
+		"type":            inputType,
+		"field_delimiter": fieldDelimiter,
+	}
+
+	// `format` is only applicable to the Serialization of Outputs
+	if includeFormat {
+		output["format"] = format
+	}
+
+	return []interface{}{output}
+}
+
+// streamAnalyticsJobIsRunning returns whether the given Job State indicates that the Stream Analytics Job
+// is currently processing events (or is transitioning into doing so)
+func streamAnalyticsJobIsRunning(jobState *string) bool {
+	if jobState == nil {
+		return false
+	}
+
+	state := strings.ToLower(*jobState)
+	return state == "running" || state == "starting" || state == "degraded"
+}
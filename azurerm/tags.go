@@ -1,8 +1,10 @@
 package azurerm
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -93,3 +95,222 @@ func flattenAndSetTags(d *schema.ResourceData, tagsMap *map[string]*string) {
 
 	d.Set("tags", output)
 }
+
+// ignoreTags contains the tags which are managed outside of Terraform (for example by
+// Azure Policy) and which should be ignored when reading resources.
+type ignoreTags struct {
+	Keys        []string
+	KeyPrefixes []string
+}
+
+func (i ignoreTags) ignored(key string) bool {
+	for _, k := range i.Keys {
+		if k == key {
+			return true
+		}
+	}
+
+	for _, prefix := range i.KeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func expandIgnoreTags(input []interface{}) ignoreTags {
+	output := ignoreTags{}
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	v := input[0].(map[string]interface{})
+	for _, key := range v["keys"].(*schema.Set).List() {
+		output.Keys = append(output.Keys, key.(string))
+	}
+
+	for _, prefix := range v["key_prefixes"].(*schema.Set).List() {
+		output.KeyPrefixes = append(output.KeyPrefixes, prefix.(string))
+	}
+
+	return output
+}
+
+// mergeDefaultTags returns the tags which should be sent for a resource - that is the
+// provider's `default_tags` along with the resource's `tags`, which take precedence.
+func mergeDefaultTags(defaultTags map[string]string, configuredTags map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(defaultTags)+len(configuredTags))
+
+	for k, v := range defaultTags {
+		output[k] = v
+	}
+
+	for k, v := range configuredTags {
+		output[k] = v
+	}
+
+	return output
+}
+
+// mergeIgnoredTags returns the tags which should be sent for a resource along with any ignored tags
+// from `existingTags` - since these are managed outside of Terraform, the tags in `tags` take precedence.
+func mergeIgnoredTags(tags map[string]interface{}, existingTags map[string]interface{}, ignore ignoreTags) map[string]interface{} {
+	output := make(map[string]interface{}, len(tags)+len(existingTags))
+
+	for k, v := range existingTags {
+		if ignore.ignored(k) {
+			output[k] = v
+		}
+	}
+
+	for k, v := range tags {
+		output[k] = v
+	}
+
+	return output
+}
+
+// filterProviderTags removes any tags which come from the provider's `default_tags` (unless they're
+// also configured on the resource) or are ignored via `ignore_tags`, so they don't show as a diff.
+func filterProviderTags(tags map[string]interface{}, configuredTags map[string]interface{}, defaultTags map[string]string, ignore ignoreTags) map[string]interface{} {
+	output := make(map[string]interface{}, len(tags))
+
+	for k, v := range tags {
+		if _, configured := configuredTags[k]; !configured {
+			if _, isDefault := defaultTags[k]; isDefault {
+				continue
+			}
+		}
+
+		if ignore.ignored(k) {
+			continue
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
+
+// setResourceTagsSchema adds the `tags_all` and `default_tags_applied` attributes to every resource which
+// supports tags. `tags_all` contains every tag assigned to the resource when it's read, including those
+// which are ignored - so that these can be sent when it's updated.
+// `default_tags_applied` is set to the provider's `default_tags` which are assigned to the resource when it's
+// read, and defaults to the current `default_tags` - so that changing these shows a diff (and updates the resource).
+func setResourceTagsSchema(p *schema.Provider) {
+	for _, resource := range p.ResourcesMap {
+		tags, ok := resource.Schema["tags"]
+		if !ok || tags.Type != schema.TypeMap {
+			continue
+		}
+
+		resource.Schema["tags_all"] = &schema.Schema{
+			Type:     schema.TypeMap,
+			Computed: true,
+		}
+
+		resource.Schema["default_tags_applied"] = &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: tags.ForceNew || resource.Update == nil,
+			DefaultFunc: func() (interface{}, error) {
+				client, ok := p.Meta().(*ArmClient)
+				if !ok {
+					return nil, nil
+				}
+
+				return flattenDefaultTags(expectedDefaultTags(client.defaultTags, client.ignoreTags)), nil
+			},
+			ValidateFunc: func(v interface{}, k string) (ws []string, es []error) {
+				client, ok := p.Meta().(*ArmClient)
+				if !ok {
+					return
+				}
+
+				if v.(string) != flattenDefaultTags(expectedDefaultTags(client.defaultTags, client.ignoreTags)) {
+					es = append(es, fmt.Errorf("%q can't be set, since it's managed through the provider's `default_tags`", k))
+				}
+				return
+			},
+		}
+	}
+}
+
+// expectedDefaultTags returns the provider's `default_tags` which should be assigned to every resource
+func expectedDefaultTags(defaultTags map[string]string, ignore ignoreTags) map[string]string {
+	output := make(map[string]string, len(defaultTags))
+
+	for k, v := range defaultTags {
+		if ignore.ignored(k) {
+			continue
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
+
+// appliedDefaultTags returns the provider's `default_tags` which are assigned to a resource - a tag
+// which is configured on the resource takes precedence, and so counts as having been assigned.
+func appliedDefaultTags(tags map[string]interface{}, configuredTags map[string]interface{}, defaultTags map[string]string, ignore ignoreTags) map[string]string {
+	output := make(map[string]string, len(defaultTags))
+
+	for k, v := range expectedDefaultTags(defaultTags, ignore) {
+		if _, configured := configuredTags[k]; configured {
+			output[k] = v
+			continue
+		}
+
+		if value, ok := tags[k]; ok && value == v {
+			output[k] = v
+		}
+	}
+
+	return output
+}
+
+// flattenDefaultTags flattens the `default_tags` into the value of the `default_tags_applied` attribute
+func flattenDefaultTags(input map[string]string) string {
+	// the keys of a map are sorted when marshalled, so this is stable - and since an empty
+	// value would be treated as unset (rather than removing the `default_tags`) this is `{}`
+	output, _ := json.Marshal(input)
+	return string(output)
+}
+
+// expandResourceTags expands the tags for a resource, including the provider's `default_tags` - and
+// any ignored tags which are assigned to the resource, so that these aren't removed when it's updated
+func expandResourceTags(d *schema.ResourceData, tagsMap map[string]interface{}, meta interface{}) *map[string]*string {
+	client := meta.(*ArmClient)
+	tags := mergeDefaultTags(client.defaultTags, tagsMap)
+
+	// these are the tags assigned to the resource when it was last read
+	existingTags := d.Get("tags_all").(map[string]interface{})
+	tags = mergeIgnoredTags(tags, existingTags, client.ignoreTags)
+
+	return expandTags(tags)
+}
+
+// flattenAndSetResourceTags sets the tags for a resource, excluding any tags which are
+// managed by the provider (through `default_tags`) or are ignored (through `ignore_tags`)
+func flattenAndSetResourceTags(d *schema.ResourceData, tagsMap *map[string]*string, meta interface{}) {
+	client := meta.(*ArmClient)
+
+	tags := make(map[string]interface{})
+	if tagsMap != nil {
+		for k, v := range *tagsMap {
+			tags[k] = *v
+		}
+	}
+
+	// these are the tags defined in the configuration, or in the state when refreshing
+	configuredTags := d.Get("tags").(map[string]interface{})
+	output := filterProviderTags(tags, configuredTags, client.defaultTags, client.ignoreTags)
+	applied := appliedDefaultTags(tags, configuredTags, client.defaultTags, client.ignoreTags)
+
+	d.Set("tags", output)
+	d.Set("tags_all", tags)
+	d.Set("default_tags_applied", flattenDefaultTags(applied))
+}
@@ -2,8 +2,15 @@ package azurerm
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestValidateMaximumNumberOfARMTags(t *testing.T) {
@@ -91,7 +98,256 @@ func TestExpandARMTags(t *testing.T) {
 		}
 
 		if *expanded[k] != strVal {
-			t.Fatalf("Expanded value %q incorrect: expected %q, got %q", k, strVal, expanded[k])
+			t.Fatalf("Expanded value %q incorrect: expected %q, got %q", k, strVal, *expanded[k])
 		}
 	}
 }
+
+func TestMergeDefaultTags(t *testing.T) {
+	defaultTags := map[string]string{
+		"environment": "production",
+		"cost-center": "123",
+	}
+	configuredTags := map[string]interface{}{
+		"environment": "staging",
+		"owner":       "team",
+	}
+
+	expected := map[string]interface{}{
+		"environment": "staging",
+		"cost-center": "123",
+		"owner":       "team",
+	}
+
+	actual := mergeDefaultTags(defaultTags, configuredTags)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestFilterProviderTags(t *testing.T) {
+	defaultTags := map[string]string{
+		"environment": "production",
+		"cost-center": "123",
+	}
+	ignore := ignoreTags{
+		Keys:        []string{"created-by"},
+		KeyPrefixes: []string{"policy-"},
+	}
+	tags := map[string]interface{}{
+		"environment":  "staging",
+		"cost-center":  "123",
+		"owner":        "team",
+		"created-by":   "someone",
+		"policy-audit": "true",
+	}
+	configuredTags := map[string]interface{}{
+		"environment": "staging",
+		"owner":       "team",
+	}
+
+	expected := map[string]interface{}{
+		"environment": "staging",
+		"owner":       "team",
+	}
+
+	actual := filterProviderTags(tags, configuredTags, defaultTags, ignore)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestMergeIgnoredTags(t *testing.T) {
+	ignore := ignoreTags{
+		Keys:        []string{"created-by"},
+		KeyPrefixes: []string{"policy-"},
+	}
+	tags := map[string]interface{}{
+		"environment": "production",
+		"created-by":  "terraform",
+	}
+	existingTags := map[string]interface{}{
+		"environment":  "staging",
+		"owner":        "team",
+		"created-by":   "someone",
+		"policy-audit": "true",
+	}
+
+	expected := map[string]interface{}{
+		"environment":  "production",
+		"created-by":   "terraform",
+		"policy-audit": "true",
+	}
+
+	actual := mergeIgnoredTags(tags, existingTags, ignore)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestAppliedDefaultTags(t *testing.T) {
+	defaultTags := map[string]string{
+		"environment": "production",
+		"cost-center": "123",
+		"owner":       "ops",
+		"policy-team": "security",
+	}
+	ignore := ignoreTags{
+		KeyPrefixes: []string{"policy-"},
+	}
+	tags := map[string]interface{}{
+		"environment": "staging",
+		"cost-center": "123",
+		"owner":       "team",
+	}
+	configuredTags := map[string]interface{}{
+		"owner": "team",
+	}
+
+	// the `environment` tag has an outdated value
+	expected := map[string]string{
+		"cost-center": "123",
+		"owner":       "ops",
+	}
+
+	actual := appliedDefaultTags(tags, configuredTags, defaultTags, ignore)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestFlattenDefaultTags(t *testing.T) {
+	cases := []struct {
+		Input    map[string]string
+		Expected string
+	}{
+		{
+			Input:    map[string]string{},
+			Expected: "{}",
+		},
+		{
+			Input: map[string]string{
+				"environment": "production",
+				"cost-center": "123",
+			},
+			Expected: `{"cost-center":"123","environment":"production"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := flattenDefaultTags(tc.Input); actual != tc.Expected {
+			t.Fatalf("Expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
+
+func TestDefaultTagsChangeShowsDiff(t *testing.T) {
+	provider := Provider().(*schema.Provider)
+	resource := provider.ResourcesMap["azurerm_resource_group"]
+	if _, ok := resource.Schema["default_tags_applied"]; !ok {
+		t.Fatalf("Expected `default_tags_applied` to be added to the Resource Group schema")
+	}
+
+	state := &terraform.InstanceState{
+		ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+		Attributes: map[string]string{
+			"name":                 "example",
+			"location":             "westeurope",
+			"tags.%":               "0",
+			"default_tags_applied": `{"environment":"staging"}`,
+		},
+	}
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"name":     "example",
+		"location": "westeurope",
+	})
+	if err != nil {
+		t.Fatalf("Error building config: %+v", err)
+	}
+
+	cases := []struct {
+		DefaultTags  map[string]string
+		ExpectedDiff bool
+	}{
+		{
+			DefaultTags: map[string]string{
+				"environment": "staging",
+			},
+			ExpectedDiff: false,
+		},
+		{
+			DefaultTags: map[string]string{
+				"environment": "production",
+			},
+			ExpectedDiff: true,
+		},
+		{
+			DefaultTags:  map[string]string{},
+			ExpectedDiff: true,
+		},
+	}
+
+	for _, tc := range cases {
+		provider.SetMeta(&ArmClient{
+			defaultTags: tc.DefaultTags,
+		})
+
+		diff, err := resource.Diff(state, terraform.NewResourceConfig(raw))
+		if err != nil {
+			t.Fatalf("Error computing the diff: %+v", err)
+		}
+
+		hasDiff := false
+		if diff != nil {
+			_, hasDiff = diff.Attributes["default_tags_applied"]
+		}
+		if hasDiff != tc.ExpectedDiff {
+			t.Fatalf("Expected a diff to be %t for the default tags %+v but got %t", tc.ExpectedDiff, tc.DefaultTags, hasDiff)
+		}
+	}
+}
+
+func TestExpandAndFlattenResourceTags(t *testing.T) {
+	client := &ArmClient{
+		defaultTags: map[string]string{
+			"environment": "production",
+		},
+		ignoreTags: ignoreTags{
+			KeyPrefixes: []string{"policy-"},
+		},
+	}
+	resourceSchema := map[string]*schema.Schema{
+		"tags": tagsSchema(),
+		"tags_all": {
+			Type:     schema.TypeMap,
+			Computed: true,
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"owner": "team",
+		},
+	})
+
+	expanded := expandResourceTags(d, d.Get("tags").(map[string]interface{}), client)
+	if len(*expanded) != 2 || *(*expanded)["environment"] != "production" || *(*expanded)["owner"] != "team" {
+		t.Fatalf("Expected the default tags to be included but got %s", spew.Sprint(*expanded))
+	}
+
+	// Azure Policy may have added additional tags to the resource
+	(*expanded)["policy-audit"] = utils.String("true")
+	flattenAndSetResourceTags(d, expanded, client)
+
+	expected := map[string]interface{}{
+		"owner": "team",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected the tags to be %+v but got %+v", expected, actual)
+	}
+
+	// the tags added by Azure Policy shouldn't be removed when the resource is updated
+	expanded = expandResourceTags(d, d.Get("tags").(map[string]interface{}), client)
+	if len(*expanded) != 3 || *(*expanded)["policy-audit"] != "true" {
+		t.Fatalf("Expected the ignored tags to be included but got %s", spew.Sprint(*expanded))
+	}
+}
@@ -0,0 +1,59 @@
+package azurerm
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// the default amount of time to wait for long-running operations, which can be
+// overridden for each resource using a `timeouts` block
+const (
+	defaultCreateTimeout = 60 * time.Minute
+	defaultUpdateTimeout = 60 * time.Minute
+	defaultDeleteTimeout = 60 * time.Minute
+)
+
+// setDefaultTimeouts configures the default Create/Update/Delete timeouts for any
+// resources which don't define their own
+func setDefaultTimeouts(resources map[string]*schema.Resource) {
+	for _, resource := range resources {
+		if resource.Timeouts != nil {
+			continue
+		}
+
+		timeouts := &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultCreateTimeout),
+			Delete: schema.DefaultTimeout(defaultDeleteTimeout),
+		}
+
+		if resource.Update != nil {
+			timeouts.Update = schema.DefaultTimeout(defaultUpdateTimeout)
+		}
+
+		resource.Timeouts = timeouts
+	}
+}
+
+// createUpdateTimeout returns the timeout for a function used to both create and update a resource
+func createUpdateTimeout(d *schema.ResourceData) time.Duration {
+	if d.IsNewResource() {
+		return d.Timeout(schema.TimeoutCreate)
+	}
+
+	return d.Timeout(schema.TimeoutUpdate)
+}
+
+// cancelAfter returns a channel which is closed once the timeout has elapsed, or Terraform
+// is stopped (e.g. Ctrl-C is pressed) - which cancels any long-running operation it's passed to.
+// The returned function releases the underlying context and should be deferred by the caller.
+func cancelAfter(meta interface{}, timeout time.Duration) (<-chan struct{}, context.CancelFunc) {
+	parent := context.Background()
+	if client, ok := meta.(*ArmClient); ok && client.StopContext != nil {
+		parent = client.StopContext
+	}
+
+	ctx, cancel := context.WithTimeout(parent, timeout)
+	return ctx.Done(), cancel
+}
@@ -0,0 +1,94 @@
+package azurerm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestSetDefaultTimeouts(t *testing.T) {
+	noop := func(d *schema.ResourceData, meta interface{}) error {
+		return nil
+	}
+	custom := &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(5 * time.Minute),
+	}
+	resources := map[string]*schema.Resource{
+		"updatable": {
+			Create: noop,
+			Update: noop,
+			Delete: noop,
+		},
+		"force_new": {
+			Create: noop,
+			Delete: noop,
+		},
+		"custom": {
+			Create:   noop,
+			Delete:   noop,
+			Timeouts: custom,
+		},
+	}
+
+	setDefaultTimeouts(resources)
+
+	updatable := resources["updatable"].Timeouts
+	if updatable == nil || *updatable.Create != defaultCreateTimeout || updatable.Update == nil || *updatable.Delete != defaultDeleteTimeout {
+		t.Fatalf("Expected the default Create, Update and Delete timeouts to be set but got %+v", updatable)
+	}
+
+	if forceNew := resources["force_new"].Timeouts; forceNew == nil || forceNew.Update != nil {
+		t.Fatalf("Expected no Update timeout for a resource without an Update function but got %+v", forceNew)
+	}
+
+	if resources["custom"].Timeouts != custom {
+		t.Fatalf("Expected the existing timeouts not to be overwritten")
+	}
+}
+
+func TestCancelAfter(t *testing.T) {
+	cancel, release := cancelAfter(nil, 10*time.Millisecond)
+	defer release()
+
+	select {
+	case <-cancel:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Expected the channel to be closed once the timeout elapsed")
+	}
+}
+
+func TestCancelAfterStopped(t *testing.T) {
+	stopContext, stop := context.WithCancel(context.Background())
+	client := &ArmClient{
+		StopContext: stopContext,
+	}
+
+	cancel, release := cancelAfter(client, time.Hour)
+	defer release()
+
+	select {
+	case <-cancel:
+		t.Fatalf("Expected the channel not to be closed before Terraform is stopped")
+	default:
+	}
+
+	stop()
+	select {
+	case <-cancel:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Expected the channel to be closed once Terraform was stopped")
+	}
+}
+
+func TestCancelAfterReleased(t *testing.T) {
+	cancel, release := cancelAfter(nil, time.Hour)
+	release()
+
+	select {
+	case <-cancel:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Expected the channel to be closed once it was released")
+	}
+}
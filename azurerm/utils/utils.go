@@ -4,6 +4,10 @@ func Bool(input bool) *bool {
 	return &input
 }
 
+func Float(input float64) *float64 {
+	return &input
+}
+
 func Int32(input int32) *int32 {
 	return &input
 }
@@ -15,3 +19,11 @@ func Int64(input int64) *int64 {
 func String(input string) *string {
 	return &input
 }
+
+func NormalizeNilableString(input *string) string {
+	if input == nil {
+		return ""
+	}
+
+	return *input
+}
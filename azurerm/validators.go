@@ -48,6 +48,14 @@ func validateUUID(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+func validateUUIDOrEmpty(v interface{}, k string) (ws []string, errors []error) {
+	if v.(string) == "" {
+		return
+	}
+
+	return validateUUID(v, k)
+}
+
 func validateDBAccountName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -46,6 +46,34 @@ func TestValidateRFC3339Date(t *testing.T) {
 	}
 }
 
+func TestValidateUUIDOrEmpty(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 0,
+		},
+		{
+			Value:    "not-a-uuid",
+			ErrCount: 1,
+		},
+		{
+			Value:    "00000000-0000-0000-0000-000000000000",
+			ErrCount: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateUUIDOrEmpty(tc.Value, "example")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected validateUUIDOrEmpty to trigger '%d' errors for '%s' - got '%d'", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
 func TestValidateIntInSlice(t *testing.T) {
 
 	cases := []struct {